@@ -17,6 +17,8 @@ func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			options.EvmKeeper,
 			options.DistributionKeeper,
 			options.StakingKeeper,
+			options.FeegrantKeeper,
+			options.PaymasterKeeper,
 			options.MaxTxGasWanted,
 		),
 	)
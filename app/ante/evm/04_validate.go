@@ -9,6 +9,7 @@ import (
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
@@ -16,6 +17,7 @@ import (
 //
 // It checks the following requirements:
 // - nil MUST be passed as the from address
+// - If the transaction is a set code transaction, EIP-7702 must be enabled in the EVM parameters
 // - If the transaction is a contract creation or call, the corresponding operation must be enabled in the EVM parameters
 func ValidateMsg(
 	evmParams evmtypes.Params,
@@ -26,6 +28,10 @@ func ValidateMsg(
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid from address; expected nil; got: %q", from.String())
 	}
 
+	if txData.TxType() == evmtypes.SetCodeTxType && !evmParams.IsSetCodeEnabled() {
+		return errorsmod.Wrap(evmtypes.ErrInvalidAuthorization, "set code transactions are not enabled")
+	}
+
 	return checkDisabledCreateCall(
 		txData,
 		&evmParams.AccessControl,
@@ -52,6 +58,24 @@ func checkDisabledCreateCall(
 	return nil
 }
 
+// CheckPermissions checks that the sender of the transaction is allowed to create
+// a contract or to perform a call by the access control policy of the EVM parameters.
+func CheckPermissions(
+	evmParams evmtypes.Params,
+	txData evmtypes.TxData,
+	from common.Address,
+) error {
+	policy := evmtypes.NewRestrictedPermissionPolicy(&evmParams.AccessControl, from)
+
+	to := txData.GetTo()
+	if to == nil && !policy.CanCreate(from, from) {
+		return errorsmod.Wrapf(evmtypes.ErrCreateNotPermitted, "address %s does not have permission to deploy contracts", from)
+	} else if to != nil && !policy.CanCall(from, from, *to) {
+		return errorsmod.Wrapf(evmtypes.ErrCallNotPermitted, "address %s does not have permission to perform a call", from)
+	}
+	return nil
+}
+
 // ValidateTx validates an Ethereum specific transaction type and returns an error if invalid.
 //
 // FIXME: this shouldn't be required if the tx was an Ethereum transaction type.
@@ -85,8 +109,16 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
 	}
 
-	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	// NOTE: the fee granter pays the fees of the Ethereum txs when set and accepted by
+	// their senders, while the fee payer cannot sign the tx.
+	if authInfo.Fee.Payer != "" {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer should be empty")
+	}
+
+	if authInfo.Fee.Granter != "" {
+		if _, err := sdktypes.AccAddressFromBech32(authInfo.Fee.Granter); err != nil {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid AuthInfo Fee granter: %s", err)
+		}
 	}
 
 	sigs := protoTx.Signatures
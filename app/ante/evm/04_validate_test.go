@@ -52,6 +52,38 @@ func (suite *EvmAnteTestSuite) TestValidateMsg() {
 				}
 			},
 		},
+		{
+			name:          "fail: set code tx without EIP-7702",
+			expectedError: evmtypes.ErrInvalidAuthorization,
+			getFunctionParams: func() validateMsgParams {
+				txArgs := getTxByType("set code", keyring.GetAddr(1))
+				txData, err := txArgs.ToTxData()
+				suite.Require().NoError(err)
+				return validateMsgParams{
+					evmParams: evmtypes.DefaultParams(),
+					txData:    txData,
+					from:      nil,
+				}
+			},
+		},
+		{
+			name:          "success: set code tx with EIP-7702",
+			expectedError: nil,
+			getFunctionParams: func() validateMsgParams {
+				txArgs := getTxByType("set code", keyring.GetAddr(1))
+				txData, err := txArgs.ToTxData()
+				suite.Require().NoError(err)
+
+				params := evmtypes.DefaultParams()
+				params.ExtraEIPs = append(params.ExtraEIPs, evmtypes.SetCodeEIP)
+
+				return validateMsgParams{
+					evmParams: params,
+					txData:    txData,
+					from:      nil,
+				}
+			},
+		},
 		{
 			name:          "success: transfer with disable call and create",
 			expectedError: evmtypes.ErrCallDisabled,
@@ -194,6 +226,79 @@ func (suite *EvmAnteTestSuite) TestValidateMsg() {
 	}
 }
 
+func (suite *EvmAnteTestSuite) TestCheckPermissions() {
+	keyring := testkeyring.New(2)
+	sender := keyring.GetAddr(0)
+
+	permissioned := func(addresses ...string) evmtypes.AccessControlType {
+		return evmtypes.AccessControlType{
+			AccessType:        evmtypes.AccessTypePermissioned,
+			AccessControlList: addresses,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		txType        string
+		accessControl evmtypes.AccessControl
+		expectedError error
+	}{
+		{
+			name:          "success: create with default params",
+			txType:        "create",
+			accessControl: evmtypes.DefaultAccessControl,
+		},
+		{
+			name:          "success: create by permissioned sender",
+			txType:        "create",
+			accessControl: evmtypes.AccessControl{Create: permissioned(sender.Hex()), Call: evmtypes.DefaultAccessControl.Call},
+		},
+		{
+			name:          "fail: create by sender not permissioned",
+			txType:        "create",
+			accessControl: evmtypes.AccessControl{Create: permissioned(keyring.GetAddr(1).Hex()), Call: evmtypes.DefaultAccessControl.Call},
+			expectedError: evmtypes.ErrCreateNotPermitted,
+		},
+		{
+			name:          "success: call with create permissioned",
+			txType:        "call",
+			accessControl: evmtypes.AccessControl{Create: permissioned(), Call: evmtypes.DefaultAccessControl.Call},
+		},
+		{
+			name:   "fail: transfer by blocked sender",
+			txType: "transfer",
+			accessControl: evmtypes.AccessControl{
+				Create: evmtypes.DefaultAccessControl.Create,
+				Call: evmtypes.AccessControlType{
+					AccessType:        evmtypes.AccessTypePermissionless,
+					AccessControlList: []string{sender.Hex()},
+				},
+			},
+			expectedError: evmtypes.ErrCallNotPermitted,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			txArgs := getTxByType(tc.txType, keyring.GetAddr(1))
+			txData, err := txArgs.ToTxData()
+			suite.Require().NoError(err)
+
+			params := evmtypes.DefaultParams()
+			params.AccessControl = tc.accessControl
+
+			// Function under test
+			err = evm.CheckPermissions(params, txData, sender)
+
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func getTxByType(typeTx string, recipient common.Address) evmtypes.EvmTxArgs {
 	switch typeTx {
 	case "call":
@@ -210,6 +315,13 @@ func getTxByType(typeTx string, recipient common.Address) evmtypes.EvmTxArgs {
 			To:     &recipient,
 			Amount: big.NewInt(100),
 		}
+	case "set code":
+		return evmtypes.EvmTxArgs{
+			To:                &recipient,
+			GasFeeCap:         big.NewInt(1),
+			GasTipCap:         big.NewInt(1),
+			AuthorizationList: []evmtypes.SetCodeAuthorization{{Address: recipient.Hex()}},
+		}
 	default:
 		panic("invalid type")
 	}
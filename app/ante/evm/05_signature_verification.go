@@ -60,6 +60,16 @@ func SignatureVerification(
 	signer ethtypes.Signer,
 	allowUnprotectedTxs bool,
 ) error {
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+
+	// set code transactions are not supported by the go-ethereum signers
+	if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+		return setCodeSignatureVerification(msg, setCodeTx, signer)
+	}
+
 	ethTx := msg.AsTransaction()
 
 	if !allowUnprotectedTxs && !ethTx.Protected() {
@@ -81,3 +91,33 @@ func SignatureVerification(
 	msg.From = sender.Hex()
 	return nil
 }
+
+// setCodeSignatureVerification checks that the EIP-7702 set code transaction was
+// signed for the registered chain id and sets the recovered sender on the message.
+// The authorizations are verified when they are applied to the state.
+func setCodeSignatureVerification(
+	msg *evmtypes.MsgEthereumTx,
+	txData *evmtypes.SetCodeTx,
+	signer ethtypes.Signer,
+) error {
+	chainID := txData.GetChainID()
+	if chainID == nil || signer.ChainID() == nil || chainID.Cmp(signer.ChainID()) != 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidChainID,
+			"invalid chain id for set code transaction; expected %s, got %s", signer.ChainID(), chainID,
+		)
+	}
+
+	sender, err := txData.Sender()
+	if err != nil {
+		return errorsmod.Wrapf(
+			errortypes.ErrorInvalidSigner,
+			"couldn't retrieve sender address from the ethereum transaction: %s",
+			err.Error(),
+		)
+	}
+
+	// set up the sender to the transaction field if not already
+	msg.From = sender.Hex()
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
 	"github.com/evmos/evmos/v19/x/evm/keeper"
 	"github.com/evmos/evmos/v19/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
@@ -16,18 +17,68 @@ import (
 // VerifyAccountBalance checks that the account balance is greater than the total transaction cost.
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This method will fail if:
-// - from address is NOT an EOA
+// - from address is NOT an EOA or an EOA delegating its code (EIP-7702)
 // - account balance is lower than the transaction cost
 func VerifyAccountBalance(
 	ctx sdk.Context,
 	accountKeeper evmtypes.AccountKeeper,
+	evmKeeper EVMKeeper,
 	account *statedb.Account,
 	from common.Address,
 	txData evmtypes.TxData,
 ) error {
-	// check whether the sender address is EOA
-	if account != nil && account.IsContract() {
+	account, err := verifySenderAccount(ctx, accountKeeper, evmKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// VerifySponsoredAccountBalance checks that the account balance is greater than the value
+// transferred by a transaction whose fees are paid by a gas sponsor, which allows senders
+// without funds to send sponsored transactions. As VerifyAccountBalance, the account will be
+// set to store if it doesn't exist and the method fails if from address is NOT an EOA.
+func VerifySponsoredAccountBalance(
+	ctx sdk.Context,
+	accountKeeper evmtypes.AccountKeeper,
+	evmKeeper EVMKeeper,
+	account *statedb.Account,
+	from common.Address,
+	txData evmtypes.TxData,
+) error {
+	account, err := verifySenderAccount(ctx, accountKeeper, evmKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	value := txData.GetValue()
+	if account.Balance.Cmp(value) < 0 {
 		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"sender balance < tx value (%s < %s)", account.Balance, value,
+		)
+	}
+
+	return nil
+}
+
+// verifySenderAccount checks that the sender is an EOA and sets its account to store if it
+// doesn't exist. It returns the sender account.
+func verifySenderAccount(
+	ctx sdk.Context,
+	accountKeeper evmtypes.AccountKeeper,
+	evmKeeper EVMKeeper,
+	account *statedb.Account,
+	from common.Address,
+) (*statedb.Account, error) {
+	// check whether the sender address is EOA
+	if account != nil && account.IsContract() && !isDelegated(ctx, evmKeeper, account) {
+		return nil, errorsmod.Wrapf(
 			errortypes.ErrInvalidType,
 			"the sender is not EOA: address %s", from,
 		)
@@ -39,9 +90,12 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
-		return errorsmod.Wrap(err, "failed to check sender balance")
-	}
+	return account, nil
+}
 
-	return nil
+// isDelegated returns true if the account code is an EIP-7702 delegation designator.
+func isDelegated(ctx sdk.Context, evmKeeper EVMKeeper, account *statedb.Account) bool {
+	code := evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
+	_, ok := vm.ParseDelegation(code)
+	return ok
 }
@@ -108,6 +108,7 @@ func (suite *EvmAnteTestSuite) TestVerifyAccountBalance() {
 			err = evm.VerifyAccountBalance(
 				unitNetwork.GetContext(),
 				unitNetwork.App.AccountKeeper,
+				unitNetwork.App.EvmKeeper,
 				statedbAccount,
 				senderKey.Addr,
 				txData,
@@ -131,6 +132,74 @@ func (suite *EvmAnteTestSuite) TestVerifyAccountBalance() {
 	}
 }
 
+func (suite *EvmAnteTestSuite) TestVerifySponsoredAccountBalance() {
+	// Setup
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetKey(0).AccAddr),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	// The sender is not funded, the fees are paid by the gas sponsor
+	senderKey := keyring.GetKey(1)
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		malleate      func(*evmtypes.EvmTxArgs)
+	}{
+		{
+			name:          "fail: sender balance is lower than the transaction value",
+			expectedError: errortypes.ErrInsufficientFunds,
+			malleate: func(txArgs *evmtypes.EvmTxArgs) {
+				txArgs.Amount = big.NewInt(100)
+			},
+		},
+		{
+			name:          "success: sender without funds sends a transaction without value",
+			expectedError: nil,
+			malleate: func(txArgs *evmtypes.EvmTxArgs) {
+				txArgs.Amount = big.NewInt(0)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), tc.name), func() {
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+			suite.Require().NoError(err)
+			tc.malleate(&txArgs)
+			txData, err := txArgs.ToTxData()
+			suite.Require().NoError(err)
+
+			//  Function to be tested
+			err = evm.VerifySponsoredAccountBalance(
+				unitNetwork.GetContext(),
+				unitNetwork.App.AccountKeeper,
+				unitNetwork.App.EvmKeeper,
+				nil,
+				senderKey.Addr,
+				txData,
+			)
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+			// Make sure the account is created either way
+			acc, err := grpcHandler.GetAccount(senderKey.AccAddr.String())
+			suite.Require().NoError(err)
+			suite.Require().NotEmpty(acc)
+
+			// Clean block for next test
+			err = unitNetwork.NextBlock()
+			suite.Require().NoError(err)
+		})
+	}
+}
+
 func getDefaultStateDBAccount(unitNetwork *network.UnitTestNetwork, addr common.Address) *statedb.Account {
 	statedb := unitNetwork.GetStateDB()
 	return statedb.Keeper().GetAccount(unitNetwork.GetContext(), addr)
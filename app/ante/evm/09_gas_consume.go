@@ -11,6 +11,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	anteutils "github.com/evmos/evmos/v19/app/ante/utils"
+	feegrantprecompile "github.com/evmos/evmos/v19/precompiles/feegrant"
 	"github.com/evmos/evmos/v19/types"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
@@ -67,6 +68,94 @@ func ConsumeFeesAndEmitEvent(
 	return nil
 }
 
+// VerifyFeeGranter checks that the sender accepted the fee granter of the Cosmos tx to pay
+// the fees of its Ethereum tx. The fee granter is not covered by the Ethereum tx signature,
+// so it is only honoured when the sender accepted it beforehand through the feegrant
+// precompile, or when the signed tx itself accepts it by calling the precompile.
+func VerifyFeeGranter(
+	ctx sdktypes.Context,
+	evmKeeper EVMKeeper,
+	from common.Address,
+	granter common.Address,
+	txData evmtypes.TxData,
+) error {
+	if accepted, found := evmKeeper.GetAcceptedFeeGranter(ctx, from); found && accepted == granter {
+		return nil
+	}
+
+	to := txData.GetTo()
+	if to != nil && *to == common.HexToAddress(feegrantprecompile.PrecompileAddress) {
+		if accepted, ok := feegrantprecompile.ParseAcceptGranterInput(txData.GetData()); ok && accepted == granter {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(
+		errortypes.ErrUnauthorized,
+		"fee granter %s not accepted by the sender %s", granter, from,
+	)
+}
+
+// UseGrantedFees deducts the fees of the Ethereum tx from the allowance granted by the fee
+// granter of the Cosmos tx to the sender.
+func UseGrantedFees(
+	ctx sdktypes.Context,
+	feegrantKeeper FeegrantKeeper,
+	granter sdktypes.AccAddress,
+	grantee sdktypes.AccAddress,
+	fees sdktypes.Coins,
+	msg sdktypes.Msg,
+) error {
+	if feegrantKeeper == nil {
+		return errortypes.ErrInvalidRequest.Wrap("fee grants are not enabled")
+	}
+
+	if err := feegrantKeeper.UseGrantedFees(ctx, granter, grantee, fees, []sdktypes.Msg{msg}); err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", granter, grantee)
+	}
+	return nil
+}
+
+// SponsorFees returns the gas sponsor that pays the fees of the transaction instead of the
+// sender. It returns false if there is no paymaster or no sponsorship covers the transaction.
+func SponsorFees(
+	ctx sdktypes.Context,
+	paymasterKeeper PaymasterKeeper,
+	from common.Address,
+	txData evmtypes.TxData,
+	baseFee *big.Int,
+	denom string,
+) (sdktypes.AccAddress, bool) {
+	if paymasterKeeper == nil {
+		return nil, false
+	}
+
+	gasPrice := sdkmath.NewIntFromBigInt(txData.EffectiveGasPrice(baseFee))
+	return paymasterKeeper.SponsorFees(ctx, from, txData.GetTo(), txData.GetData(), gasPrice, sponsoredFee(txData, baseFee, denom))
+}
+
+// RecordSponsoredFees adds the fees of a sponsored transaction to the daily usage
+// of the sender. It must only be called once the fees have been deducted from the sponsor.
+func RecordSponsoredFees(
+	ctx sdktypes.Context,
+	paymasterKeeper PaymasterKeeper,
+	from common.Address,
+	txData evmtypes.TxData,
+	baseFee *big.Int,
+	denom string,
+) {
+	if paymasterKeeper == nil || txData.GetTo() == nil {
+		return
+	}
+
+	paymasterKeeper.RecordSponsoredFees(ctx, from, *txData.GetTo(), sponsoredFee(txData, baseFee, denom))
+}
+
+// sponsoredFee returns the fees charged to the gas sponsor of the transaction
+func sponsoredFee(txData evmtypes.TxData, baseFee *big.Int, denom string) sdktypes.Coin {
+	return sdktypes.Coin{Denom: denom, Amount: sdkmath.NewIntFromBigInt(txData.EffectiveFee(baseFee))}
+}
+
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
 // If the spendable balance is not enough, it tries to claim enough staking rewards to cover the fees.
 func deductFees(
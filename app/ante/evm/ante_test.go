@@ -9,16 +9,21 @@ import (
 	sdkmath "cosmossdk.io/math"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	feegrantprecompile "github.com/evmos/evmos/v19/precompiles/feegrant"
 	utiltx "github.com/evmos/evmos/v19/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	paymastertypes "github.com/evmos/evmos/v19/x/paymaster/types"
 )
 
 func (suite *AnteTestSuite) TestAnteHandler() {
@@ -1264,3 +1269,304 @@ func (suite *AnteTestSuite) TestAnteHandlerWithParams() {
 	}
 	suite.evmParamsOption = nil
 }
+
+func (suite *AnteTestSuite) TestAnteHandlerWithFeeGrant() {
+	addr, privKey := utiltx.NewAddrKey()
+	granter := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	to := utiltx.GenerateAddress()
+
+	ethTxParams := evmtypes.EvmTxArgs{
+		ChainID:  suite.app.EvmKeeper.ChainID(),
+		To:       &to,
+		Nonce:    1,
+		Amount:   big.NewInt(10),
+		GasLimit: 100000,
+		GasPrice: big.NewInt(150),
+	}
+	// the legacy tx fees are the gas price times the gas limit
+	fees := sdkmath.NewInt(150 * 100000)
+	granterBalance := big.NewInt(100000000)
+
+	feegrantAddr := common.HexToAddress(feegrantprecompile.PrecompileAddress)
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(suite.app.FeeGrantKeeper, suite.app.EvmKeeper, suite.app.AuthzKeeper)
+	suite.Require().NoError(err)
+
+	spendLimit := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, amount))
+	}
+	grantAllowance := func() {
+		err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, addr.Bytes(), &feegrant.BasicAllowance{SpendLimit: spendLimit(20000000)})
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(txArgs *evmtypes.EvmTxArgs) sdk.AccAddress
+		feePayer  sdk.AccAddress
+		expErr    error
+		expRefund bool
+	}{
+		{
+			"fail - no allowance from the granter",
+			func(*evmtypes.EvmTxArgs) sdk.AccAddress {
+				suite.app.EvmKeeper.SetAcceptedFeeGranter(suite.ctx, addr, common.BytesToAddress(granter))
+				return granter
+			},
+			nil,
+			errortypes.ErrNotFound,
+			false,
+		},
+		{
+			"fail - fees exceed the spend limit",
+			func(*evmtypes.EvmTxArgs) sdk.AccAddress {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, addr.Bytes(), &feegrant.BasicAllowance{SpendLimit: spendLimit(1000)})
+				suite.Require().NoError(err)
+				suite.app.EvmKeeper.SetAcceptedFeeGranter(suite.ctx, addr, common.BytesToAddress(granter))
+				return granter
+			},
+			nil,
+			feegrant.ErrFeeLimitExceeded,
+			false,
+		},
+		{
+			"fail - Ethereum txs not allowed",
+			func(*evmtypes.EvmTxArgs) sdk.AccAddress {
+				allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+				suite.Require().NoError(err)
+				err = suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, addr.Bytes(), allowance)
+				suite.Require().NoError(err)
+				suite.app.EvmKeeper.SetAcceptedFeeGranter(suite.ctx, addr, common.BytesToAddress(granter))
+				return granter
+			},
+			nil,
+			feegrant.ErrMessageNotAllowed,
+			false,
+		},
+		{
+			"fail - fee payer set",
+			func(*evmtypes.EvmTxArgs) sdk.AccAddress {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, addr.Bytes(), &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+				return granter
+			},
+			granter,
+			errortypes.ErrInvalidRequest,
+			false,
+		},
+		{
+			"fail - signed tx rewrapped with a granter not accepted by the sender",
+			func(*evmtypes.EvmTxArgs) sdk.AccAddress {
+				grantAllowance()
+				return granter
+			},
+			nil,
+			errortypes.ErrUnauthorized,
+			false,
+		},
+		{
+			"fail - sender accepted another granter",
+			func(*evmtypes.EvmTxArgs) sdk.AccAddress {
+				grantAllowance()
+				suite.app.EvmKeeper.SetAcceptedFeeGranter(suite.ctx, addr, utiltx.GenerateAddress())
+				return granter
+			},
+			nil,
+			errortypes.ErrUnauthorized,
+			false,
+		},
+		{
+			"fail - tx accepts another granter",
+			func(txArgs *evmtypes.EvmTxArgs) sdk.AccAddress {
+				grantAllowance()
+				input, err := feegrantPrecompile.Pack(feegrantprecompile.AcceptGranterMethod, utiltx.GenerateAddress())
+				suite.Require().NoError(err)
+				txArgs.To = &feegrantAddr
+				txArgs.Input = input
+				return granter
+			},
+			nil,
+			errortypes.ErrUnauthorized,
+			false,
+		},
+		{
+			"success - granter accepted by the sender pays the fees",
+			func(*evmtypes.EvmTxArgs) sdk.AccAddress {
+				grantAllowance()
+				suite.app.EvmKeeper.SetAcceptedFeeGranter(suite.ctx, addr, common.BytesToAddress(granter))
+				return granter
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"success - granter accepted by the tx pays the fees",
+			func(txArgs *evmtypes.EvmTxArgs) sdk.AccAddress {
+				grantAllowance()
+				input, err := feegrantPrecompile.Pack(feegrantprecompile.AcceptGranterMethod, common.BytesToAddress(granter))
+				suite.Require().NoError(err)
+				txArgs.To = &feegrantAddr
+				txArgs.Input = input
+				return granter
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"success - sender is the granter",
+			func(*evmtypes.EvmTxArgs) sdk.AccAddress {
+				err := suite.app.EvmKeeper.SetBalance(suite.ctx, addr, granterBalance)
+				suite.Require().NoError(err)
+				return addr.Bytes()
+			},
+			nil,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = false
+			suite.SetupTest() // reset
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, granter))
+			suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(100))
+
+			// the sender only holds the transferred value
+			err := suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt(10))
+			suite.Require().NoError(err)
+			err = suite.app.EvmKeeper.SetBalance(suite.ctx, common.BytesToAddress(granter), granterBalance)
+			suite.Require().NoError(err)
+
+			txArgs := ethTxParams
+			feeGranter := tc.malleate(&txArgs)
+
+			// the tx is signed by the sender, while the fee granter is set on the
+			// Cosmos tx wrapping it, which anyone can build
+			signedTx := evmtypes.NewTx(&txArgs)
+			signedTx.From = addr.Hex()
+			txBuilder := suite.CreateTestTxBuilder(signedTx, privKey, 1, false)
+			txBuilder.SetFeeGranter(feeGranter)
+			txBuilder.SetFeePayer(tc.feePayer)
+
+			_, err = suite.anteHandler(suite.ctx, txBuilder.GetTx(), false)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			feePayer := common.BytesToAddress(feeGranter)
+			expBalance := new(big.Int).Sub(granterBalance, fees.BigInt())
+			suite.Require().Equal(expBalance, suite.app.EvmKeeper.GetBalance(suite.ctx, feePayer))
+
+			refundRecipient, found := suite.app.EvmKeeper.GetTxFeePayerTransient(suite.ctx, signedTx.AsTransaction().Hash())
+			suite.Require().Equal(tc.expRefund, found)
+			if tc.expRefund {
+				suite.Require().Equal(feeGranter, refundRecipient)
+
+				allowance, err := suite.app.FeeGrantKeeper.GetAllowance(suite.ctx, granter, addr.Bytes())
+				suite.Require().NoError(err)
+				suite.Require().Equal(spendLimit(20000000).Sub(sdk.NewCoin(evmtypes.DefaultEVMDenom, fees)), allowance.(*feegrant.BasicAllowance).SpendLimit)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerWithSponsorship() {
+	addr, privKey := utiltx.NewAddrKey()
+	contract := utiltx.GenerateAddress()
+	sponsor := paymastertypes.SponsorshipAccount(contract)
+
+	ethTxParams := evmtypes.EvmTxArgs{
+		ChainID:  suite.app.EvmKeeper.ChainID(),
+		To:       &contract,
+		Nonce:    1,
+		Amount:   big.NewInt(10),
+		GasLimit: 100000,
+		GasPrice: big.NewInt(150),
+	}
+	// the legacy tx fees are the gas price times the gas limit
+	fees := sdkmath.NewInt(150 * 100000)
+	balance := big.NewInt(100000000)
+
+	testCases := []struct {
+		name         string
+		maxGasPrice  int64
+		dailyCap     sdkmath.Int
+		expSponsored bool
+	}{
+		{
+			"sponsored - no daily cap",
+			150,
+			sdkmath.ZeroInt(),
+			true,
+		},
+		{
+			"sponsored - fees within the daily cap",
+			150,
+			fees,
+			true,
+		},
+		{
+			"not sponsored - gas price above the max gas price",
+			149,
+			sdkmath.ZeroInt(),
+			false,
+		},
+		{
+			"not sponsored - fees above the daily cap",
+			150,
+			fees.SubRaw(1),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = false
+			suite.SetupTest() // reset
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, sponsor))
+			suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(100))
+
+			err := suite.app.EvmKeeper.SetBalance(suite.ctx, addr, balance)
+			suite.Require().NoError(err)
+			err = suite.app.EvmKeeper.SetBalance(suite.ctx, common.BytesToAddress(sponsor), balance)
+			suite.Require().NoError(err)
+
+			owner := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			sponsorship := paymastertypes.NewSponsorship(contract, owner, nil, tc.dailyCap, sdkmath.NewInt(tc.maxGasPrice))
+			suite.app.PaymasterKeeper.SetSponsorship(suite.ctx, sponsorship)
+
+			signedTx := evmtypes.NewTx(&ethTxParams)
+			signedTx.From = addr.Hex()
+			txBuilder := suite.CreateTestTxBuilder(signedTx, privKey, 1, false)
+
+			_, err = suite.anteHandler(suite.ctx, txBuilder.GetTx(), false)
+			suite.Require().NoError(err)
+
+			feePayer, notPayer := common.BytesToAddress(sponsor), addr
+			if !tc.expSponsored {
+				feePayer, notPayer = notPayer, feePayer
+			}
+			suite.Require().Equal(new(big.Int).Sub(balance, fees.BigInt()), suite.app.EvmKeeper.GetBalance(suite.ctx, feePayer))
+			suite.Require().Equal(balance, suite.app.EvmKeeper.GetBalance(suite.ctx, notPayer))
+
+			// the daily usage is only recorded once the sponsor paid the fees
+			usage, found := suite.app.PaymasterKeeper.GetSponsorshipUsage(suite.ctx, contract, addr)
+			suite.Require().Equal(tc.expSponsored, found)
+			if tc.expSponsored {
+				suite.Require().Equal(fees, usage.Amount)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package evm
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/ethereum/go-ethereum/common"
@@ -24,12 +25,37 @@ type EVMKeeper interface { //nolint: revive
 
 	NewEVM(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) *vm.EVM
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	VerifyFee(
+		ctx sdk.Context,
+		msg core.Message,
+		txData evmtypes.TxData,
+		denom string,
+		baseFee *big.Int,
+		cfg *params.ChainConfig,
+		evmParams evmtypes.Params,
+		isCheckTx bool,
+	) (sdk.Coins, error)
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
+	SetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash, feePayer sdk.AccAddress)
+	GetAcceptedFeeGranter(ctx sdk.Context, grantee common.Address) (common.Address, bool)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
 
+// PaymasterKeeper defines the expected keeper interface used on the AnteHandler
+// to charge the fees of the sponsored transactions to the gas sponsor
+type PaymasterKeeper interface {
+	SponsorFees(ctx sdk.Context, sender common.Address, to *common.Address, input []byte, gasPrice sdkmath.Int, fee sdk.Coin) (sdk.AccAddress, bool)
+	RecordSponsoredFees(ctx sdk.Context, sender, contract common.Address, fee sdk.Coin)
+}
+
+// FeegrantKeeper defines the expected keeper interface used on the AnteHandler
+// to charge the fees of the Ethereum transactions to the fee granter of the Cosmos tx
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
@@ -15,7 +15,6 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	anteutils "github.com/evmos/evmos/v19/app/ante/utils"
-	evmkeeper "github.com/evmos/evmos/v19/x/evm/keeper"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
@@ -30,6 +29,8 @@ type MonoDecorator struct {
 	evmKeeper          EVMKeeper
 	distributionKeeper anteutils.DistributionKeeper
 	stakingKeeper      anteutils.StakingKeeper
+	feegrantKeeper     FeegrantKeeper
+	paymasterKeeper    PaymasterKeeper
 	maxGasWanted       uint64
 }
 
@@ -57,6 +58,8 @@ func NewMonoDecorator(
 	evmKeeper EVMKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	stakingKeeper anteutils.StakingKeeper,
+	feegrantKeeper FeegrantKeeper,
+	paymasterKeeper PaymasterKeeper,
 	maxGasWanted uint64,
 ) MonoDecorator {
 	return MonoDecorator{
@@ -66,6 +69,8 @@ func NewMonoDecorator(
 		evmKeeper:          evmKeeper,
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
+		feegrantKeeper:     feegrantKeeper,
+		paymasterKeeper:    paymasterKeeper,
 		maxGasWanted:       maxGasWanted,
 	}
 }
@@ -136,6 +141,12 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	// The fee granter of the Cosmos tx pays the fees of the wrapped Ethereum txs.
+	var feeGranter sdk.AccAddress
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		feeGranter = feeTx.FeeGranter()
+	}
+
 	// Use the lowest priority of all the messages as the final one.
 	for i, msg := range tx.GetMsgs() {
 		ethMsg, txData, from, err := evmtypes.UnpackEthMsg(msg)
@@ -156,7 +167,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 
 		// 3. min gas price (global min fee)
-		if txData.TxType() == ethtypes.DynamicFeeTxType && decUtils.BaseFee != nil {
+		if (txData.TxType() == ethtypes.DynamicFeeTxType || txData.TxType() == evmtypes.SetCodeTxType) && decUtils.BaseFee != nil {
 			feeAmt = txData.EffectiveFee(decUtils.BaseFee)
 			fee = sdkmath.LegacyNewDecFromBigInt(feeAmt)
 		}
@@ -186,18 +197,57 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 
 		// NOTE: sender address has been verified and cached
 		from = ethMsg.GetFrom()
+		fromAddr := common.HexToAddress(ethMsg.From)
+
+		// 5.1 access control permissions of the sender
+		if err := CheckPermissions(decUtils.EvmParams, txData, fromAddr); err != nil {
+			return ctx, err
+		}
 
 		// 6. account balance verification
-		fromAddr := common.HexToAddress(ethMsg.From)
 		// TODO: Use account from AccountKeeper instead
 		account := md.evmKeeper.GetAccount(ctx, fromAddr)
-		if err := VerifyAccountBalance(
-			ctx,
-			md.accountKeeper,
-			account,
-			fromAddr,
-			txData,
-		); err != nil {
+
+		// the fee granter or the sponsor of the called contract pays the fees of the
+		// sponsored transactions, so the sender balance only has to cover the transferred value
+		granted := feeGranter != nil && !feeGranter.Equals(from)
+		if granted {
+			if err := VerifyFeeGranter(ctx, md.evmKeeper, fromAddr, common.BytesToAddress(feeGranter), txData); err != nil {
+				return ctx, err
+			}
+		}
+		feePayer, sponsored := feeGranter, granted
+		if !granted {
+			feePayer, sponsored = SponsorFees(
+				ctx,
+				md.paymasterKeeper,
+				fromAddr,
+				txData,
+				decUtils.BaseFee,
+				decUtils.EvmDenom,
+			)
+		}
+		if sponsored {
+			err = VerifySponsoredAccountBalance(
+				ctx,
+				md.accountKeeper,
+				md.evmKeeper,
+				account,
+				fromAddr,
+				txData,
+			)
+		} else {
+			feePayer = from
+			err = VerifyAccountBalance(
+				ctx,
+				md.accountKeeper,
+				md.evmKeeper,
+				account,
+				fromAddr,
+				txData,
+			)
+		}
+		if err != nil {
 			return ctx, err
 		}
 
@@ -243,18 +293,26 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 
 		// 9. gas consumption
-		msgFees, err := evmkeeper.VerifyFee(
+		msgFees, err := md.evmKeeper.VerifyFee(
+			ctx,
+			coreMsg,
 			txData,
 			decUtils.EvmDenom,
 			decUtils.BaseFee,
-			decUtils.Rules.IsHomestead,
-			decUtils.Rules.IsIstanbul,
+			decUtils.EthConfig,
+			decUtils.EvmParams,
 			ctx.IsCheckTx(),
 		)
 		if err != nil {
 			return ctx, err
 		}
 
+		if granted {
+			if err := UseGrantedFees(ctx, md.feegrantKeeper, feeGranter, from, msgFees, msg); err != nil {
+				return ctx, err
+			}
+		}
+
 		err = ConsumeFeesAndEmitEvent(
 			ctx,
 			&ConsumeGasKeepers{
@@ -264,12 +322,29 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				Staking:      md.stakingKeeper,
 			},
 			msgFees,
-			from,
+			feePayer,
 		)
 		if err != nil {
 			return ctx, err
 		}
 
+		// the daily usage of the sender is only updated once the sponsor paid the fees
+		if sponsored && !granted {
+			RecordSponsoredFees(
+				ctx,
+				md.paymasterKeeper,
+				fromAddr,
+				txData,
+				decUtils.BaseFee,
+				decUtils.EvmDenom,
+			)
+		}
+
+		// the leftover gas of a sponsored transaction is refunded to the fee payer
+		if sponsored {
+			md.evmKeeper.SetTxFeePayerTransient(ctx, common.HexToHash(ethMsg.Hash), feePayer)
+		}
+
 		gasWanted := UpdateCumulativeGasWanted(
 			ctx,
 			txData.GetGas(),
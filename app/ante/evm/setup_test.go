@@ -103,6 +103,7 @@ func (suite *AnteTestSuite) SetupTest() {
 		DistributionKeeper: suite.app.DistrKeeper,
 		EvmKeeper:          suite.app.EvmKeeper,
 		FeegrantKeeper:     suite.app.FeeGrantKeeper,
+		PaymasterKeeper:    suite.app.PaymasterKeeper,
 		IBCKeeper:          suite.app.IBCKeeper,
 		StakingKeeper:      suite.app.StakingKeeper,
 		FeeMarketKeeper:    suite.app.FeeMarketKeeper,
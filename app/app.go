@@ -139,13 +139,19 @@ import (
 	v19 "github.com/evmos/evmos/v19/app/upgrades/v19"
 	v191 "github.com/evmos/evmos/v19/app/upgrades/v19_1"
 	v192 "github.com/evmos/evmos/v19/app/upgrades/v19_2"
+	v193 "github.com/evmos/evmos/v19/app/upgrades/v19_3"
 	"github.com/evmos/evmos/v19/encoding"
 	"github.com/evmos/evmos/v19/ethereum/eip712"
 	bankprecompile "github.com/evmos/evmos/v19/precompiles/bank"
 	bech32precompile "github.com/evmos/evmos/v19/precompiles/bech32"
 	distprecompile "github.com/evmos/evmos/v19/precompiles/distribution"
+	feegrantprecompile "github.com/evmos/evmos/v19/precompiles/feegrant"
+	govprecompile "github.com/evmos/evmos/v19/precompiles/gov"
 	ics20precompile "github.com/evmos/evmos/v19/precompiles/ics20"
+	inflationprecompile "github.com/evmos/evmos/v19/precompiles/inflation"
+	networkstateprecompile "github.com/evmos/evmos/v19/precompiles/networkstate"
 	p256precompile "github.com/evmos/evmos/v19/precompiles/p256"
+	slashingprecompile "github.com/evmos/evmos/v19/precompiles/slashing"
 	stakingprecompile "github.com/evmos/evmos/v19/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v19/precompiles/vesting"
 	srvflags "github.com/evmos/evmos/v19/server/flags"
@@ -167,6 +173,9 @@ import (
 	inflation "github.com/evmos/evmos/v19/x/inflation/v1"
 	inflationkeeper "github.com/evmos/evmos/v19/x/inflation/v1/keeper"
 	inflationtypes "github.com/evmos/evmos/v19/x/inflation/v1/types"
+	"github.com/evmos/evmos/v19/x/paymaster"
+	paymasterkeeper "github.com/evmos/evmos/v19/x/paymaster/keeper"
+	paymastertypes "github.com/evmos/evmos/v19/x/paymaster/types"
 	"github.com/evmos/evmos/v19/x/staking"
 	stakingkeeper "github.com/evmos/evmos/v19/x/staking/keeper"
 	"github.com/evmos/evmos/v19/x/vesting"
@@ -243,6 +252,7 @@ var (
 		feemarket.AppModuleBasic{},
 		inflation.AppModuleBasic{},
 		erc20.AppModuleBasic{},
+		paymaster.AppModuleBasic{},
 		epochs.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 		incentives.AppModuleBasic{},
@@ -373,6 +383,7 @@ type Evmos struct {
 	// Evmos keepers
 	InflationKeeper inflationkeeper.Keeper
 	Erc20Keeper     erc20keeper.Keeper
+	PaymasterKeeper paymasterkeeper.Keeper
 	EpochsKeeper    epochskeeper.Keeper
 	VestingKeeper   vestingkeeper.Keeper
 
@@ -494,7 +505,11 @@ func NewEvmos(
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec, app.LegacyAmino(), keys[slashingtypes.StoreKey], stakingKeeper, authAddr,
 	)
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
+	// NOTE: the bank keeper is required to grant allowances to accounts that
+	// do not exist yet. The feegrant module only sets it on its own copy of the
+	// keeper, so it is set here for the feegrant precompile.
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper).
+		SetBankKeeper(app.BankKeeper)
 	app.UpgradeKeeper = *upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp, authAddr)
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.MsgServiceRouter(), app.AccountKeeper)
@@ -574,6 +589,11 @@ func NewEvmos(
 		app.AuthzKeeper, &app.TransferKeeper,
 	)
 
+	app.PaymasterKeeper = paymasterkeeper.NewKeeper(
+		keys[paymastertypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper, app.EvmKeeper,
+	)
+
 	// Create the rate limit keeper
 	app.RateLimitKeeper = *ratelimitkeeper.NewKeeper(
 		appCodec,
@@ -593,20 +613,6 @@ func NewEvmos(
 		app.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
 	)
 
-	// We call this after setting the hooks to ensure that the hooks are set on the keeper
-	evmKeeper.WithStaticPrecompiles(
-		evmkeeper.NewAvailableStaticPrecompiles(
-			*stakingKeeper,
-			app.DistrKeeper,
-			app.BankKeeper,
-			app.Erc20Keeper,
-			app.VestingKeeper,
-			app.AuthzKeeper,
-			app.TransferKeeper,
-			app.IBCKeeper.ChannelKeeper,
-		),
-	)
-
 	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
@@ -621,6 +627,27 @@ func NewEvmos(
 		),
 	)
 
+	// We call this after setting the hooks to ensure that the hooks are set on the keeper
+	evmKeeper.WithStaticPrecompiles(
+		evmkeeper.NewAvailableStaticPrecompiles(
+			evmKeeper,
+			*stakingKeeper,
+			app.DistrKeeper,
+			app.BankKeeper,
+			app.Erc20Keeper,
+			app.VestingKeeper,
+			app.AuthzKeeper,
+			app.TransferKeeper,
+			app.IBCKeeper.ChannelKeeper,
+			app.InflationKeeper,
+			app.EpochsKeeper,
+			app.GovKeeper,
+			appCodec,
+			app.SlashingKeeper,
+			app.FeeGrantKeeper,
+		),
+	)
+
 	// Override the ICS20 app module
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
@@ -715,6 +742,7 @@ func NewEvmos(
 			app.GetSubspace(inflationtypes.ModuleName)),
 		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper,
 			app.GetSubspace(erc20types.ModuleName)),
+		paymaster.NewAppModule(app.PaymasterKeeper),
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, *app.StakingKeeper.Keeper),
 	)
@@ -750,6 +778,7 @@ func NewEvmos(
 		vestingtypes.ModuleName,
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
+		paymastertypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		ratelimittypes.ModuleName,
 	)
@@ -781,6 +810,7 @@ func NewEvmos(
 		vestingtypes.ModuleName,
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
+		paymastertypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		ratelimittypes.ModuleName,
 	)
@@ -817,6 +847,7 @@ func NewEvmos(
 		vestingtypes.ModuleName,
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
+		paymastertypes.ModuleName,
 		epochstypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		ratelimittypes.ModuleName,
@@ -932,6 +963,7 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		EvmKeeper:              app.EvmKeeper,
 		StakingKeeper:          app.StakingKeeper,
 		FeegrantKeeper:         app.FeeGrantKeeper,
+		PaymasterKeeper:        app.PaymasterKeeper,
 		DistributionKeeper:     app.DistrKeeper,
 		IBCKeeper:              app.IBCKeeper,
 		FeeMarketKeeper:        app.FeeMarketKeeper,
@@ -1044,6 +1076,11 @@ func (app *Evmos) BlockedAddrs() map[string]bool {
 		distprecompile.PrecompileAddress,
 		ics20precompile.PrecompileAddress,
 		vestingprecompile.PrecompileAddress,
+		networkstateprecompile.PrecompileAddress,
+		inflationprecompile.PrecompileAddress,
+		govprecompile.PrecompileAddress,
+		slashingprecompile.PrecompileAddress,
+		feegrantprecompile.PrecompileAddress,
 	}
 	for _, addr := range vm.PrecompiledAddressesBerlin {
 		blockedPrecompilesHex = append(blockedPrecompilesHex, addr.Hex())
@@ -1280,6 +1317,15 @@ func (app *Evmos) setupUpgradeHandlers() {
 		),
 	)
 
+	// v19.3 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v193.UpgradeName,
+		v193.CreateUpgradeHandler(
+			app.mm, app.configurator,
+			app.PaymasterKeeper,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1313,6 +1359,11 @@ func (app *Evmos) setupUpgradeHandlers() {
 				Added: []string{ratelimittypes.ModuleName},
 			}
 		}
+	case v193.UpgradeName:
+		// paymaster module is added in v19.3
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{paymastertypes.StoreKey},
+		}
 	default:
 		// no-op
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/evmos/evmos/v19/app/eips"
 	evmconfig "github.com/evmos/evmos/v19/x/evm/config"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
 // The init function of the config file allows to setup the global
@@ -26,4 +27,9 @@ var evmosActivators = map[string]func(*vm.JumpTable){
 	"evmos_0": eips.Enable0000,
 	"evmos_1": eips.Enable0001,
 	"evmos_2": eips.Enable0002,
+
+	// opcode policies enforced together with the x/evm opcode hooks
+	evmtypes.CallDenylistEIP:      eips.EnableCallDenylist,
+	evmtypes.DeployerWhitelistEIP: eips.EnableDeployerWhitelist,
+	evmtypes.SelfdestructBanEIP:   eips.EnableSelfdestructBan,
 }
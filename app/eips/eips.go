@@ -4,6 +4,9 @@
 package eips
 
 import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 )
 
@@ -12,6 +15,10 @@ var (
 	SstoreConstantGas = uint64(500)
 )
 
+// ErrSelfdestructBanned is the revert reason of the SELFDESTRUCT opcode when
+// it is banned.
+var ErrSelfdestructBanned = errors.New("selfdestruct is banned")
+
 // enable0000 contains the logic to modify the CREATE and CREATE2 opcodes
 // constant gas value.
 func Enable0000(jt *vm.JumpTable) {
@@ -34,3 +41,31 @@ func Enable0001(jt *vm.JumpTable) {
 func Enable0002(jt *vm.JumpTable) {
 	jt[vm.SSTORE].SetConstantGas(SstoreConstantGas)
 }
+
+// PolicyCheckGas is the constant gas added to the opcodes checked by the
+// nexqloud policies, to charge the lookup of the policy parameters.
+var PolicyCheckGas = params.WarmStorageReadCostEIP2929
+
+// EnableCallDenylist charges the call denylist check to the CALL, CALLCODE,
+// DELEGATECALL and STATICCALL opcodes. The check itself is run by the x/evm
+// call hook.
+func EnableCallDenylist(jt *vm.JumpTable) {
+	for _, op := range []vm.OpCode{vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL} {
+		jt[op].SetConstantGas(jt[op].GetConstantGas() + PolicyCheckGas)
+	}
+}
+
+// EnableDeployerWhitelist charges the deployer whitelist check to the CREATE
+// and CREATE2 opcodes. The check itself is run by the x/evm create hook.
+func EnableDeployerWhitelist(jt *vm.JumpTable) {
+	for _, op := range []vm.OpCode{vm.CREATE, vm.CREATE2} {
+		jt[op].SetConstantGas(jt[op].GetConstantGas() + PolicyCheckGas)
+	}
+}
+
+// EnableSelfdestructBan reverts the execution of the SELFDESTRUCT opcode.
+func EnableSelfdestructBan(jt *vm.JumpTable) {
+	jt[vm.SELFDESTRUCT].SetExecute(func(_ *uint64, _ *vm.EVMInterpreter, _ *vm.ScopeContext) ([]byte, error) {
+		return vm.RevertReason(ErrSelfdestructBanned.Error()), vm.ErrExecutionReverted
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package eips_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v19/app/eips"
+	"github.com/evmos/evmos/v19/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyActivators(t *testing.T) {
+	rules := params.TestChainConfig.Rules(common.Big0, true)
+
+	testCases := []struct {
+		name      string
+		activator func(*vm.JumpTable)
+		opcodes   []vm.OpCode
+	}{
+		{"call denylist", eips.EnableCallDenylist, []vm.OpCode{vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL}},
+		{"deployer whitelist", eips.EnableDeployerWhitelist, []vm.OpCode{vm.CREATE, vm.CREATE2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			jt := vm.DefaultJumpTable(rules)
+			updated := vm.CopyJumpTable(jt)
+			tc.activator(updated)
+
+			for _, op := range tc.opcodes {
+				require.Equal(t, jt[op].GetConstantGas()+eips.PolicyCheckGas, updated[op].GetConstantGas(), op.String())
+			}
+			// other opcodes are not modified
+			require.Equal(t, jt[vm.SSTORE].GetConstantGas(), updated[vm.SSTORE].GetConstantGas())
+		})
+	}
+}
+
+func TestSelfdestructBan(t *testing.T) {
+	// PUSH1 0x00 SELFDESTRUCT
+	code := []byte{byte(vm.PUSH1), 0x00, byte(vm.SELFDESTRUCT)}
+
+	testCases := []struct {
+		name      string
+		extraEIPs []string
+		expRevert bool
+	}{
+		{"selfdestruct executed without the ban", nil, false},
+		{"selfdestruct executed with EIP-6780", []string{"ethereum_6780"}, false},
+		{"selfdestruct banned", []string{evmtypes.SelfdestructBanEIP}, true},
+		{"selfdestruct banned with EIP-6780", []string{"ethereum_6780", evmtypes.SelfdestructBanEIP}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+			evmKeeper := nw.App.EvmKeeper
+
+			contract := utiltx.GenerateAddress()
+			stateDB := nw.GetStateDB()
+			stateDB.SetCode(contract, code)
+			require.NoError(t, stateDB.Commit())
+
+			config, err := evmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, evmKeeper.ChainID())
+			require.NoError(t, err)
+			config.Params.ExtraEIPs = tc.extraEIPs
+
+			msg := ethtypes.NewMessage(
+				utiltx.GenerateAddress(), &contract, 0, big.NewInt(0), 100_000, big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, true,
+			)
+			txConfig := evmKeeper.TxConfig(ctx, common.Hash{})
+			res, err := evmKeeper.ApplyMessageWithConfig(ctx, msg, nil, true, config, txConfig)
+			require.NoError(t, err)
+
+			if tc.expRevert {
+				require.Equal(t, vm.ErrExecutionReverted.Error(), res.VmError)
+				require.Equal(t, vm.RevertReason(eips.ErrSelfdestructBanned.Error()), res.Ret)
+				require.Equal(t, code, nw.GetStateDB().GetCode(contract))
+			} else {
+				require.False(t, res.Failed(), res.VmError)
+			}
+		})
+	}
+}
+
+func TestPolicyEIPsOrder(t *testing.T) {
+	testCases := []struct {
+		name      string
+		extraEIPs []string
+		expPass   bool
+	}{
+		{"policy EIPs after the upstream EIPs", []string{"ethereum_6780", evmtypes.SelfdestructBanEIP, evmtypes.CallDenylistEIP}, true},
+		{"upstream EIP after a policy EIP", []string{evmtypes.SelfdestructBanEIP, "ethereum_6780"}, false},
+		{"custom EIP after a policy EIP", []string{evmtypes.CallDenylistEIP, "evmos_1"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := evmtypes.DefaultParams()
+			params.ExtraEIPs = tc.extraEIPs
+
+			err := params.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "must be enabled before the policy EIPs")
+			}
+		})
+	}
+}
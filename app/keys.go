@@ -28,6 +28,7 @@ import (
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
 	inflationtypes "github.com/evmos/evmos/v19/x/inflation/v1/types"
+	paymastertypes "github.com/evmos/evmos/v19/x/paymaster/types"
 	vestingtypes "github.com/evmos/evmos/v19/x/vesting/types"
 )
 
@@ -56,6 +57,7 @@ func StoreKeys() (
 		// evmos keys
 		inflationtypes.StoreKey, erc20types.StoreKey,
 		epochstypes.StoreKey, vestingtypes.StoreKey,
+		paymastertypes.StoreKey,
 	}
 
 	keys := sdk.NewKVStoreKeys(storeKeys...)
@@ -0,0 +1,52 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v193
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/cometbft/cometbft/libs/log"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/evmos/evmos/v19/x/paymaster"
+	paymasterkeeper "github.com/evmos/evmos/v19/x/paymaster/keeper"
+	paymastertypes "github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v19.3
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	paymasterKeeper paymasterkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		if err := AddPaymasterModule(ctx, logger, paymasterKeeper, vm); err != nil {
+			return nil, err
+		}
+
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
+
+// AddPaymasterModule initializes the params of the paymaster module, whose store
+// is added in this upgrade. The module version is set on the version map, so that
+// its InitGenesis is not run by the module migrations.
+func AddPaymasterModule(
+	ctx sdk.Context,
+	logger log.Logger,
+	paymasterKeeper paymasterkeeper.Keeper,
+	vm module.VersionMap,
+) error {
+	logger.Info("Initializing the paymaster module params...")
+
+	if err := paymasterKeeper.SetParams(ctx, paymastertypes.DefaultParams()); err != nil {
+		return err
+	}
+	vm[paymastertypes.ModuleName] = paymaster.AppModuleBasic{}.ConsensusVersion()
+
+	logger.Info("Done with the paymaster module")
+	return nil
+}
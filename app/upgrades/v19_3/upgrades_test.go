@@ -0,0 +1,29 @@
+package v193_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/stretchr/testify/require"
+
+	v193 "github.com/evmos/evmos/v19/app/upgrades/v19_3"
+	testnetwork "github.com/evmos/evmos/v19/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v19/x/paymaster"
+	paymastertypes "github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+func TestAddPaymasterModule(t *testing.T) {
+	network := testnetwork.NewUnitTestNetwork()
+	ctx := network.GetContext()
+	paymasterKeeper := network.App.PaymasterKeeper
+
+	// the params of a store added by the upgrade are read as zero values
+	require.NoError(t, paymasterKeeper.SetParams(ctx, paymastertypes.Params{}))
+
+	vm := module.VersionMap{}
+	err := v193.AddPaymasterModule(ctx, ctx.Logger(), paymasterKeeper, vm)
+	require.NoError(t, err)
+
+	require.Equal(t, paymastertypes.DefaultParams(), paymasterKeeper.GetParams(ctx))
+	require.Equal(t, paymaster.AppModuleBasic{}.ConsensusVersion(), vm[paymastertypes.ModuleName])
+}
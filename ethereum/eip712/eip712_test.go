@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 
@@ -22,11 +23,13 @@ import (
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/evmos/evmos/v19/app"
 	"github.com/evmos/evmos/v19/cmd/config"
 	"github.com/evmos/evmos/v19/encoding"
 	"github.com/evmos/evmos/v19/utils"
+	vestingtypes "github.com/evmos/evmos/v19/x/vesting/types"
 
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -242,6 +245,50 @@ func (suite *EIP712TestSuite) TestEIP712() {
 			},
 			expectSuccess: !suite.useLegacyEIP712TypedData,
 		},
+		{
+			title: "Succeeds - MsgFundVestingAccount without Lockup Periods",
+			msgs: []sdk.Msg{
+				vestingtypes.NewMsgFundVestingAccount(
+					params.address,
+					suite.createTestAddress(),
+					time.Unix(1700000000, 0),
+					nil,
+					sdkvesting.Periods{
+						{Length: 100, Amount: suite.makeCoins(suite.denom, math.NewInt(50))},
+					},
+				),
+			},
+			expectSuccess: !suite.useLegacyEIP712TypedData,
+		},
+		{
+			title: "Succeeds - MsgBatchFundVestingAccounts with Different Grant Schedules",
+			msgs: []sdk.Msg{
+				vestingtypes.NewMsgBatchFundVestingAccounts(
+					params.address,
+					[]vestingtypes.VestingGrant{
+						{
+							VestingAddress: suite.createTestAddress().String(),
+							StartTime:      time.Unix(1700000000, 0),
+							VestingPeriods: sdkvesting.Periods{
+								{Length: 100, Amount: suite.makeCoins(suite.denom, math.NewInt(50))},
+							},
+						},
+						{
+							VestingAddress: suite.createTestAddress().String(),
+							StartTime:      time.Unix(1700000000, 0),
+							LockupPeriods: sdkvesting.Periods{
+								{Length: 50, Amount: suite.makeCoins(suite.denom, math.NewInt(50))},
+							},
+							VestingPeriods: sdkvesting.Periods{
+								{Length: 100, Amount: suite.makeCoins(suite.denom, math.NewInt(50))},
+							},
+						},
+					},
+					false,
+				),
+			},
+			expectSuccess: !suite.useLegacyEIP712TypedData,
+		},
 		{
 			title: "Fails - Two MsgVotes with Different Signers",
 			msgs: []sdk.Msg{
@@ -505,9 +552,31 @@ func (suite *EIP712TestSuite) verifyBasicTypedData(signDoc []byte) {
 	flattenedMsgMap, ok := flattened.Value().(map[string]interface{})
 	suite.Require().True(ok)
 
+	// Null fields are signed as empty arrays
+	replaceNullsWithEmptyArrays(flattenedMsgMap)
+
 	suite.Require().Equal(typedData.Message, flattenedMsgMap)
 }
 
+// replaceNullsWithEmptyArrays recursively replaces the null fields of the JSON objects
+// contained in value with empty arrays.
+func replaceNullsWithEmptyArrays(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if field == nil {
+				v[key] = []interface{}{}
+				continue
+			}
+			replaceNullsWithEmptyArrays(field)
+		}
+	case []interface{}:
+		for _, elem := range v {
+			replaceNullsWithEmptyArrays(elem)
+		}
+	}
+}
+
 // TestFlattenPayloadErrorHandling tests error handling in TypedData generation,
 // specifically regarding the payload.
 func (suite *EIP712TestSuite) TestFlattenPayloadErrorHandling() {
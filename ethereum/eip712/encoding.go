@@ -3,6 +3,8 @@
 package eip712
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -12,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	txTypes "github.com/cosmos/cosmos-sdk/types/tx"
 
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	apitypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
 	evmostypes "github.com/evmos/evmos/v19/types"
 
@@ -108,6 +111,11 @@ func decodeAminoSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 		return apitypes.TypedData{}, errors.New("invalid chain ID passed as argument")
 	}
 
+	signDocBytes, err = signDocWithEmptyArraysForNull(signDocBytes)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
 	typedData, err := WrapTxToTypedData(
 		chainID.Uint64(),
 		signDocBytes,
@@ -191,6 +199,11 @@ func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 		tip,
 	)
 
+	signBytes, err = signDocWithEmptyArraysForNull(signBytes)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
 	typedData, err := WrapTxToTypedData(
 		chainID.Uint64(),
 		signBytes,
@@ -222,6 +235,10 @@ func validatePayloadMessages(msgs []sdk.Msg) error {
 	var msgSigner sdk.AccAddress
 
 	for i, m := range msgs {
+		if err := validateLegacyMsg(m); err != nil {
+			return err
+		}
+
 		if len(m.GetSigners()) != 1 {
 			return errors.New("unable to build EIP-712 payload: expect exactly 1 signer")
 		}
@@ -238,3 +255,65 @@ func validatePayloadMessages(msgs []sdk.Msg) error {
 
 	return nil
 }
+
+// signDocWithEmptyArraysForNull returns the JSON sign doc with all null object
+// fields replaced by empty arrays. Amino encodes nil slices (e.g. vesting grants
+// without lockup periods) as null, for which no EIP-712 type can be inferred,
+// while empty arrays are supported by the type generation.
+func signDocWithEmptyArraysForNull(signDocBytes []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(signDocBytes))
+	// preserve the exact representation of numbers
+	decoder.UseNumber()
+
+	var signDoc interface{}
+	if err := decoder.Decode(&signDoc); err != nil {
+		return nil, fmt.Errorf("failed to decode sign doc JSON: %w", err)
+	}
+
+	return json.Marshal(replaceNullFields(signDoc))
+}
+
+// replaceNullFields recursively replaces the null fields of the JSON objects
+// contained in value with empty arrays.
+func replaceNullFields(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if field == nil {
+				v[key] = []interface{}{}
+				continue
+			}
+			v[key] = replaceNullFields(field)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = replaceNullFields(elem)
+		}
+	}
+
+	return value
+}
+
+// ethereumTxMsg is implemented by messages wrapping an Ethereum transaction,
+// which are signed over their RLP encoding instead of a Cosmos sign doc.
+type ethereumTxMsg interface {
+	AsTransaction() *ethtypes.Transaction
+}
+
+// validateLegacyMsg ensures that the message can be encoded as Amino JSON, which
+// is the representation the EIP-712 typed data is derived from.
+func validateLegacyMsg(msg sdk.Msg) error {
+	if _, ok := msg.(ethereumTxMsg); ok {
+		return fmt.Errorf(
+			"unable to build EIP-712 payload: message type %s must be signed as an Ethereum transaction", sdk.MsgTypeURL(msg),
+		)
+	}
+
+	if _, ok := msg.(legacytx.LegacyMsg); !ok {
+		return fmt.Errorf(
+			"unable to build EIP-712 payload: message type %s does not support Amino JSON encoding", sdk.MsgTypeURL(msg),
+		)
+	}
+
+	return nil
+}
@@ -219,6 +219,10 @@ func legacyValidatePayloadMessages(msgs []sdk.Msg) error {
 	var msgSigner sdk.AccAddress
 
 	for i, m := range msgs {
+		if err := validateLegacyMsg(m); err != nil {
+			return err
+		}
+
 		t, err := getMsgType(m)
 		if err != nil {
 			return err
@@ -16,6 +16,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
 )
 
 const (
@@ -180,7 +181,10 @@ func recursivelyAddTypesToRoot(
 				continue
 			}
 
-			field = fieldAsArray[0]
+			field, err = arrayElementForTypes(fieldAsArray)
+			if err != nil {
+				return "", err
+			}
 			isCollection = true
 		}
 
@@ -221,6 +225,44 @@ func recursivelyAddTypesToRoot(
 	return addTypesToRoot(typeMap, typeDef, typesToAdd)
 }
 
+// arrayElementForTypes returns the element used to infer the EIP-712 type of
+// an array. For arrays of objects, the fields of all elements are merged so that
+// a field which is an empty array in the first element (e.g. a vesting grant
+// without lockup periods) is typed from the first element where it is populated.
+func arrayElementForTypes(elems []gjson.Result) (gjson.Result, error) {
+	if !elems[0].IsObject() {
+		return elems[0], nil
+	}
+
+	merged := elems[0].Raw
+	for _, elem := range elems[1:] {
+		if !elem.IsObject() {
+			continue
+		}
+
+		var err error
+		elem.ForEach(func(key, value gjson.Result) bool {
+			current := gjson.Get(merged, gjson.Escape(key.Str))
+			if current.Exists() && !isEmptyArray(current) {
+				return true
+			}
+
+			merged, err = sjson.SetRaw(merged, gjson.Escape(key.Str), value.Raw)
+			return err == nil
+		})
+		if err != nil {
+			return gjson.Result{}, err
+		}
+	}
+
+	return gjson.Parse(merged), nil
+}
+
+// isEmptyArray returns whether the JSON value is an array without elements.
+func isEmptyArray(json gjson.Result) bool {
+	return json.IsArray() && len(json.Array()) == 0
+}
+
 // sortedJSONKeys returns the sorted JSON keys for the input object,
 // to be used for deterministic iteration.
 func sortedJSONKeys(json gjson.Result) ([]string, error) {
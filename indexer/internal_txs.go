@@ -0,0 +1,136 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	evmostypes "github.com/evmos/evmos/v19/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// executedTx defines an eth tx executed in the block being indexed.
+type executedTx struct {
+	msg        *evmtypes.MsgEthereumTx
+	hash       common.Hash
+	ethTxIndex int32
+}
+
+// callFrame defines the subset of the callTracer output used to index the internal txs.
+type callFrame struct {
+	Type  string          `json:"type"`
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to,omitempty"`
+	Error string          `json:"error,omitempty"`
+	Calls []callFrame     `json:"calls,omitempty"`
+}
+
+// callTraceResult defines the callTracer output of a single tx in a block trace.
+type callTraceResult struct {
+	Result *callFrame `json:"result"`
+	Error  string     `json:"error"`
+}
+
+// saveInternalTxs traces the executed eth txs of the block with the callTracer
+// and index the internal call recipients and created contracts into the kv db batch
+func (kv *KVIndexer) saveInternalTxs(batch dbm.Batch, block *tmtypes.Block, txs []executedTx) error {
+	results, err := kv.traceBlock(block, txs)
+	if err != nil {
+		return err
+	}
+	if len(results) != len(txs) {
+		return fmt.Errorf("trace results don't match the txs, expect: %d, got: %d", len(txs), len(results))
+	}
+
+	for i, tx := range txs {
+		if results[i].Result == nil {
+			continue
+		}
+		// the top level call is already indexed from the tx itself
+		for _, frame := range results[i].Result.Calls {
+			if err := saveCallFrame(batch, block.Height, tx, frame); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// saveCallFrame index the recipient of an internal call and its nested calls
+func saveCallFrame(batch dbm.Batch, height int64, tx executedTx, frame callFrame) error {
+	if frame.To != nil {
+		if err := batch.Set(AccountTxKey(*frame.To, height, tx.ethTxIndex), tx.hash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set account-tx key")
+		}
+		if (frame.Type == "CREATE" || frame.Type == "CREATE2") && frame.Error == "" {
+			if err := batch.Set(ContractCreatorKey(*frame.To), append(tx.hash.Bytes(), frame.From.Bytes()...)); err != nil {
+				return errorsmod.Wrap(err, "set contract-creator key")
+			}
+		}
+	}
+	for _, call := range frame.Calls {
+		if err := saveCallFrame(batch, height, tx, call); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// traceBlock traces the eth txs on top of the state at the beginning of the block
+func (kv *KVIndexer) traceBlock(block *tmtypes.Block, txs []executedTx) ([]callTraceResult, error) {
+	nc, ok := kv.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+	cp, err := nc.ConsensusParams(context.Background(), &block.Height)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := evmostypes.ParseChainID(block.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*evmtypes.MsgEthereumTx, len(txs))
+	for i, tx := range txs {
+		msgs[i] = tx.msg
+	}
+
+	// minus one to get the context at the beginning of the block
+	contextHeight := block.Height - 1
+	if contextHeight < 1 {
+		// 0 is a special value for `ContextWithHeight`.
+		contextHeight = 1
+	}
+
+	queryClient := evmtypes.NewQueryClient(kv.clientCtx)
+	res, err := queryClient.TraceBlock(rpctypes.ContextWithHeight(contextHeight), &evmtypes.QueryTraceBlockRequest{
+		Txs:             msgs,
+		TraceConfig:     &evmtypes.TraceConfig{Tracer: "callTracer"},
+		BlockNumber:     block.Height,
+		BlockTime:       block.Time,
+		BlockHash:       common.Bytes2Hex(block.Hash()),
+		ProposerAddress: sdk.ConsAddress(block.ProposerAddress),
+		ChainId:         chainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []callTraceResult
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
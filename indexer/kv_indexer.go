@@ -3,7 +3,10 @@
 package indexer
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	dbm "github.com/cometbft/cometbft-db"
@@ -15,31 +18,68 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	rpctypes "github.com/evmos/evmos/v19/rpc/types"
 	evmostypes "github.com/evmos/evmos/v19/types"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash          = 1
+	KeyPrefixTxIndex         = 2
+	KeyPrefixLogAddress      = 3
+	KeyPrefixLogTopic        = 4
+	KeyPrefixLogIndexedBlock = 5
+	KeyPrefixAccountTx       = 6
+	KeyPrefixSenderNonce     = 7
+	KeyPrefixContractCreator = 8
+	KeyPrefixIndexCursor     = 9
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// LogIndexedBlockKeyLength is the length of log-indexed-block key
+	LogIndexedBlockKeyLength = 1 + 8
+	// AccountTxKeyLength is the length of account-tx key
+	AccountTxKeyLength = 1 + common.AddressLength + 8 + 8
 )
 
-var _ evmostypes.EVMTxIndexer = &KVIndexer{}
+var (
+	_ evmostypes.EVMTxIndexer        = &KVIndexer{}
+	_ evmostypes.EVMLogIndexer       = &KVIndexer{}
+	_ evmostypes.EVMAccountTxIndexer = &KVIndexer{}
+)
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+	// indexLogs enables the secondary (address, height, log index) and
+	// (topic0, height, log index) indexes on the eth tx logs.
+	indexLogs bool
+	// indexInternalTxs enables the tracing of the eth txs to index the
+	// accounts called and the contracts created by internal calls.
+	indexInternalTxs bool
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+}
+
+// WithLogIndex enables or disables the indexing of the eth tx logs by address
+// and first topic.
+func (kv *KVIndexer) WithLogIndex(enable bool) *KVIndexer {
+	kv.indexLogs = enable
+	return kv
+}
+
+// WithInternalTxs enables or disables the tracing of the eth txs to add the
+// internal call recipients and created contracts to the account tx history.
+// The tracing requires the client context to query a running node.
+func (kv *KVIndexer) WithInternalTxs(enable bool) *KVIndexer {
+	kv.indexInternalTxs = enable
+	return kv
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -48,13 +88,35 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	batch, err := kv.prepareBlock(block, txResults)
+	if err != nil {
+		return err
+	}
+	defer batch.Close()
+
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// prepareBlock builds the batch indexing the eth txs of a block, the caller is
+// responsible for writing and closing it.
+func (kv *KVIndexer) prepareBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) (dbm.Batch, error) {
 	height := block.Header.Height
 
 	batch := kv.db.NewBatch()
-	defer batch.Close()
+	prepared := false
+	defer func() {
+		if !prepared {
+			batch.Close()
+		}
+	}()
 
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
+	// executed eth txs, traced to index the internal txs
+	var executedTxs []executedTx
 	for txIndex, tx := range block.Txs {
 		result := txResults[txIndex]
 		if !rpctypes.TxSucessOrExpectedFailure(result) {
@@ -111,14 +173,37 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			ethTxIndex++
 
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+				return nil, errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+			if err := kv.saveAccountTx(batch, ethMsg, txHash, &txResult); err != nil {
+				return nil, errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+			if result.Code == abci.CodeTypeOK {
+				executedTxs = append(executedTxs, executedTx{msg: ethMsg, hash: txHash, ethTxIndex: txResult.EthTxIndex})
+			}
+		}
+
+		if kv.indexLogs && result.Code == abci.CodeTypeOK {
+			if err := saveTxLogs(batch, height, result.Events); err != nil {
+				return nil, errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
 		}
 	}
-	if err := batch.Write(); err != nil {
-		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	if kv.indexInternalTxs && len(executedTxs) > 0 {
+		if err := kv.saveInternalTxs(batch, block, executedTxs); err != nil {
+			// the block is still indexed without the internal txs, e.g. when the state is pruned
+			kv.logger.Error("Fail to index internal txs", "err", err, "block", height)
+		}
 	}
-	return nil
+	if kv.indexLogs {
+		// mark the block as covered by the log indexes, even when it doesn't contain any log,
+		// so that the filter system knows the indexes can be used for this height.
+		if err := batch.Set(LogIndexedBlockKey(height), []byte{1}); err != nil {
+			return nil, errorsmod.Wrapf(err, "IndexBlock %d, set log-indexed-block key", height)
+		}
+	}
+	prepared = true
+	return batch, nil
 }
 
 // LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
@@ -131,6 +216,167 @@ func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
 	return LoadFirstBlock(kv.db)
 }
 
+// LogIndexedRange returns the first and last block numbers covered by the log
+// indexes, returns -1 for both if the log indexes are empty
+func (kv *KVIndexer) LogIndexedRange() (int64, int64, error) {
+	return LoadLogIndexedRange(kv.db)
+}
+
+// GetLogHeights returns the sorted heights within [from, to] that contain at
+// least one log emitted by one of the addresses and with one of the topic0s
+// as first topic. An empty list of addresses or topic0s matches any value.
+func (kv *KVIndexer) GetLogHeights(from, to int64, addresses []common.Address, topic0s []common.Hash) ([]int64, error) {
+	if len(addresses) == 0 && len(topic0s) == 0 {
+		return nil, fmt.Errorf("GetLogHeights requires at least an address or a topic")
+	}
+
+	var (
+		heights map[int64]struct{}
+		err     error
+	)
+	if len(addresses) > 0 {
+		prefixes := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			prefixes[i] = append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+		}
+		if heights, err = kv.logHeights(prefixes, from, to); err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogHeights by address")
+		}
+	}
+
+	if len(topic0s) > 0 {
+		prefixes := make([][]byte, len(topic0s))
+		for i, topic := range topic0s {
+			prefixes[i] = append([]byte{KeyPrefixLogTopic}, topic.Bytes()...)
+		}
+		topicHeights, err := kv.logHeights(prefixes, from, to)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogHeights by topic")
+		}
+		if heights == nil {
+			heights = topicHeights
+		} else {
+			// both filters must match in the same block
+			for height := range heights {
+				if _, ok := topicHeights[height]; !ok {
+					delete(heights, height)
+				}
+			}
+		}
+	}
+
+	result := make([]int64, 0, len(heights))
+	for height := range heights {
+		result = append(result, height)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result, nil
+}
+
+// logHeights collects the heights within [from, to] of the log keys under
+// any of the given prefixes.
+func (kv *KVIndexer) logHeights(prefixes [][]byte, from, to int64) (map[int64]struct{}, error) {
+	heights := make(map[int64]struct{})
+	for _, prefix := range prefixes {
+		start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...)
+		end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to+1))...)
+		if err := func() error {
+			it, err := kv.db.Iterator(start, end)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for ; it.Valid(); it.Next() {
+				key := it.Key()
+				heights[int64(sdk.BigEndianToUint64(key[len(prefix):len(prefix)+8]))] = struct{}{}
+			}
+			return it.Error()
+		}(); err != nil {
+			return nil, err
+		}
+	}
+	return heights, nil
+}
+
+// SearchAccountTxs returns the hashes of the eth txs sent by or to the address,
+// including the internal txs when indexed, in the blocks strictly before (in
+// descending order) or after (in ascending order) the given height. A zero
+// height searches from the latest or the earliest block respectively. At least
+// pageSize txs are returned when available, completing the txs of the last
+// block, together with whether more txs are left to search.
+func (kv *KVIndexer) SearchAccountTxs(address common.Address, height int64, before bool, pageSize int) ([]common.Hash, bool, error) {
+	prefix := append([]byte{KeyPrefixAccountTx}, address.Bytes()...)
+	end := append([]byte{KeyPrefixAccountTx}, address.Bytes()...)
+	end = append(end, bytes.Repeat([]byte{0xff}, 16)...)
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if before {
+		if height > 0 {
+			end = append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+		}
+		it, err = kv.db.ReverseIterator(prefix, end)
+	} else {
+		start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(height+1))...)
+		it, err = kv.db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "SearchAccountTxs %s", address.Hex())
+	}
+	defer it.Close()
+
+	hashes := make([]common.Hash, 0, pageSize)
+	lastHeight := int64(-1)
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != AccountTxKeyLength {
+			return nil, false, fmt.Errorf("wrong account tx key length, expect: %d, got: %d", AccountTxKeyLength, len(key))
+		}
+		keyHeight := int64(sdk.BigEndianToUint64(key[len(prefix) : len(prefix)+8]))
+		if len(hashes) >= pageSize && keyHeight != lastHeight {
+			return hashes, true, nil
+		}
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+		lastHeight = keyHeight
+	}
+	return hashes, false, it.Error()
+}
+
+// GetTxHashBySenderAndNonce returns the hash of the eth tx sent by the sender
+// with the given nonce, returns nil if the tx is not found.
+func (kv *KVIndexer) GetTxHashBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	bz, err := kv.db.Get(SenderNonceKey(sender, nonce))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetTxHashBySenderAndNonce %s %d", sender.Hex(), nonce)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
+// GetContractCreator returns the eth tx and the account that created the
+// contract, returns nil if the contract creation is not indexed.
+func (kv *KVIndexer) GetContractCreator(contract common.Address) (*evmostypes.ContractCreator, error) {
+	bz, err := kv.db.Get(ContractCreatorKey(contract))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreator %s", contract.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	if len(bz) != common.HashLength+common.AddressLength {
+		return nil, fmt.Errorf("wrong contract creator value length, expect: %d, got: %d", common.HashLength+common.AddressLength, len(bz))
+	}
+	return &evmostypes.ContractCreator{
+		TxHash:  common.BytesToHash(bz[:common.HashLength]),
+		Creator: common.BytesToAddress(bz[common.HashLength:]),
+	}, nil
+}
+
 // GetByTxHash finds eth tx by eth tx hash
 func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*evmostypes.TxResult, error) {
 	bz, err := kv.db.Get(TxHashKey(hash))
@@ -171,6 +417,80 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// LogAddressKey returns the key for db entry: `(address, block number, log index) -> tx hash`
+func LogAddressKey(address common.Address, blockNumber int64, logIndex uint) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(logIndex))
+	return append(append(append([]byte{KeyPrefixLogAddress}, address.Bytes()...), bz1...), bz2...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic0, block number, log index) -> tx hash`
+func LogTopicKey(topic common.Hash, blockNumber int64, logIndex uint) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(logIndex))
+	return append(append(append([]byte{KeyPrefixLogTopic}, topic.Bytes()...), bz1...), bz2...)
+}
+
+// LogIndexedBlockKey returns the key for db entry: `block number -> log indexed marker`
+func LogIndexedBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLogIndexedBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+// AccountTxKey returns the key for db entry: `(address, block number, tx index) -> tx hash`
+func AccountTxKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))
+	return append(append(append([]byte{KeyPrefixAccountTx}, address.Bytes()...), bz1...), bz2...)
+}
+
+// SenderNonceKey returns the key for db entry: `(sender, nonce) -> tx hash`
+func SenderNonceKey(sender common.Address, nonce uint64) []byte {
+	return append(append([]byte{KeyPrefixSenderNonce}, sender.Bytes()...), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// ContractCreatorKey returns the key for db entry: `contract address -> (tx hash, creator)`
+func ContractCreatorKey(contract common.Address) []byte {
+	return append([]byte{KeyPrefixContractCreator}, contract.Bytes()...)
+}
+
+// LoadLogIndexedRange returns the first and last block numbers covered by the
+// log indexes, returns -1 for both if the log indexes are empty
+func LoadLogIndexedRange(db dbm.DB) (int64, int64, error) {
+	first, err := loadLogIndexedBlock(db, false)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LoadLogIndexedRange")
+	}
+	last, err := loadLogIndexedBlock(db, true)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LoadLogIndexedRange")
+	}
+	return first, last, nil
+}
+
+func loadLogIndexedBlock(db dbm.DB, reverse bool) (int64, error) {
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		it, err = db.ReverseIterator([]byte{KeyPrefixLogIndexedBlock}, []byte{KeyPrefixLogIndexedBlock + 1})
+	} else {
+		it, err = db.Iterator([]byte{KeyPrefixLogIndexedBlock}, []byte{KeyPrefixLogIndexedBlock + 1})
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	key := it.Key()
+	if len(key) != LogIndexedBlockKeyLength {
+		return 0, fmt.Errorf("wrong log indexed block key length, expect: %d, got: %d", LogIndexedBlockKeyLength, len(key))
+	}
+	return int64(sdk.BigEndianToUint64(key[1:])), nil
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -222,6 +542,85 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveAccountTx index the eth tx in the history of its sender and recipient,
+// or of the created contract, into the kv db batch
+func (kv *KVIndexer) saveAccountTx(batch dbm.Batch, msg *evmtypes.MsgEthereumTx, txHash common.Hash, txResult *evmostypes.TxResult) error {
+	tx := msg.AsTransaction()
+	if tx == nil {
+		return fmt.Errorf("invalid tx data, hash: %s", txHash.Hex())
+	}
+	from, err := txSender(msg)
+	if err != nil {
+		kv.logger.Error("Fail to recover tx sender", "err", err, "hash", txHash.Hex())
+		return nil
+	}
+
+	if err := batch.Set(AccountTxKey(from, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set account-tx key")
+	}
+	if err := batch.Set(SenderNonceKey(from, tx.Nonce()), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set sender-nonce key")
+	}
+
+	if to := tx.To(); to != nil {
+		if err := batch.Set(AccountTxKey(*to, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set account-tx key")
+		}
+		return nil
+	}
+	if txResult.Failed {
+		return nil
+	}
+
+	contract := crypto.CreateAddress(from, tx.Nonce())
+	if err := batch.Set(AccountTxKey(contract, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set account-tx key")
+	}
+	if err := batch.Set(ContractCreatorKey(contract), append(txHash.Bytes(), from.Bytes()...)); err != nil {
+		return errorsmod.Wrap(err, "set contract-creator key")
+	}
+	return nil
+}
+
+// txSender returns the sender of the eth tx, recovering it from the signature when not set
+func txSender(msg *evmtypes.MsgEthereumTx) (common.Address, error) {
+	if msg.From != "" {
+		return common.HexToAddress(msg.From), nil
+	}
+	return msg.GetSender(msg.AsTransaction().ChainId())
+}
+
+// saveTxLogs index the logs of an eth tx by address and first topic into the kv db batch
+func saveTxLogs(batch dbm.Batch, height int64, events []abci.Event) error {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyTxLog {
+				continue
+			}
+
+			var log evmtypes.Log
+			if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+				return errorsmod.Wrap(err, "unmarshal tx log")
+			}
+
+			txHash := common.HexToHash(log.TxHash)
+			if err := batch.Set(LogAddressKey(common.HexToAddress(log.Address), height, uint(log.Index)), txHash.Bytes()); err != nil {
+				return errorsmod.Wrap(err, "set log-address key")
+			}
+			if len(log.Topics) == 0 {
+				continue
+			}
+			if err := batch.Set(LogTopicKey(common.HexToHash(log.Topics[0]), height, uint(log.Index)), txHash.Bytes()); err != nil {
+				return errorsmod.Wrap(err, "set log-topic key")
+			}
+		}
+	}
+	return nil
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -1,6 +1,7 @@
 package indexer_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -12,11 +13,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/evmos/v19/app"
 	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
 	evmenc "github.com/evmos/evmos/v19/encoding"
 	"github.com/evmos/evmos/v19/indexer"
 	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	evmostypes "github.com/evmos/evmos/v19/types"
 	"github.com/evmos/evmos/v19/utils"
 	"github.com/evmos/evmos/v19/x/evm/types"
 	"github.com/stretchr/testify/require"
@@ -188,6 +191,246 @@ func TestKVIndexer(t *testing.T) {
 	}
 }
 
+func TestKVIndexerLogIndex(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 21000,
+	})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	token1, token2 := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	transferTopic := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	approvalTopic := common.HexToHash("0x8c5be1e5ebec7d5bd14f71427e1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	txLog := func(address common.Address, topic common.Hash, index uint64) abci.EventAttribute {
+		bz, err := json.Marshal(&types.Log{
+			Address: address.Hex(),
+			Topics:  []string{topic.Hex()},
+			TxHash:  txHash.Hex(),
+			Index:   index,
+		})
+		require.NoError(t, err)
+		return abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)}
+	}
+
+	// block 2 contains a tx emitting a Transfer log from token1 and an Approval log from token2
+	// and block 3 doesn't contain any tx
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 2}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+	blockResult := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: to.Hex()},
+				}},
+				{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					txLog(token1, transferTopic, 0),
+					txLog(token2, approvalTopic, 1),
+				}},
+			},
+		},
+	}
+	emptyBlock := &tmtypes.Block{Header: tmtypes.Header{Height: 3}}
+
+	t.Run("log index disabled", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+		require.NoError(t, idxer.IndexBlock(block, blockResult))
+
+		first, last, err := idxer.LogIndexedRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), first)
+		require.Equal(t, int64(-1), last)
+
+		heights, err := idxer.GetLogHeights(1, 3, []common.Address{token1}, nil)
+		require.NoError(t, err)
+		require.Empty(t, heights)
+	})
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx).WithLogIndex(true)
+	require.NoError(t, idxer.IndexBlock(block, blockResult))
+	require.NoError(t, idxer.IndexBlock(emptyBlock, nil))
+
+	first, last, err := idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), first)
+	require.Equal(t, int64(3), last)
+
+	// the eth tx is still indexed
+	res, err := idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Height)
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topic0s   []common.Hash
+		expPass   bool
+		expResult []int64
+	}{
+		{"fail - no address nor topic", 1, 3, nil, nil, false, nil},
+		{"pass - by address", 1, 3, []common.Address{token1}, nil, true, []int64{2}},
+		{"pass - by any of the addresses", 1, 3, []common.Address{utiltx.GenerateAddress(), token2}, nil, true, []int64{2}},
+		{"pass - by topic", 1, 3, nil, []common.Hash{transferTopic}, true, []int64{2}},
+		{"pass - by address and topic", 1, 3, []common.Address{token1}, []common.Hash{transferTopic}, true, []int64{2}},
+		{"pass - address and topic in different logs of the same block", 1, 3, []common.Address{token1}, []common.Hash{approvalTopic}, true, []int64{2}},
+		{"pass - unknown address", 1, 3, []common.Address{utiltx.GenerateAddress()}, nil, true, []int64{}},
+		{"pass - unknown topic", 1, 3, []common.Address{token1}, []common.Hash{common.HexToHash("0x01")}, true, []int64{}},
+		{"pass - out of range", 3, 10, []common.Address{token1}, nil, true, []int64{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			heights, err := idxer.GetLogHeights(tc.from, tc.to, tc.addresses, tc.topic0s)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expResult, heights)
+		})
+	}
+}
+
+func TestKVIndexerAccountTxs(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// buildBlock builds a block with one eth tx from the sender and its result
+	buildBlock := func(height int64, nonce uint64, to *common.Address, code uint32) (*tmtypes.Block, []*abci.ResponseDeliverTx, common.Hash) {
+		tx := types.NewTx(&types.EvmTxArgs{
+			Nonce:    nonce,
+			To:       to,
+			Amount:   big.NewInt(1000),
+			GasLimit: 100000,
+		})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+		result := &abci.ResponseDeliverTx{Code: code}
+		if code == abci.CodeTypeOK {
+			result.Events = []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			}
+		} else {
+			result.Log = "out of gas in location: block gas meter; gasWanted: 100000"
+		}
+		return block, []*abci.ResponseDeliverTx{result}, txHash
+	}
+
+	recipient := utiltx.GenerateAddress()
+	contract := crypto.CreateAddress(from, 1)
+	failedContract := crypto.CreateAddress(from, 3)
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+	var hashes []common.Hash
+	for i, tc := range []struct {
+		to   *common.Address
+		code uint32
+	}{
+		{&recipient, abci.CodeTypeOK},
+		{nil, abci.CodeTypeOK},
+		{&recipient, abci.CodeTypeOK},
+		{nil, 11},
+	} {
+		block, result, hash := buildBlock(int64(i+1), uint64(i), tc.to, tc.code)
+		require.NoError(t, idxer.IndexBlock(block, result))
+		hashes = append(hashes, hash)
+	}
+
+	t.Run("search txs", func(t *testing.T) {
+		testCases := []struct {
+			name      string
+			address   common.Address
+			height    int64
+			before    bool
+			pageSize  int
+			expHashes []common.Hash
+			expMore   bool
+		}{
+			{"sender, before latest", from, 0, true, 10, []common.Hash{hashes[3], hashes[2], hashes[1], hashes[0]}, false},
+			{"sender, before latest, paginated", from, 0, true, 2, []common.Hash{hashes[3], hashes[2]}, true},
+			{"sender, before height", from, 3, true, 10, []common.Hash{hashes[1], hashes[0]}, false},
+			{"sender, after earliest", from, 0, false, 10, hashes, false},
+			{"sender, after height, paginated", from, 1, false, 2, []common.Hash{hashes[1], hashes[2]}, true},
+			{"recipient", recipient, 0, true, 10, []common.Hash{hashes[2], hashes[0]}, false},
+			{"created contract", contract, 0, false, 10, []common.Hash{hashes[1]}, false},
+			{"failed contract creation", failedContract, 0, false, 10, []common.Hash{}, false},
+			{"unknown address", utiltx.GenerateAddress(), 0, true, 10, []common.Hash{}, false},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				res, more, err := idxer.SearchAccountTxs(tc.address, tc.height, tc.before, tc.pageSize)
+				require.NoError(t, err)
+				require.Equal(t, tc.expHashes, res)
+				require.Equal(t, tc.expMore, more)
+			})
+		}
+	})
+
+	t.Run("get tx by sender and nonce", func(t *testing.T) {
+		hash, err := idxer.GetTxHashBySenderAndNonce(from, 2)
+		require.NoError(t, err)
+		require.Equal(t, hashes[2], *hash)
+
+		hash, err = idxer.GetTxHashBySenderAndNonce(from, 10)
+		require.NoError(t, err)
+		require.Nil(t, hash)
+	})
+
+	t.Run("get contract creator", func(t *testing.T) {
+		creator, err := idxer.GetContractCreator(contract)
+		require.NoError(t, err)
+		require.Equal(t, &evmostypes.ContractCreator{TxHash: hashes[1], Creator: from}, creator)
+
+		creator, err = idxer.GetContractCreator(failedContract)
+		require.NoError(t, err)
+		require.Nil(t, creator)
+	})
+}
+
 // MakeEncodingConfig creates the EncodingConfig
 func MakeEncodingConfig() params.EncodingConfig {
 	return evmenc.MakeConfig(app.ModuleBasics)
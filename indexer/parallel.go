@@ -0,0 +1,176 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockLoader loads a block and its ABCI responses, it must be safe for concurrent use.
+type BlockLoader func(height int64) (*tmtypes.Block, []*abci.ResponseDeliverTx, error)
+
+// ProgressFunc is called after a block is written with the number of blocks
+// written so far.
+type ProgressFunc func(height int64, written int64)
+
+// preparedBlock is the batch of a block prepared by a worker.
+type preparedBlock struct {
+	height int64
+	batch  dbm.Batch
+	err    error
+}
+
+// IndexBlocks indexes the blocks from start to end, in descending order if
+// start is greater than end. The blocks are loaded and decoded concurrently by
+// the workers, while the batches are written in height order together with
+// the cursor, so an interrupted indexing can resume from IndexCursor.
+func (kv *KVIndexer) IndexBlocks(
+	ctx context.Context,
+	start, end int64,
+	workers int,
+	cursor string,
+	load BlockLoader,
+	progress ProgressFunc,
+) error {
+	if workers < 1 {
+		return fmt.Errorf("invalid number of workers %d", workers)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	step := int64(1)
+	if start > end {
+		step = -1
+	}
+
+	// each height gets its own result channel, queued in height order so the
+	// writer consumes the batches in order while the workers run ahead.
+	jobs := make(chan int64)
+	slots := make(map[int64]chan preparedBlock)
+	var slotsMu sync.Mutex
+	results := make(chan chan preparedBlock, workers*2)
+
+	go func() {
+		defer close(jobs)
+		defer close(results)
+		for height := start; height != end+step; height += step {
+			slot := make(chan preparedBlock, 1)
+			slotsMu.Lock()
+			slots[height] = slot
+			slotsMu.Unlock()
+
+			select {
+			case results <- slot:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- height:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for height := range jobs {
+				slotsMu.Lock()
+				slot := slots[height]
+				delete(slots, height)
+				slotsMu.Unlock()
+
+				slot <- kv.loadAndPrepareBlock(height, load)
+			}
+		}()
+	}
+	defer func() {
+		// stop the producer and the workers, then release the batches that
+		// were prepared but not written
+		cancel()
+		wg.Wait()
+		for slot := range results {
+			select {
+			case prepared := <-slot:
+				if prepared.batch != nil {
+					prepared.batch.Close()
+				}
+			default:
+			}
+		}
+	}()
+
+	var written int64
+	for slot := range results {
+		var prepared preparedBlock
+		select {
+		case prepared = <-slot:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if prepared.err != nil {
+			return prepared.err
+		}
+
+		if err := kv.writeBlock(prepared, cursor); err != nil {
+			return err
+		}
+		written++
+		if progress != nil {
+			progress(prepared.height, written)
+		}
+	}
+	return ctx.Err()
+}
+
+// loadAndPrepareBlock loads a block and builds its batch
+func (kv *KVIndexer) loadAndPrepareBlock(height int64, load BlockLoader) preparedBlock {
+	block, txResults, err := load(height)
+	if err != nil {
+		return preparedBlock{height: height, err: errorsmod.Wrapf(err, "load block %d", height)}
+	}
+	batch, err := kv.prepareBlock(block, txResults)
+	return preparedBlock{height: height, batch: batch, err: err}
+}
+
+// writeBlock writes the batch of a block together with the cursor
+func (kv *KVIndexer) writeBlock(prepared preparedBlock, cursor string) error {
+	defer prepared.batch.Close()
+
+	if err := prepared.batch.Set(IndexCursorKey(cursor), sdk.Uint64ToBigEndian(uint64(prepared.height))); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set cursor", prepared.height)
+	}
+	if err := prepared.batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", prepared.height)
+	}
+	return nil
+}
+
+// IndexCursor returns the last block written by IndexBlocks with the cursor,
+// returns -1 if the cursor is not found
+func (kv *KVIndexer) IndexCursor(cursor string) (int64, error) {
+	bz, err := kv.db.Get(IndexCursorKey(cursor))
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "IndexCursor %s", cursor)
+	}
+	if len(bz) == 0 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+// IndexCursorKey returns the key for db entry: `cursor name -> last written block number`
+func IndexCursorKey(cursor string) []byte {
+	return append([]byte{KeyPrefixIndexCursor}, []byte(cursor)...)
+}
@@ -0,0 +1,157 @@
+package indexer_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v19/indexer"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVIndexerIndexBlocks(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// blocks 1 to 10 contain one eth tx each
+	const blocks = 10
+	to := utiltx.GenerateAddress()
+	blks := make(map[int64]*tmtypes.Block, blocks)
+	results := make(map[int64][]*abci.ResponseDeliverTx, blocks)
+	hashes := make(map[int64]common.Hash, blocks)
+	for height := int64(1); height <= blocks; height++ {
+		tx := types.NewTx(&types.EvmTxArgs{Nonce: uint64(height - 1), To: &to, Amount: big.NewInt(1000), GasLimit: 21000})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		txHash := tx.AsTransaction().Hash()
+		hashes[height] = txHash
+		blks[height] = &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+		results[height] = []*abci.ResponseDeliverTx{{
+			Code:    abci.CodeTypeOK,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		}}
+	}
+	errLoad := errors.New("load failed")
+
+	testCases := []struct {
+		name      string
+		start     int64
+		end       int64
+		failAt    int64
+		expOrder  []int64
+		expCursor int64
+		expPass   bool
+	}{
+		{"forward", 1, blocks, 0, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, blocks, true},
+		{"backward", blocks, 1, 0, []int64{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}, 1, true},
+		{"single block", 4, 4, 0, []int64{4}, 4, true},
+		{"forward, stops at the failed block", 1, blocks, 6, []int64{1, 2, 3, 4, 5}, 5, false},
+		{"backward, stops at the failed block", blocks, 1, 6, []int64{10, 9, 8, 7}, 7, false},
+		{"fails at the first block", 1, blocks, 1, []int64{}, -1, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+			load := func(height int64) (*tmtypes.Block, []*abci.ResponseDeliverTx, error) {
+				if height == tc.failAt {
+					return nil, nil, errLoad
+				}
+				return blks[height], results[height], nil
+			}
+			order := []int64{}
+			progress := func(height, written int64) {
+				order = append(order, height)
+				require.Equal(t, int64(len(order)), written)
+			}
+
+			err := idxer.IndexBlocks(context.Background(), tc.start, tc.end, 4, "test", load, progress)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errLoad)
+			}
+			require.Equal(t, tc.expOrder, order)
+
+			cursor, err := idxer.IndexCursor("test")
+			require.NoError(t, err)
+			require.Equal(t, tc.expCursor, cursor)
+
+			// only the written blocks are indexed
+			written := make(map[int64]bool, len(order))
+			for _, height := range order {
+				written[height] = true
+			}
+			for height := int64(1); height <= blocks; height++ {
+				res, err := idxer.GetByTxHash(hashes[height])
+				if written[height] {
+					require.NoError(t, err)
+					require.Equal(t, height, res.Height)
+				} else {
+					require.Error(t, err)
+				}
+			}
+
+			// the other cursors are untouched
+			other, err := idxer.IndexCursor("other")
+			require.NoError(t, err)
+			require.Equal(t, int64(-1), other)
+		})
+	}
+}
+
+func TestKVIndexerIndexBlocksCanceled(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	load := func(height int64) (*tmtypes.Block, []*abci.ResponseDeliverTx, error) {
+		return &tmtypes.Block{Header: tmtypes.Header{Height: height}}, nil, nil
+	}
+	progress := func(height, _ int64) {
+		if height == 3 {
+			cancel()
+		}
+	}
+
+	err := idxer.IndexBlocks(ctx, 1, 1000, 2, "test", load, progress)
+	require.ErrorIs(t, err, context.Canceled)
+
+	cursor, err := idxer.IndexCursor("test")
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, cursor, int64(3))
+	require.Less(t, cursor, int64(1000))
+}
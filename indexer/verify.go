@@ -0,0 +1,233 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package indexer
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmostypes "github.com/evmos/evmos/v19/types"
+)
+
+// TxResultMismatch defines an indexed eth tx whose result differs from the one
+// built from the block and its ABCI responses.
+type TxResultMismatch struct {
+	Hash     common.Hash
+	Expected *evmostypes.TxResult
+	Actual   *evmostypes.TxResult
+}
+
+// BlockDiff defines the inconsistencies found between the indexed eth txs of a
+// block and the ones built from the block and its ABCI responses.
+type BlockDiff struct {
+	Height int64
+	// Missing are the eth txs of the block that are not indexed.
+	Missing []common.Hash
+	// Extra are the eth txs indexed at the block height that are not part of it.
+	Extra []common.Hash
+	// Mismatched are the eth txs indexed with a different result.
+	Mismatched []TxResultMismatch
+}
+
+// IsEmpty returns true if no inconsistency was found.
+func (d BlockDiff) IsEmpty() bool {
+	return len(d.Missing) == 0 && len(d.Extra) == 0 && len(d.Mismatched) == 0
+}
+
+// VerifyBlock compares the indexed eth txs of the block against the ones built
+// from the block and its ABCI responses.
+func (kv *KVIndexer) VerifyBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) (BlockDiff, error) {
+	height := block.Header.Height
+	diff := BlockDiff{Height: height}
+
+	expected := NewKVIndexer(dbm.NewMemDB(), kv.logger, kv.clientCtx)
+	if err := expected.IndexBlock(block, txResults); err != nil {
+		return diff, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+	expectedHashes, err := loadBlockTxHashes(expected.db, height)
+	if err != nil {
+		return diff, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+	actualHashes, err := loadBlockTxHashes(kv.db, height)
+	if err != nil {
+		return diff, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+
+	expectedSet := make(map[common.Hash]struct{}, len(expectedHashes))
+	for _, ethTxIndex := range sortedTxIndexes(expectedHashes) {
+		hash := expectedHashes[ethTxIndex]
+		expectedSet[hash] = struct{}{}
+
+		expectedRes, err := expected.GetByTxHash(hash)
+		if err != nil {
+			return diff, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+		}
+		bz, err := kv.db.Get(TxHashKey(hash))
+		if err != nil {
+			return diff, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+		}
+		if len(bz) == 0 {
+			diff.Missing = append(diff.Missing, hash)
+			continue
+		}
+
+		var actualRes evmostypes.TxResult
+		if err := kv.clientCtx.Codec.Unmarshal(bz, &actualRes); err != nil {
+			// a corrupted entry is reported without actual result, so it gets rewritten
+			diff.Mismatched = append(diff.Mismatched, TxResultMismatch{Hash: hash, Expected: expectedRes})
+			continue
+		}
+		// the tx result must match and be reachable from its eth tx index
+		if actualRes != *expectedRes || actualHashes[ethTxIndex] != hash {
+			diff.Mismatched = append(diff.Mismatched, TxResultMismatch{Hash: hash, Expected: expectedRes, Actual: &actualRes})
+		}
+	}
+
+	for _, ethTxIndex := range sortedTxIndexes(actualHashes) {
+		hash := actualHashes[ethTxIndex]
+		if _, ok := expectedSet[hash]; !ok {
+			diff.Extra = append(diff.Extra, hash)
+		}
+	}
+	return diff, nil
+}
+
+// RepairBlock removes the eth txs indexed at the block height, together with
+// their log, account tx, sender nonce and contract creator entries, and indexes
+// the block again. The logs are indexed again when the block was covered by the
+// log indexes, even if the indexer doesn't index the logs. The internal txs are
+// only indexed again when the indexer traces them.
+func (kv *KVIndexer) RepairBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	height := block.Header.Height
+	hashes, err := loadBlockTxHashes(kv.db, height)
+	if err != nil {
+		return errorsmod.Wrapf(err, "RepairBlock %d", height)
+	}
+	logIndexed, err := kv.db.Has(LogIndexedBlockKey(height))
+	if err != nil {
+		return errorsmod.Wrapf(err, "RepairBlock %d", height)
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	// the eth txs indexed at the height, and the ones whose tx result is removed
+	stale := make(map[common.Hash]struct{}, len(hashes))
+	removed := make(map[common.Hash]struct{}, len(hashes))
+	for ethTxIndex, hash := range hashes {
+		stale[hash] = struct{}{}
+		if err := batch.Delete(TxIndexKey(height, ethTxIndex)); err != nil {
+			return errorsmod.Wrapf(err, "RepairBlock %d, delete tx-index key", height)
+		}
+		// keep the tx result of a tx hash indexed at another height
+		if res, err := kv.GetByTxHash(hash); err == nil && res.Height != height {
+			continue
+		}
+		removed[hash] = struct{}{}
+		if err := batch.Delete(TxHashKey(hash)); err != nil {
+			return errorsmod.Wrapf(err, "RepairBlock %d, delete tx-hash key", height)
+		}
+	}
+	if err := deleteSecondaryKeys(kv.db, batch, height, stale, removed); err != nil {
+		return errorsmod.Wrapf(err, "RepairBlock %d", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "RepairBlock %d, write batch", height)
+	}
+
+	repairer := *kv
+	repairer.indexLogs = kv.indexLogs || logIndexed
+	return repairer.IndexBlock(block, txResults)
+}
+
+// deleteSecondaryKeys deletes into the batch the log and account tx entries of
+// the stale eth txs at the height, and the sender nonce and contract creator
+// entries of the removed eth txs. The entries are keyed by address, topic or
+// nonce first, so all of them are scanned.
+func deleteSecondaryKeys(db dbm.DB, batch dbm.Batch, height int64, stale, removed map[common.Hash]struct{}) error {
+	it, err := db.Iterator([]byte{KeyPrefixLogAddress}, []byte{KeyPrefixContractCreator + 1})
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key, value := it.Key(), it.Value()
+		if len(value) < common.HashLength {
+			continue
+		}
+		hash := common.BytesToHash(value[:common.HashLength])
+
+		var hashes map[common.Hash]struct{}
+		switch key[0] {
+		case KeyPrefixLogAddress, KeyPrefixAccountTx:
+			if keyHeight(key, 1+common.AddressLength) != height {
+				continue
+			}
+			hashes = stale
+		case KeyPrefixLogTopic:
+			if keyHeight(key, 1+common.HashLength) != height {
+				continue
+			}
+			hashes = stale
+		case KeyPrefixSenderNonce, KeyPrefixContractCreator:
+			hashes = removed
+		default:
+			continue
+		}
+
+		if _, ok := hashes[hash]; !ok {
+			continue
+		}
+		if err := batch.Delete(append([]byte{}, key...)); err != nil {
+			return errorsmod.Wrap(err, "delete secondary key")
+		}
+	}
+	return it.Error()
+}
+
+// keyHeight returns the block number stored at the offset of the key, returns
+// -1 if the key is too short
+func keyHeight(key []byte, offset int) int64 {
+	if len(key) < offset+8 {
+		return -1
+	}
+	return int64(sdk.BigEndianToUint64(key[offset : offset+8]))
+}
+
+// loadBlockTxHashes returns the eth tx hashes indexed at the height by eth tx index
+func loadBlockTxHashes(db dbm.DB, height int64) (map[int32]common.Hash, error) {
+	start := append([]byte{KeyPrefixTxIndex}, sdk.Uint64ToBigEndian(uint64(height))...)
+	end := append([]byte{KeyPrefixTxIndex}, sdk.Uint64ToBigEndian(uint64(height+1))...)
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	hashes := make(map[int32]common.Hash)
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != TxIndexKeyLength {
+			return nil, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
+		}
+		hashes[int32(sdk.BigEndianToUint64(key[9:]))] = common.BytesToHash(it.Value()) //#nosec G701 -- the eth tx index is stored from an int32
+	}
+	return hashes, it.Error()
+}
+
+// sortedTxIndexes returns the eth tx indexes of the hashes in ascending order
+func sortedTxIndexes(hashes map[int32]common.Hash) []int32 {
+	indexes := make([]int32, 0, len(hashes))
+	for ethTxIndex := range hashes {
+		indexes = append(indexes, ethTxIndex)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	return indexes
+}
@@ -0,0 +1,234 @@
+package indexer_test
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v19/indexer"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	evmostypes "github.com/evmos/evmos/v19/types"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+// buildEthBlock returns a block at height 1 that contains two eth txs sent by
+// the returned address, together with their results and hashes
+func buildEthBlock(t *testing.T, clientCtx client.Context) (common.Address, *tmtypes.Block, []*abci.ResponseDeliverTx, []common.Hash) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := utiltx.GenerateAddress()
+	var (
+		txs       []tmtypes.Tx
+		txResults []*abci.ResponseDeliverTx
+		hashes    []common.Hash
+	)
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		tx := types.NewTx(&types.EvmTxArgs{Nonce: nonce, To: &to, Amount: big.NewInt(1000), GasLimit: 21000})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		txHash := tx.AsTransaction().Hash()
+		txs = append(txs, txBz)
+		hashes = append(hashes, txHash)
+		txResults = append(txResults, &abci.ResponseDeliverTx{
+			Code:    abci.CodeTypeOK,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: strconv.FormatUint(nonce, 10)},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		})
+	}
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: txs}}
+	return from, block, txResults, hashes
+}
+
+func TestKVIndexerVerifyBlock(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// block 1 contains two eth txs
+	_, block, txResults, hashes := buildEthBlock(t, clientCtx)
+	extraHash := common.HexToHash("0x1")
+
+	testCases := []struct {
+		name          string
+		malleate      func(db dbm.DB)
+		expMissing    []common.Hash
+		expExtra      []common.Hash
+		expMismatched []common.Hash
+	}{
+		{
+			"consistent block",
+			func(dbm.DB) {},
+			nil, nil, nil,
+		},
+		{
+			"block not indexed",
+			func(db dbm.DB) {
+				for i, hash := range hashes {
+					require.NoError(t, db.Delete(indexer.TxHashKey(hash)))
+					require.NoError(t, db.Delete(indexer.TxIndexKey(1, int32(i))))
+				}
+			},
+			hashes, nil, nil,
+		},
+		{
+			"missing tx result",
+			func(db dbm.DB) {
+				require.NoError(t, db.Delete(indexer.TxHashKey(hashes[1])))
+			},
+			[]common.Hash{hashes[1]}, nil, nil,
+		},
+		{
+			"extra tx",
+			func(db dbm.DB) {
+				require.NoError(t, db.Set(indexer.TxIndexKey(1, 2), extraHash.Bytes()))
+			},
+			nil, []common.Hash{extraHash}, nil,
+		},
+		{
+			"mismatched gas used",
+			func(db dbm.DB) {
+				res := evmostypes.TxResult{Height: 1, TxIndex: 1, EthTxIndex: 1, GasUsed: 30000, CumulativeGasUsed: 30000}
+				require.NoError(t, db.Set(indexer.TxHashKey(hashes[1]), clientCtx.Codec.MustMarshal(&res)))
+			},
+			nil, nil, []common.Hash{hashes[1]},
+		},
+		{
+			"mismatched eth tx index",
+			func(db dbm.DB) {
+				require.NoError(t, db.Set(indexer.TxIndexKey(1, 0), hashes[1].Bytes()))
+				require.NoError(t, db.Set(indexer.TxIndexKey(1, 1), hashes[0].Bytes()))
+			},
+			nil, nil, hashes,
+		},
+		{
+			"corrupted tx result",
+			func(db dbm.DB) {
+				require.NoError(t, db.Set(indexer.TxHashKey(hashes[0]), []byte{0xff}))
+			},
+			nil, nil, []common.Hash{hashes[0]},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+			require.NoError(t, idxer.IndexBlock(block, txResults))
+			tc.malleate(db)
+
+			diff, err := idxer.VerifyBlock(block, txResults)
+			require.NoError(t, err)
+			require.Equal(t, int64(1), diff.Height)
+			require.Equal(t, tc.expMissing, diff.Missing)
+			require.Equal(t, tc.expExtra, diff.Extra)
+			mismatched := make([]common.Hash, 0, len(diff.Mismatched))
+			for _, m := range diff.Mismatched {
+				mismatched = append(mismatched, m.Hash)
+			}
+			require.Equal(t, len(tc.expMismatched), len(mismatched))
+			if len(tc.expMismatched) > 0 {
+				require.Equal(t, tc.expMismatched, mismatched)
+			}
+
+			// the repaired block is consistent
+			require.NoError(t, idxer.RepairBlock(block, txResults))
+			diff, err = idxer.VerifyBlock(block, txResults)
+			require.NoError(t, err)
+			require.True(t, diff.IsEmpty())
+
+			res, err := idxer.GetByBlockAndIndex(1, 1)
+			require.NoError(t, err)
+			require.Equal(t, uint64(21000), res.GasUsed)
+			require.Equal(t, uint64(21000), res.CumulativeGasUsed)
+		})
+	}
+}
+
+func TestKVIndexerRepairBlockSecondaryKeys(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	from, block, txResults, hashes := buildEthBlock(t, clientCtx)
+
+	db := dbm.NewMemDB()
+	require.NoError(t, indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx).WithLogIndex(true).IndexBlock(block, txResults))
+
+	// an eth tx wrongly indexed at height 1 with all its secondary entries
+	extraHash := common.HexToHash("0x1")
+	account := utiltx.GenerateAddress()
+	topic := common.HexToHash("0x2")
+	res := evmostypes.TxResult{Height: 1, TxIndex: 2, EthTxIndex: 2, GasUsed: 21000, CumulativeGasUsed: 21000}
+	require.NoError(t, db.Set(indexer.TxIndexKey(1, 2), extraHash.Bytes()))
+	require.NoError(t, db.Set(indexer.TxHashKey(extraHash), clientCtx.Codec.MustMarshal(&res)))
+	require.NoError(t, db.Set(indexer.AccountTxKey(account, 1, 2), extraHash.Bytes()))
+	require.NoError(t, db.Set(indexer.LogAddressKey(account, 1, 0), extraHash.Bytes()))
+	require.NoError(t, db.Set(indexer.LogTopicKey(topic, 1, 0), extraHash.Bytes()))
+	require.NoError(t, db.Set(indexer.SenderNonceKey(account, 5), extraHash.Bytes()))
+	require.NoError(t, db.Set(indexer.ContractCreatorKey(account), append(extraHash.Bytes(), account.Bytes()...)))
+	// the entries at another height are kept
+	require.NoError(t, db.Set(indexer.AccountTxKey(account, 2, 0), extraHash.Bytes()))
+
+	// the indexer used to repair doesn't index the logs
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.RepairBlock(block, txResults))
+
+	diff, err := idxer.VerifyBlock(block, txResults)
+	require.NoError(t, err)
+	require.True(t, diff.IsEmpty())
+
+	accountTxs, _, err := idxer.SearchAccountTxs(account, 0, true, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{extraHash}, accountTxs)
+
+	logHeights, err := idxer.GetLogHeights(1, 1, []common.Address{account}, nil)
+	require.NoError(t, err)
+	require.Empty(t, logHeights)
+	logHeights, err = idxer.GetLogHeights(1, 1, nil, []common.Hash{topic})
+	require.NoError(t, err)
+	require.Empty(t, logHeights)
+
+	hash, err := idxer.GetTxHashBySenderAndNonce(account, 5)
+	require.NoError(t, err)
+	require.Nil(t, hash)
+	creator, err := idxer.GetContractCreator(account)
+	require.NoError(t, err)
+	require.Nil(t, creator)
+
+	// the entries of the block eth txs are indexed again
+	senderTxs, _, err := idxer.SearchAccountTxs(from, 0, true, 10)
+	require.NoError(t, err)
+	require.ElementsMatch(t, hashes, senderTxs)
+	hash, err = idxer.GetTxHashBySenderAndNonce(from, 1)
+	require.NoError(t, err)
+	require.Equal(t, hashes[1], *hash)
+
+	// the block is still covered by the log indexes
+	first, last, err := idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(1), last)
+}
@@ -53,6 +53,7 @@ func NewBalanceChangeEntry(acc common.Address, amt *big.Int, op Operation) balan
 type snapshot struct {
 	MultiStore sdk.CacheMultiStore
 	Events     sdk.Events
+	trace      *callTrace
 }
 
 // RequiredGas calculates the base minimum required gas for a transaction or a query.
@@ -138,6 +139,11 @@ func (p Precompile) RunSetup(
 		}
 	}
 
+	// keep the decoded call if the active tracer records the precompile calls
+	if tracer, ok := evm.Config.Tracer.(vm.PrecompileLogger); ok && evm.Config.Debug {
+		s.trace = &callTrace{tracer: tracer, method: method, args: args}
+	}
+
 	initialGas := ctx.GasMeter().GasConsumed()
 
 	defer HandleGasError(ctx, contract, initialGas, &err)()
@@ -177,8 +183,15 @@ func HandleGasError(ctx sdk.Context, contract *vm.Contract, initialGas storetype
 
 // AddJournalEntries adds the balanceChange (if corresponds)
 // and precompileCall entries on the stateDB journal
-// This allows to revert the call changes within an evm tx
+// This allows to revert the call changes within an evm tx.
+// The call is also reported to the active tracer, if it records the precompile calls.
 func (p Precompile) AddJournalEntries(stateDB *statedb.StateDB, s snapshot) error {
+	if s.trace != nil {
+		if err := p.captureCall(stateDB, s); err != nil {
+			return err
+		}
+	}
+
 	for _, entry := range p.journalEntries {
 		switch entry.Op {
 		case Sub:
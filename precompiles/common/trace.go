@@ -0,0 +1,133 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package common
+
+import (
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	"github.com/evmos/evmos/v19/x/evm/statedb"
+)
+
+// callTrace holds the decoded precompile call to be reported to the tracer
+// once the call is executed.
+type callTrace struct {
+	tracer vm.PrecompileLogger
+	method *abi.Method
+	args   []interface{}
+}
+
+// captureCall reports the precompile call to the tracer along with the SDK
+// events emitted since the snapshot and the balance changes they contain.
+func (p Precompile) captureCall(stateDB *statedb.StateDB, s snapshot) error {
+	ctx, err := stateDB.GetCacheContext()
+	if err != nil {
+		return err
+	}
+
+	var events sdk.Events
+	if allEvents := ctx.EventManager().Events(); len(allEvents) > len(s.Events) {
+		events = allEvents[len(s.Events):]
+	}
+
+	s.trace.tracer.CapturePrecompile(&vm.PrecompileFrame{
+		Address:        p.Address(),
+		Method:         s.trace.method.Name,
+		Args:           namedArgs(s.trace.method, s.trace.args),
+		BalanceChanges: BalanceChangesFromEvents(events),
+		Events:         traceEvents(events),
+	})
+	return nil
+}
+
+// BalanceChangesFromEvents returns the net balance changes in the EVM denomination
+// of the accounts from the coin spent and coin received events emitted by the bank
+// module. The changes are sorted by the first occurrence of each account.
+func BalanceChangesFromEvents(events sdk.Events) []vm.PrecompileBalanceChange {
+	var (
+		changes []vm.PrecompileBalanceChange
+		indexes = make(map[common.Address]int)
+	)
+
+	for _, event := range events {
+		var addrKey string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey = banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			addrKey = banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		var (
+			addr   common.Address
+			amount *big.Int
+		)
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case addrKey:
+				accAddr, err := sdk.AccAddressFromBech32(attr.Value)
+				if err != nil {
+					continue
+				}
+				addr = common.BytesToAddress(accAddr)
+			case sdk.AttributeKeyAmount:
+				coins, err := sdk.ParseCoinsNormalized(attr.Value)
+				if err != nil {
+					continue
+				}
+				amount = coins.AmountOf(utils.BaseDenom).BigInt()
+			}
+		}
+		if amount == nil || amount.Sign() == 0 || addr == (common.Address{}) {
+			continue
+		}
+
+		if event.Type == banktypes.EventTypeCoinSpent {
+			amount.Neg(amount)
+		}
+		i, found := indexes[addr]
+		if !found {
+			indexes[addr] = len(changes)
+			changes = append(changes, vm.PrecompileBalanceChange{Address: addr, Amount: amount})
+			continue
+		}
+		changes[i].Amount = new(big.Int).Add(changes[i].Amount, amount)
+	}
+
+	return changes
+}
+
+// namedArgs returns the call arguments keyed by the names of the method inputs.
+// Unnamed inputs are keyed by their position.
+func namedArgs(method *abi.Method, args []interface{}) map[string]interface{} {
+	named := make(map[string]interface{}, len(args))
+	for i, arg := range args {
+		name := strconv.Itoa(i)
+		if i < len(method.Inputs) && method.Inputs[i].Name != "" {
+			name = method.Inputs[i].Name
+		}
+		named[name] = arg
+	}
+	return named
+}
+
+// traceEvents converts the SDK events to the format reported to the tracer.
+func traceEvents(events sdk.Events) []vm.PrecompileEvent {
+	traced := make([]vm.PrecompileEvent, len(events))
+	for i, event := range events {
+		attrs := make([]vm.PrecompileEventAttribute, len(event.Attributes))
+		for j, attr := range event.Attributes {
+			attrs[j] = vm.PrecompileEventAttribute{Key: attr.Key, Value: attr.Value}
+		}
+		traced[i] = vm.PrecompileEvent{Type: event.Type, Attributes: attrs}
+	}
+	return traced
+}
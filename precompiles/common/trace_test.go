@@ -0,0 +1,38 @@
+package common_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceChangesFromEvents(t *testing.T) {
+	delegator := sdk.AccAddress([]byte("delegator___________"))
+	pool := sdk.AccAddress([]byte("bonded_pool_________"))
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(amt)), sdk.NewCoin("uatom", math.NewInt(7)))
+	}
+
+	events := sdk.Events{
+		banktypes.NewCoinSpentEvent(delegator, coins(100)),
+		banktypes.NewCoinReceivedEvent(pool, coins(100)),
+		sdk.NewEvent("delegate", sdk.NewAttribute("validator", "evmosvaloper1")),
+		banktypes.NewCoinReceivedEvent(delegator, coins(30)),
+		// other denominations are not reported
+		banktypes.NewCoinSpentEvent(pool, sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1)))),
+	}
+
+	changes := cmn.BalanceChangesFromEvents(events)
+	require.Equal(t, []vm.PrecompileBalanceChange{
+		{Address: common.BytesToAddress(delegator), Amount: big.NewInt(-70)},
+		{Address: common.BytesToAddress(pool), Amount: big.NewInt(100)},
+	}, changes)
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev The type URL of the Ethereum transactions, to be used in the allowed
+/// messages of an allowance that sponsors the gas of the grantee.
+string constant MSG_ETHEREUM_TX = "/ethermint.evm.v1.MsgEthereumTx";
+
+// Allowance defines a fee allowance granted by a granter to a grantee.
+struct Allowance {
+    // granter is the address of the account paying the fees.
+    address granter;
+    // grantee is the address of the account whose fees are paid.
+    address grantee;
+    // spendLimit is the maximum amount of fees the grantee can use, empty meaning no limit.
+    Coin[] spendLimit;
+    // expiration is the unix time in seconds at which the allowance expires, zero meaning no expiration.
+    int64 expiration;
+    // allowedMessages are the message type URLs the fees can be paid for, empty meaning all messages.
+    string[] allowedMessages;
+}
+
+/// @author Nexqloud Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with feegrant.
+/// The allowances are always granted and revoked by the caller of the precompile.
+/// The fee granter of an Ethereum transaction is only honoured when the sender
+/// accepted it, either beforehand or in the transaction itself through acceptGranter.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface IFeegrant {
+    /// @dev Defines an event that is emitted when a fee allowance is granted.
+    /// @param granter The address of the account paying the fees.
+    /// @param grantee The address of the account whose fees are paid.
+    event Grant(
+        address indexed granter,
+        address indexed grantee
+    );
+
+    /// @dev Defines an event that is emitted when a fee allowance is revoked.
+    /// @param granter The address of the account paying the fees.
+    /// @param grantee The address of the account whose fees are paid.
+    event Revoke(
+        address indexed granter,
+        address indexed grantee
+    );
+
+    /// @dev Defines an event that is emitted when a grantee accepts a fee granter.
+    /// @param granter The address of the account paying the fees.
+    /// @param grantee The address of the account whose fees are paid.
+    event AcceptGranter(
+        address indexed granter,
+        address indexed grantee
+    );
+
+    /// @dev Defines a method for granting a fee allowance from the caller to the grantee.
+    /// @param grantee The address of the account whose fees are paid.
+    /// @param spendLimit The maximum amount of fees the grantee can use, empty for no limit.
+    /// @param expiration The unix time in seconds at which the allowance expires, zero for no expiration.
+    /// @param allowedMessages The message type URLs the fees can be paid for, empty for all messages.
+    /// @return success Whether the allowance was granted.
+    function grant(
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        string[] calldata allowedMessages
+    ) external returns (bool success);
+
+    /// @dev Defines a method for revoking the fee allowance granted by the caller to the grantee.
+    /// @param grantee The address of the account whose fees are paid.
+    /// @return success Whether the allowance was revoked.
+    function revoke(
+        address grantee
+    ) external returns (bool success);
+
+    /// @dev Defines a method for accepting the granter that pays the fees of the
+    /// Ethereum transactions sent by the caller. The zero address removes it.
+    /// @param granter The address of the account paying the fees.
+    /// @return success Whether the granter was accepted.
+    function acceptGranter(
+        address granter
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev Defines a query for getting the fee allowance granted by the granter to the grantee.
+    /// @param granter The address of the account paying the fees.
+    /// @param grantee The address of the account whose fees are paid.
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (Allowance memory allowance);
+
+    /// @dev Defines a query for getting all the fee allowances granted to the grantee.
+    /// @param grantee The address of the account whose fees are paid.
+    /// @param pageRequest The pagination of the query.
+    function allowances(
+        address grantee,
+        PageRequest calldata pageRequest
+    ) external view returns (Allowance[] memory allowances, PageResponse memory pageResponse);
+
+    /// @dev Defines a query for getting the fee granter accepted by the grantee.
+    /// @param grantee The address of the account whose fees are paid.
+    /// @return granter The accepted granter, the zero address if none.
+    function acceptedGranter(
+        address grantee
+    ) external view returns (address granter);
+}
@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+const (
+	// EventTypeGrant defines the event type for the feegrant Grant transaction.
+	EventTypeGrant = "Grant"
+	// EventTypeRevoke defines the event type for the feegrant Revoke transaction.
+	EventTypeRevoke = "Revoke"
+	// EventTypeAcceptGranter defines the event type for the feegrant AcceptGranter transaction.
+	EventTypeAcceptGranter = "AcceptGranter"
+)
+
+// EmitGrantEvent creates a new event emitted on a Grant transaction.
+func (p Precompile) EmitGrantEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address) error {
+	return p.emitAllowanceEvent(ctx, stateDB, EventTypeGrant, granter, grantee)
+}
+
+// EmitRevokeEvent creates a new event emitted on a Revoke transaction.
+func (p Precompile) EmitRevokeEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address) error {
+	return p.emitAllowanceEvent(ctx, stateDB, EventTypeRevoke, granter, grantee)
+}
+
+// EmitAcceptGranterEvent creates a new event emitted on an AcceptGranter transaction.
+func (p Precompile) EmitAcceptGranterEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address) error {
+	return p.emitAllowanceEvent(ctx, stateDB, EventTypeAcceptGranter, granter, grantee)
+}
+
+// emitAllowanceEvent adds the log of the given event, indexed by the granter
+// and the grantee of the allowance.
+func (p Precompile) emitAllowanceEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	granter, grantee common.Address,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+const (
+	// AllowanceMethod defines the ABI method name for the feegrant Allowance query.
+	AllowanceMethod = "allowance"
+	// AllowancesMethod defines the ABI method name for the feegrant Allowances query.
+	AllowancesMethod = "allowances"
+	// AcceptedGranterMethod defines the ABI method name for the feegrant AcceptedGranter query.
+	AcceptedGranterMethod = "acceptedGranter"
+)
+
+// Allowance returns the fee allowance granted by the granter to the grantee.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewAllowanceRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowance(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := NewAllowance(*res.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// Allowances returns all the fee allowances granted to the grantee with pagination.
+func (p Precompile) Allowances(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewAllowancesRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowances(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowancesOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// AcceptedGranter returns the fee granter accepted by the grantee, or the zero
+// address if none.
+func (p Precompile) AcceptedGranter(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, err := ParseAcceptedGranterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, _ := p.evmKeeper.GetAcceptedFeeGranter(ctx, grantee)
+	return method.Outputs.Pack(granter)
+}
@@ -0,0 +1,110 @@
+package feegrant_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/precompiles/feegrant"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+
+	s.Run("fail - allowance not found", func() {
+		s.SetupTest()
+		_, err := s.precompile.Allowance(s.network.GetContext(), nil, &method, []interface{}{s.keyring.GetAddr(0), utiltx.GenerateAddress()})
+		s.Require().ErrorContains(err, "fee-grant not found")
+	})
+
+	s.Run("success", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		granter := s.keyring.GetAddr(0)
+		grantee := utiltx.GenerateAddress()
+		spendLimit := sdk.NewCoins(sdk.NewCoin(s.network.GetDenom(), sdk.NewInt(1e18)))
+		expiration := ctx.BlockTime().Add(time.Hour).Truncate(time.Second).UTC()
+		allowedMessages := []string{sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})}
+
+		allowance, err := feegranttypes.NewAllowedMsgAllowance(&feegranttypes.PeriodicAllowance{
+			Basic:            feegranttypes.BasicAllowance{SpendLimit: spendLimit, Expiration: &expiration},
+			Period:           time.Minute,
+			PeriodSpendLimit: spendLimit,
+			PeriodCanSpend:   spendLimit,
+			PeriodReset:      ctx.BlockTime().Add(time.Minute),
+		}, allowedMessages)
+		s.Require().NoError(err)
+		err = s.network.App.FeeGrantKeeper.GrantAllowance(ctx, granter.Bytes(), grantee.Bytes(), allowance)
+		s.Require().NoError(err)
+
+		bz, err := s.precompile.Allowance(ctx, nil, &method, []interface{}{granter, grantee})
+		s.Require().NoError(err)
+
+		var out struct{ Allowance feegrant.Allowance }
+		err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz)
+		s.Require().NoError(err)
+
+		s.Require().Equal(feegrant.Allowance{
+			Granter:         granter,
+			Grantee:         grantee,
+			SpendLimit:      cmn.NewCoinsResponse(spendLimit),
+			Expiration:      expiration.Unix(),
+			AllowedMessages: allowedMessages,
+		}, out.Allowance)
+	})
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	grantee := utiltx.GenerateAddress()
+	for i := 0; i < 2; i++ {
+		s.grantAllowance(s.keyring.GetAddr(i), grantee, nil)
+	}
+
+	bz, err := s.precompile.Allowances(ctx, nil, &method, []interface{}{grantee, query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out feegrant.AllowancesOutput
+	err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz)
+	s.Require().NoError(err)
+
+	s.Require().Len(out.Allowances, 1)
+	s.Require().Equal(grantee, out.Allowances[0].Grantee)
+	s.Require().Empty(out.Allowances[0].SpendLimit)
+	s.Require().Empty(out.Allowances[0].AllowedMessages)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+	s.Require().NotEmpty(out.PageResponse.NextKey)
+}
+
+func (s *PrecompileTestSuite) TestAcceptedGranter() {
+	method := s.precompile.Methods[feegrant.AcceptedGranterMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	grantee := s.keyring.GetAddr(0)
+	granter := s.keyring.GetAddr(1)
+
+	_, err := s.precompile.AcceptedGranter(ctx, nil, &method, []interface{}{common.Address{}})
+	s.Require().ErrorContains(err, "invalid grantee address")
+
+	bz, err := s.precompile.AcceptedGranter(ctx, nil, &method, []interface{}{grantee})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(common.Address{}, out[0])
+
+	s.network.App.EvmKeeper.SetAcceptedFeeGranter(ctx, grantee, granter)
+	bz, err = s.precompile.AcceptedGranter(ctx, nil, &method, []interface{}{grantee})
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(granter, out[0])
+}
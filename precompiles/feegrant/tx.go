@@ -0,0 +1,123 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the feegrant Grant transaction.
+	GrantMethod = "grant"
+	// RevokeMethod defines the ABI method name for the feegrant Revoke transaction.
+	RevokeMethod = "revoke"
+	// AcceptGranterMethod defines the ABI method name for the feegrant AcceptGranter transaction.
+	AcceptGranterMethod = "acceptGranter"
+)
+
+// Grant grants a fee allowance from the caller to the given grantee.
+//
+// NOTE: the granter is always the caller of the precompile, so that a contract
+// can only grant allowances paid from its own balance and never from the
+// balance of the transaction origin.
+func (p *Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgGrantAllowance(method, granter, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ granter: %s, grantee: %s }", msg.Granter, msg.Grantee),
+	)
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err = msgSrv.GrantAllowance(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke revokes the fee allowance granted by the caller to the given grantee.
+func (p *Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgRevokeAllowance(granter, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ granter: %s, grantee: %s }", msg.Granter, msg.Grantee),
+	)
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err = msgSrv.RevokeAllowance(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// AcceptGranter sets the fee granter that the caller accepts to pay the fees
+// of its Ethereum transactions. The zero address removes the accepted granter.
+//
+// NOTE: the fee granter is set on the Cosmos tx wrapping the Ethereum txs and
+// is not covered by their signature, so it is only honoured when the sender
+// accepted it.
+func (p *Precompile) AcceptGranter(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee := contract.CallerAddress
+	granter, err := ParseAcceptGranterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ granter: %s, grantee: %s }", granter, grantee),
+	)
+
+	p.evmKeeper.SetAcceptedFeeGranter(ctx, grantee, granter)
+
+	if err = p.EmitAcceptGranterEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,335 @@
+package feegrant_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/precompiles/feegrant"
+	"github.com/evmos/evmos/v19/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[feegrant.GrantMethod]
+	msgEthereumTxURL := sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+
+	testCases := []struct {
+		name        string
+		malleate    func(granter, grantee common.Address) []interface{}
+		expErr      bool
+		errContains string
+		expAllowed  []string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(common.Address, common.Address) []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+			nil,
+		},
+		{
+			"fail - empty grantee",
+			func(common.Address, common.Address) []interface{} {
+				return []interface{}{common.Address{}, []cmn.Coin{}, int64(0), []string{}}
+			},
+			true,
+			"invalid grantee address",
+			nil,
+		},
+		{
+			"fail - negative expiration",
+			func(_, grantee common.Address) []interface{} {
+				return []interface{}{grantee, []cmn.Coin{}, int64(-1), []string{}}
+			},
+			true,
+			"invalid expiration",
+			nil,
+		},
+		{
+			"fail - negative spend limit",
+			func(_, grantee common.Address) []interface{} {
+				return []interface{}{grantee, []cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(-1)}}, int64(0), []string{}}
+			},
+			true,
+			"invalid amount",
+			nil,
+		},
+		{
+			"fail - self grant",
+			func(granter, _ common.Address) []interface{} {
+				return []interface{}{granter, []cmn.Coin{}, int64(0), []string{}}
+			},
+			true,
+			"cannot self-grant fee authorization",
+			nil,
+		},
+		{
+			"fail - expiration before the block time",
+			func(_, grantee common.Address) []interface{} {
+				return []interface{}{grantee, []cmn.Coin{}, int64(1), []string{}}
+			},
+			true,
+			"expiration is before current block time",
+			nil,
+		},
+		{
+			"fail - allowance already exists",
+			func(granter, grantee common.Address) []interface{} {
+				s.grantAllowance(granter, grantee, nil)
+				return []interface{}{grantee, []cmn.Coin{}, int64(0), []string{}}
+			},
+			true,
+			"fee allowance already exists",
+			nil,
+		},
+		{
+			"success - basic allowance",
+			func(_, grantee common.Address) []interface{} {
+				return []interface{}{grantee, []cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(1e18)}}, int64(0), []string{}}
+			},
+			false,
+			"",
+			nil,
+		},
+		{
+			"success - allowance restricted to Ethereum transactions",
+			func(_, grantee common.Address) []interface{} {
+				return []interface{}{grantee, []cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(1e18)}}, int64(0), []string{msgEthereumTxURL}}
+			},
+			false,
+			"",
+			[]string{msgEthereumTxURL},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			granter := s.keyring.GetAddr(0)
+			// the grantee account does not exist yet
+			grantee := utiltx.GenerateAddress()
+
+			args := tc.malleate(granter, grantee)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+
+			bz, err := s.precompile.Grant(ctx, contract, stateDB, &method, args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, granter.Bytes(), grantee.Bytes())
+			s.Require().NoError(err)
+
+			if tc.expAllowed != nil {
+				allowed, ok := allowance.(*feegranttypes.AllowedMsgAllowance)
+				s.Require().True(ok, "expected allowed msg allowance, got %T", allowance)
+				s.Require().Equal(tc.expAllowed, allowed.AllowedMessages)
+				allowance, err = allowed.GetAllowance()
+				s.Require().NoError(err)
+			}
+
+			basic, ok := allowance.(*feegranttypes.BasicAllowance)
+			s.Require().True(ok, "expected basic allowance, got %T", allowance)
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetDenom(), sdk.NewInt(1e18))), basic.SpendLimit)
+			s.Require().Nil(basic.Expiration)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[feegrant.EventTypeGrant].ID, logs[0].Topics[0])
+			s.Require().Equal(common.BytesToHash(granter.Bytes()), logs[0].Topics[1])
+			s.Require().Equal(common.BytesToHash(grantee.Bytes()), logs[0].Topics[2])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[feegrant.RevokeMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(granter, grantee common.Address) []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(common.Address, common.Address) []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - allowance not found",
+			func(_, grantee common.Address) []interface{} {
+				return []interface{}{grantee}
+			},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"fail - allowance granted by another account",
+			func(_, grantee common.Address) []interface{} {
+				s.grantAllowance(s.keyring.GetAddr(1), grantee, nil)
+				return []interface{}{grantee}
+			},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success",
+			func(granter, grantee common.Address) []interface{} {
+				s.grantAllowance(granter, grantee, nil)
+				return []interface{}{grantee}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			granter := s.keyring.GetAddr(0)
+			grantee := utiltx.GenerateAddress()
+
+			args := tc.malleate(granter, grantee)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+
+			bz, err := s.precompile.Revoke(ctx, contract, stateDB, &method, args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			_, err = s.network.App.FeeGrantKeeper.GetAllowance(ctx, granter.Bytes(), grantee.Bytes())
+			s.Require().ErrorContains(err, "fee-grant not found")
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[feegrant.EventTypeRevoke].ID, logs[0].Topics[0])
+			s.Require().Equal(common.BytesToHash(granter.Bytes()), logs[0].Topics[1])
+			s.Require().Equal(common.BytesToHash(grantee.Bytes()), logs[0].Topics[2])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAcceptGranter() {
+	method := s.precompile.Methods[feegrant.AcceptGranterMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(grantee common.Address) []interface{}
+		expGranter  func() common.Address
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(common.Address) []interface{} {
+				return []interface{}{}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid granter",
+			func(common.Address) []interface{} {
+				return []interface{}{"granter"}
+			},
+			nil,
+			true,
+			fmt.Sprintf(feegrant.ErrInvalidGranter, "granter"),
+		},
+		{
+			"success - accept granter",
+			func(common.Address) []interface{} {
+				return []interface{}{s.keyring.GetAddr(1)}
+			},
+			func() common.Address { return s.keyring.GetAddr(1) },
+			false,
+			"",
+		},
+		{
+			"success - remove accepted granter",
+			func(grantee common.Address) []interface{} {
+				s.network.App.EvmKeeper.SetAcceptedFeeGranter(s.network.GetContext(), grantee, s.keyring.GetAddr(1))
+				return []interface{}{common.Address{}}
+			},
+			func() common.Address { return common.Address{} },
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			grantee := s.keyring.GetAddr(0)
+
+			args := tc.malleate(grantee)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), grantee, s.precompile, 200_000)
+
+			bz, err := s.precompile.AcceptGranter(ctx, contract, stateDB, &method, args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			expGranter := tc.expGranter()
+			granter, found := s.network.App.EvmKeeper.GetAcceptedFeeGranter(ctx, grantee)
+			s.Require().Equal(expGranter != common.Address{}, found)
+			s.Require().Equal(expGranter, granter)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[feegrant.EventTypeAcceptGranter].ID, logs[0].Topics[0])
+			s.Require().Equal(common.BytesToHash(expGranter.Bytes()), logs[0].Topics[1])
+			s.Require().Equal(common.BytesToHash(grantee.Bytes()), logs[0].Topics[2])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestParseAcceptGranterInput() {
+	granter := s.keyring.GetAddr(1)
+	input, err := s.precompile.Pack(feegrant.AcceptGranterMethod, granter)
+	s.Require().NoError(err)
+
+	accepted, ok := feegrant.ParseAcceptGranterInput(input)
+	s.Require().True(ok)
+	s.Require().Equal(granter, accepted)
+
+	revokeInput, err := s.precompile.Pack(feegrant.RevokeMethod, granter)
+	s.Require().NoError(err)
+	_, ok = feegrant.ParseAcceptGranterInput(revokeInput)
+	s.Require().False(ok, "expected other methods to be ignored")
+
+	_, ok = feegrant.ParseAcceptGranterInput(append(input, 0x00))
+	s.Require().False(ok, "expected trailing data to be rejected")
+
+	dirty := append([]byte{}, input...)
+	dirty[4] = 0x01
+	_, ok = feegrant.ParseAcceptGranterInput(dirty)
+	s.Require().False(ok, "expected a non-zero address padding to be rejected")
+}
@@ -0,0 +1,327 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package feegrant
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+)
+
+// acceptGranterID is the method ID of the acceptGranter transaction.
+var acceptGranterID = crypto.Keccak256([]byte("acceptGranter(address)"))[:4]
+
+// EVMKeeper defines the expected EVM keeper used to store the fee granters
+// accepted by the grantees. It is an interface to avoid an import cycle with
+// the EVM keeper, which registers this precompile.
+type EVMKeeper interface {
+	SetAcceptedFeeGranter(ctx sdk.Context, grantee, granter common.Address)
+	GetAcceptedFeeGranter(ctx sdk.Context, grantee common.Address) (common.Address, bool)
+}
+
+// Allowance defines a fee allowance as returned by the precompile. The
+// expiration is expressed as a unix timestamp in seconds, zero meaning that
+// the allowance does not expire.
+//
+// NOTE: only the basic part of the periodic allowances is returned.
+type Allowance struct {
+	Granter         common.Address
+	Grantee         common.Address
+	SpendLimit      []cmn.Coin
+	Expiration      int64
+	AllowedMessages []string
+}
+
+// Coins is a struct used to parse the spend limit used as input in the
+// grant transaction.
+type Coins struct {
+	Coins []cmn.Coin
+}
+
+// AllowancesInput is a struct to represent the input information for
+// the allowances query. Needed to unpack arguments into the PageRequest struct.
+type AllowancesInput struct {
+	Grantee     common.Address
+	PageRequest query.PageRequest
+}
+
+// AllowancesOutput is a struct to represent the key information from
+// an allowances response.
+type AllowancesOutput struct {
+	Allowances   []Allowance
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance instance from the given
+// granter and arguments and does sanity checks before populating the message.
+// An empty list of allowed messages grants a basic allowance valid for all the
+// messages.
+func NewMsgGrantAllowance(method *abi.Method, granter common.Address, args []interface{}) (*feegrant.MsgGrantAllowance, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	spendLimit, err := parseCoins(method, 1, args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	expiration, ok := args[2].(int64)
+	if !ok || expiration < 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[2])
+	}
+
+	allowedMessages, ok := args[3].([]string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidAllowedMessages, args[3])
+	}
+
+	basic := &feegrant.BasicAllowance{}
+	// NOTE: the spend limit is left unset when empty, meaning no limit.
+	if !spendLimit.Empty() {
+		basic.SpendLimit = spendLimit
+	}
+	if expiration > 0 {
+		expiresAt := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &expiresAt
+	}
+
+	var allowance feegrant.FeeAllowanceI = basic
+	if len(allowedMessages) > 0 {
+		allowance, err = feegrant.NewAllowedMsgAllowance(basic, allowedMessages)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+	}
+
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, granter.Bytes(), grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, grantee, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance instance from the given
+// granter and arguments and does sanity checks before populating the message.
+func NewMsgRevokeAllowance(granter common.Address, args []interface{}) (*feegrant.MsgRevokeAllowance, common.Address, error) {
+	if len(args) != 1 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msg := feegrant.NewMsgRevokeAllowance(granter.Bytes(), grantee.Bytes())
+	return &msg, grantee, nil
+}
+
+// ParseAcceptGranterArgs parses the arguments of the acceptGranter transaction.
+func ParseAcceptGranterArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	granter, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	return granter, nil
+}
+
+// ParseAcceptedGranterArgs parses the arguments of the acceptedGranter query.
+func ParseAcceptedGranterArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	return grantee, nil
+}
+
+// ParseAcceptGranterInput returns the granter accepted by the given call data,
+// if it is a call to the acceptGranter transaction.
+func ParseAcceptGranterInput(input []byte) (common.Address, bool) {
+	if len(input) != len(acceptGranterID)+common.HashLength || !bytes.Equal(input[:len(acceptGranterID)], acceptGranterID) {
+		return common.Address{}, false
+	}
+
+	// the address argument is left padded with zeros to 32 bytes
+	arg := input[len(acceptGranterID):]
+	padding := common.HashLength - common.AddressLength
+	if !bytes.Equal(arg[:padding], make([]byte, padding)) {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(arg[padding:]), true
+}
+
+// NewAllowanceRequest creates a new QueryAllowanceRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewAllowanceRequest(args []interface{}) (*feegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return &feegrant.QueryAllowanceRequest{
+		Granter: sdk.AccAddress(granter.Bytes()).String(),
+		Grantee: sdk.AccAddress(grantee.Bytes()).String(),
+	}, nil
+}
+
+// NewAllowancesRequest creates a new QueryAllowancesRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewAllowancesRequest(method *abi.Method, args []interface{}) (*feegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &feegrant.QueryAllowancesRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.PageRequest,
+	}, nil
+}
+
+// NewAllowance creates an Allowance from a feegrant Grant.
+func NewAllowance(grant feegrant.Grant) (Allowance, error) {
+	granter, err := sdk.AccAddressFromBech32(grant.Granter)
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	feeAllowance, err := grant.GetGrant()
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	out := Allowance{
+		Granter:         common.BytesToAddress(granter),
+		Grantee:         common.BytesToAddress(grantee),
+		AllowedMessages: []string{},
+	}
+
+	if allowed, ok := feeAllowance.(*feegrant.AllowedMsgAllowance); ok {
+		out.AllowedMessages = allowed.AllowedMessages
+		if feeAllowance, err = allowed.GetAllowance(); err != nil {
+			return Allowance{}, err
+		}
+	}
+
+	var basic feegrant.BasicAllowance
+	switch a := feeAllowance.(type) {
+	case *feegrant.BasicAllowance:
+		basic = *a
+	case *feegrant.PeriodicAllowance:
+		basic = a.Basic
+	default:
+		return Allowance{}, fmt.Errorf("unsupported fee allowance type %T", feeAllowance)
+	}
+
+	out.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		out.Expiration = basic.Expiration.Unix()
+	}
+
+	return out, nil
+}
+
+// FromResponse populates the AllowancesOutput from a QueryAllowancesResponse.
+func (ao *AllowancesOutput) FromResponse(res *feegrant.QueryAllowancesResponse) (*AllowancesOutput, error) {
+	ao.Allowances = make([]Allowance, len(res.Allowances))
+	for i, grant := range res.Allowances {
+		allowance, err := NewAllowance(*grant)
+		if err != nil {
+			return nil, err
+		}
+		ao.Allowances[i] = allowance
+	}
+
+	if res.Pagination != nil {
+		ao.PageResponse.Total = res.Pagination.Total
+		ao.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return ao, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (ao *AllowancesOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(ao.Allowances, ao.PageResponse)
+}
+
+// parseCoins parses the coins argument at the given index of the method inputs.
+func parseCoins(method *abi.Method, index int, args []interface{}) (sdk.Coins, error) {
+	var input Coins
+	arguments := abi.Arguments{method.Inputs[index]}
+	if err := arguments.Copy(&input, []interface{}{args[index]}); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to Coins struct: %s", err)
+	}
+
+	coins := make(sdk.Coins, len(input.Coins))
+	for i, coin := range input.Coins {
+		if coin.Amount == nil || coin.Amount.Sign() < 0 {
+			return nil, fmt.Errorf(cmn.ErrInvalidAmount, coin.Amount)
+		}
+		coins[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+
+	coins = coins.Sort()
+	if err := coins.Validate(); err != nil {
+		return nil, err
+	}
+
+	return coins, nil
+}
@@ -0,0 +1,33 @@
+package feegrant_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/precompiles/feegrant"
+)
+
+// setupFeegrantPrecompile is a helper function to set up an instance of the
+// feegrant precompile.
+func (s *PrecompileTestSuite) setupFeegrantPrecompile() *feegrant.Precompile {
+	precompile, err := feegrant.NewPrecompile(
+		s.network.App.FeeGrantKeeper,
+		s.network.App.EvmKeeper,
+		s.network.App.AuthzKeeper,
+	)
+	s.Require().NoError(err, "failed to create feegrant precompile")
+
+	return precompile
+}
+
+// grantAllowance is a helper function to grant a basic fee allowance with the
+// given spend limit from the granter to the grantee.
+func (s *PrecompileTestSuite) grantAllowance(granter, grantee common.Address, spendLimit sdk.Coins) {
+	err := s.network.App.FeeGrantKeeper.GrantAllowance(
+		s.network.GetContext(),
+		granter.Bytes(),
+		grantee.Bytes(),
+		&feegranttypes.BasicAllowance{SpendLimit: spendLimit},
+	)
+	s.Require().NoError(err, "failed to grant allowance")
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IGov contract's address.
+address constant GOV_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+/// @dev The IGov contract's instance.
+IGov constant GOV_CONTRACT = IGov(GOV_PRECOMPILE_ADDRESS);
+
+/// @dev Define all the available gov methods.
+string constant MSG_SUBMIT_PROPOSAL = "/cosmos.gov.v1.MsgSubmitProposal";
+string constant MSG_DEPOSIT = "/cosmos.gov.v1.MsgDeposit";
+string constant MSG_VOTE = "/cosmos.gov.v1.MsgVote";
+string constant MSG_VOTE_WEIGHTED = "/cosmos.gov.v1.MsgVoteWeighted";
+
+/// @dev VoteOption enumerates the valid vote options for a proposal.
+enum VoteOption {
+    // Unspecified defines a no-op vote option.
+    Unspecified,
+    // Yes defines a yes vote option.
+    Yes,
+    // Abstain defines an abstain vote option.
+    Abstain,
+    // No defines a no vote option.
+    No,
+    // NoWithVeto defines a no with veto vote option.
+    NoWithVeto
+}
+
+// WeightedVoteOption defines a vote option together with the weight given to it.
+// The weight is a decimal string, e.g. "0.5".
+struct WeightedVoteOption {
+    VoteOption option;
+    string weight;
+}
+
+// TallyResultData defines the tally of a proposal.
+struct TallyResultData {
+    string yes;
+    string abstain;
+    string no;
+    string noWithVeto;
+}
+
+// ProposalData defines the information of a proposal. Times are unix timestamps
+// in seconds and are zero when not set.
+struct ProposalData {
+    uint64 id;
+    string[] messages;
+    uint32 status;
+    TallyResultData finalTallyResult;
+    uint64 submitTime;
+    uint64 depositEndTime;
+    Coin[] totalDeposit;
+    uint64 votingStartTime;
+    uint64 votingEndTime;
+    string metadata;
+    string title;
+    string summary;
+    address proposer;
+}
+
+// WeightedVote defines a vote cast on a proposal.
+struct WeightedVote {
+    uint64 proposalId;
+    address voter;
+    WeightedVoteOption[] options;
+    string metadata;
+}
+
+/// @author Nexqloud Team
+/// @title Gov Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with governance.
+/// @custom:address 0x0000000000000000000000000000000000000805
+interface IGov {
+    /// @dev This event is emitted when the allowance of a granter is set by a call to the approve method.
+    /// @param grantee The contract address that received an Authorization from the granter.
+    /// @param granter The account address that granted an Authorization.
+    /// @param method The message type URL of the methods for which the approval is set.
+    event Approval(
+        address indexed grantee,
+        address indexed granter,
+        string method
+    );
+
+    /// @dev Defines an event that is emitted when a proposal is submitted.
+    /// @param proposer The address of the proposer.
+    /// @param proposalId The id of the submitted proposal.
+    event SubmitProposal(
+        address indexed proposer,
+        uint64 indexed proposalId
+    );
+
+    /// @dev Defines an event that is emitted when a deposit is added to a proposal.
+    /// @param depositor The address of the depositor.
+    /// @param proposalId The id of the proposal.
+    /// @param amount The amount deposited.
+    event Deposit(
+        address indexed depositor,
+        uint64 indexed proposalId,
+        Coin[] amount
+    );
+
+    /// @dev Defines an event that is emitted when a vote is cast on a proposal.
+    /// @param voter The address of the voter.
+    /// @param proposalId The id of the proposal.
+    /// @param option The vote option.
+    event Vote(
+        address indexed voter,
+        uint64 indexed proposalId,
+        uint8 option
+    );
+
+    /// @dev Defines an event that is emitted when a weighted vote is cast on a proposal.
+    /// @param voter The address of the voter.
+    /// @param proposalId The id of the proposal.
+    /// @param options The weighted vote options.
+    event VoteWeighted(
+        address indexed voter,
+        uint64 indexed proposalId,
+        WeightedVoteOption[] options
+    );
+
+    /// @dev Approves a contract to submit gov transactions on behalf of the origin.
+    /// @param grantee The contract address which will have an authorization to act on behalf of the origin.
+    /// @param method The message type URL of the method to approve.
+    /// @return approved Boolean value to indicate if the approval was successful.
+    function approve(
+        address grantee,
+        string calldata method
+    ) external returns (bool approved);
+
+    /// @dev Defines a method for submitting a proposal.
+    /// @param proposer The address of the proposer.
+    /// @param jsonProposal The JSON encoded MsgSubmitProposal. The proposer and
+    /// initial deposit in it are replaced by the ones passed to this method.
+    /// @param deposit The initial deposit of the proposal.
+    /// @return proposalId The id of the submitted proposal.
+    function submitProposal(
+        address proposer,
+        bytes calldata jsonProposal,
+        Coin[] calldata deposit
+    ) external returns (uint64 proposalId);
+
+    /// @dev Defines a method for adding a deposit to a proposal.
+    /// @param depositor The address of the depositor.
+    /// @param proposalId The id of the proposal.
+    /// @param amount The amount to deposit.
+    function deposit(
+        address depositor,
+        uint64 proposalId,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev Defines a method for casting a vote on a proposal.
+    /// @param voter The address of the voter.
+    /// @param proposalId The id of the proposal.
+    /// @param option The vote option.
+    /// @param metadata The metadata of the vote.
+    function vote(
+        address voter,
+        uint64 proposalId,
+        VoteOption option,
+        string calldata metadata
+    ) external returns (bool success);
+
+    /// @dev Defines a method for casting a vote split across several weighted options.
+    /// @param voter The address of the voter.
+    /// @param proposalId The id of the proposal.
+    /// @param options The weighted vote options. The weights must add up to 1.
+    /// @param metadata The metadata of the vote.
+    function voteWeighted(
+        address voter,
+        uint64 proposalId,
+        WeightedVoteOption[] calldata options,
+        string calldata metadata
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev Defines a query for getting a proposal.
+    /// @param proposalId The id of the proposal.
+    function getProposal(
+        uint64 proposalId
+    ) external view returns (ProposalData memory proposal);
+
+    /// @dev Defines a query for getting the votes cast on a proposal.
+    /// @param proposalId The id of the proposal.
+    /// @param pageRequest The pagination of the query.
+    function getVotes(
+        uint64 proposalId,
+        PageRequest calldata pageRequest
+    ) external view returns (WeightedVote[] memory votes, PageResponse memory pageResponse);
+
+    /// @dev Defines a query for getting the tally of a proposal. The current tally
+    /// is returned while the proposal is in its voting period.
+    /// @param proposalId The id of the proposal.
+    function getTallyResult(
+        uint64 proposalId
+    ) external view returns (TallyResultData memory tallyResult);
+}
@@ -0,0 +1,69 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+var (
+	// SubmitProposalMsgURL defines the gov authorization type for MsgSubmitProposal
+	SubmitProposalMsgURL = sdk.MsgTypeURL(&govv1.MsgSubmitProposal{})
+	// DepositMsgURL defines the gov authorization type for MsgDeposit
+	DepositMsgURL = sdk.MsgTypeURL(&govv1.MsgDeposit{})
+	// VoteMsgURL defines the gov authorization type for MsgVote
+	VoteMsgURL = sdk.MsgTypeURL(&govv1.MsgVote{})
+	// VoteWeightedMsgURL defines the gov authorization type for MsgVoteWeighted
+	VoteWeightedMsgURL = sdk.MsgTypeURL(&govv1.MsgVoteWeighted{})
+)
+
+// Approve is the precompile function for approving gov transactions with a generic grant.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, typeURL, err := checkApprovalArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typeURL {
+	case SubmitProposalMsgURL, DepositMsgURL, VoteMsgURL, VoteWeightedMsgURL:
+		if err := CreateGenericAuthz(ctx, p.AuthzKeeper, grantee, origin, typeURL); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf(cmn.ErrInvalidMsgType, "gov", typeURL)
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, grantee, origin, typeURL); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CreateGenericAuthz creates a generic authorization grant.
+func CreateGenericAuthz(
+	ctx sdk.Context,
+	authzKeeper authzkeeper.Keeper,
+	grantee, granter common.Address,
+	msg string,
+) error {
+	genericAuthorization := authz.GenericAuthorization{Msg: msg}
+
+	expiration := ctx.BlockTime().Add(cmn.DefaultExpirationDuration).UTC()
+	return authzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), &genericAuthorization, &expiration)
+}
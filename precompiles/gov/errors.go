@@ -0,0 +1,14 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+const (
+	// ErrDifferentOrigin is raised when the tx origin address is not the same as the gov transaction initiator.
+	ErrDifferentOrigin = "tx origin address %s does not match the %s address %s"
+	// ErrInvalidProposalID is raised when the proposal id is zero or cannot be cast to uint64.
+	ErrInvalidProposalID = "invalid proposal id: %v"
+	// ErrInvalidProposal is raised when the JSON encoded proposal cannot be decoded into a MsgSubmitProposal.
+	ErrInvalidProposal = "invalid proposal: %s"
+	// ErrInvalidVoteOption is raised when the vote option is not a valid gov vote option.
+	ErrInvalidVoteOption = "invalid vote option: %v"
+)
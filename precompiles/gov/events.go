@@ -0,0 +1,158 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v19/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+const (
+	// EventTypeSubmitProposal defines the event type for the gov SubmitProposal transaction.
+	EventTypeSubmitProposal = "SubmitProposal"
+	// EventTypeDeposit defines the event type for the gov Deposit transaction.
+	EventTypeDeposit = "Deposit"
+	// EventTypeVote defines the event type for the gov Vote transaction.
+	EventTypeVote = "Vote"
+	// EventTypeVoteWeighted defines the event type for the gov VoteWeighted transaction.
+	EventTypeVoteWeighted = "VoteWeighted"
+)
+
+// EmitApprovalEvent creates a new approval event emitted on an Approve transaction.
+func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, grantee, granter common.Address, typeURL string) error {
+	// Prepare the event topics
+	event := p.ABI.Events[authorization.EventTypeApproval]
+	topics, err := p.makeTopics(event, grantee, granter)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(typeURL)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitSubmitProposalEvent creates a new event emitted on a SubmitProposal transaction.
+func (p Precompile) EmitSubmitProposalEvent(ctx sdk.Context, stateDB vm.StateDB, proposerAddr common.Address, proposalID uint64) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeSubmitProposal]
+	topics, err := p.makeTopics(event, proposerAddr, proposalID)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, nil)
+	return nil
+}
+
+// EmitDepositEvent creates a new event emitted on a Deposit transaction.
+func (p Precompile) EmitDepositEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	depositorAddr common.Address,
+	proposalID uint64,
+	amount sdk.Coins,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeDeposit]
+	topics, err := p.makeTopics(event, depositorAddr, proposalID)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(cmn.NewCoinsResponse(amount))
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitVoteEvent creates a new event emitted on a Vote transaction.
+func (p Precompile) EmitVoteEvent(ctx sdk.Context, stateDB vm.StateDB, voterAddr common.Address, proposalID uint64, option uint8) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeVote]
+	topics, err := p.makeTopics(event, voterAddr, proposalID)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(option)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitVoteWeightedEvent creates a new event emitted on a VoteWeighted transaction.
+func (p Precompile) EmitVoteWeightedEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	voterAddr common.Address,
+	proposalID uint64,
+	options []WeightedVoteOption,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeVoteWeighted]
+	topics, err := p.makeTopics(event, voterAddr, proposalID)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(options)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// makeTopics returns the event topics: the event signature followed by the
+// given indexed values.
+func (p Precompile) makeTopics(event abi.Event, indexed ...interface{}) ([]common.Hash, error) {
+	topics := make([]common.Hash, len(indexed)+1)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	for i, value := range indexed {
+		topics[i+1], err = cmn.MakeTopic(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return topics, nil
+}
+
+// addLog adds the event log to the stateDB.
+func (p Precompile) addLog(ctx sdk.Context, stateDB vm.StateDB, topics []common.Hash, data []byte) {
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package gov
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+// PrecompileAddress of the gov EVM extension in hex format.
+const PrecompileAddress = "0x0000000000000000000000000000000000000805"
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for gov.
+type Precompile struct {
+	cmn.Precompile
+	govKeeper govkeeper.Keeper
+	codec     codec.Codec
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// NewPrecompile creates a new gov Precompile instance as a
+// PrecompiledContract interface. The codec is used to decode the
+// JSON encoded proposals passed to submitProposal.
+func NewPrecompile(
+	govKeeper govkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	cdc codec.Codec,
+) (*Precompile, error) {
+	newAbi, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, fmt.Errorf("error loading the gov ABI %s", err)
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newAbi,
+			AuthzKeeper:          authzKeeper,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+		},
+		govKeeper: govKeeper,
+		codec:     cdc,
+	}
+	// SetAddress defines the address of the gov compile contract.
+	p.SetAddress(common.HexToAddress(PrecompileAddress))
+	return p, nil
+}
+
+// Run executes the precompiled contract gov methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// Approval transaction
+	case authorization.ApproveMethod:
+		bz, err = p.Approve(ctx, evm.Origin, stateDB, method, args)
+	// Gov transactions
+	case SubmitProposalMethod:
+		bz, err = p.SubmitProposal(ctx, contract, evm.Origin, stateDB, method, args)
+	case DepositMethod:
+		bz, err = p.Deposit(ctx, contract, evm.Origin, stateDB, method, args)
+	case VoteMethod:
+		bz, err = p.Vote(ctx, contract, evm.Origin, stateDB, method, args)
+	case VoteWeightedMethod:
+		bz, err = p.VoteWeighted(ctx, contract, evm.Origin, stateDB, method, args)
+	// Gov queries
+	case GetProposalMethod:
+		bz, err = p.GetProposal(ctx, method, contract, args)
+	case GetVotesMethod:
+		bz, err = p.GetVotes(ctx, method, contract, args)
+	case GetTallyResultMethod:
+		bz, err = p.GetTallyResult(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available gov transactions are:
+//   - SubmitProposal
+//   - Deposit
+//   - Vote
+//   - VoteWeighted
+//   - Approve
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case SubmitProposalMethod,
+		DepositMethod,
+		VoteMethod,
+		VoteWeightedMethod,
+		authorization.ApproveMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "gov")
+}
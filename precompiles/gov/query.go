@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+const (
+	// GetProposalMethod defines the ABI method name for the gov Proposal query.
+	GetProposalMethod = "getProposal"
+	// GetVotesMethod defines the ABI method name for the gov Votes query.
+	GetVotesMethod = "getVotes"
+	// GetTallyResultMethod defines the ABI method name for the gov TallyResult query.
+	GetTallyResultMethod = "getTallyResult"
+)
+
+// GetProposal returns the proposal with the given id.
+func (p Precompile) GetProposal(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewProposalRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.govKeeper.Proposal(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(ProposalData).FromResponse(res.Proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// GetVotes returns the votes cast on a proposal with pagination.
+func (p Precompile) GetVotes(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewVotesRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.govKeeper.Votes(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(VotesOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GetTallyResult returns the tally of a proposal. It is the current tally while
+// the proposal is in its voting period and the final one once it has ended.
+func (p Precompile) GetTallyResult(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewTallyResultRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.govKeeper.TallyResult(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewTallyResultData(res.Tally))
+}
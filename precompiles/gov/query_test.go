@@ -0,0 +1,114 @@
+package gov_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/evmos/evmos/v19/precompiles/gov"
+	"github.com/evmos/evmos/v19/utils"
+)
+
+func (s *PrecompileTestSuite) TestGetProposal() {
+	method := s.precompile.Methods[gov.GetProposalMethod]
+
+	s.Run("fail - proposal not found", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetProposal(s.network.GetContext(), &method, nil, []interface{}{uint64(10)})
+		s.Require().ErrorContains(err, "doesn't exist")
+	})
+
+	s.Run("success", func() {
+		s.SetupTest()
+		proposer := s.keyring.GetKey(0).Addr
+		proposalID := s.submitVotingProposal(proposer)
+
+		bz, err := s.precompile.GetProposal(s.network.GetContext(), &method, nil, []interface{}{proposalID})
+		s.Require().NoError(err)
+
+		var out struct{ Proposal gov.ProposalData }
+		err = s.precompile.UnpackIntoInterface(&out, gov.GetProposalMethod, bz)
+		s.Require().NoError(err)
+
+		s.Require().Equal(proposalID, out.Proposal.Id)
+		s.Require().Equal(uint32(govv1.StatusVotingPeriod), out.Proposal.Status)
+		s.Require().Equal(proposer, out.Proposal.Proposer)
+		s.Require().Equal("test proposal", out.Proposal.Title)
+		s.Require().Equal("test summary", out.Proposal.Summary)
+		s.Require().Equal("ipfs://test", out.Proposal.Metadata)
+		s.Require().Empty(out.Proposal.Messages)
+		s.Require().Len(out.Proposal.TotalDeposit, 1)
+		s.Require().Equal(utils.BaseDenom, out.Proposal.TotalDeposit[0].Denom)
+		s.Require().Equal("1000000000000000000", out.Proposal.TotalDeposit[0].Amount.String())
+		s.Require().NotZero(out.Proposal.VotingStartTime)
+		s.Require().Greater(out.Proposal.VotingEndTime, out.Proposal.VotingStartTime)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetVotes() {
+	method := s.precompile.Methods[gov.GetVotesMethod]
+
+	s.Run("fail - invalid proposal id", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetVotes(s.network.GetContext(), &method, nil, []interface{}{uint64(0), query.PageRequest{}})
+		s.Require().ErrorContains(err, fmt.Sprintf(gov.ErrInvalidProposalID, 0))
+	})
+
+	s.Run("success", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		proposalID := s.submitVotingProposal(s.keyring.GetKey(0).Addr)
+		for i, option := range []govv1.VoteOption{govv1.OptionYes, govv1.OptionNo} {
+			err := s.network.App.GovKeeper.AddVote(ctx, proposalID, s.keyring.GetAccAddr(i), govv1.NewNonSplitVoteOption(option), "")
+			s.Require().NoError(err)
+		}
+
+		bz, err := s.precompile.GetVotes(ctx, &method, nil, []interface{}{proposalID, query.PageRequest{Limit: 1, CountTotal: true}})
+		s.Require().NoError(err)
+
+		var out gov.VotesOutput
+		err = s.precompile.UnpackIntoInterface(&out, gov.GetVotesMethod, bz)
+		s.Require().NoError(err)
+
+		s.Require().Len(out.Votes, 1)
+		s.Require().Equal(uint64(2), out.PageResponse.Total)
+		s.Require().NotEmpty(out.PageResponse.NextKey)
+		s.Require().Equal(proposalID, out.Votes[0].ProposalId)
+		s.Require().Len(out.Votes[0].Options, 1)
+		s.Require().Equal("1.000000000000000000", out.Votes[0].Options[0].Weight)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetTallyResult() {
+	method := s.precompile.Methods[gov.GetTallyResultMethod]
+
+	s.Run("fail - proposal not found", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetTallyResult(s.network.GetContext(), &method, nil, []interface{}{uint64(10)})
+		s.Require().ErrorContains(err, "doesn't exist")
+	})
+
+	s.Run("success - current tally of a proposal in the voting period", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		proposalID := s.submitVotingProposal(s.keyring.GetKey(0).Addr)
+
+		// the validator operator votes yes with its self delegation
+		validator := s.network.GetValidators()[0]
+		valAddr := validator.GetOperator()
+		err := s.network.App.GovKeeper.AddVote(ctx, proposalID, valAddr.Bytes(), govv1.NewNonSplitVoteOption(govv1.OptionYes), "")
+		s.Require().NoError(err)
+
+		bz, err := s.precompile.GetTallyResult(ctx, &method, nil, []interface{}{proposalID})
+		s.Require().NoError(err)
+
+		var out struct{ TallyResult gov.TallyResultData }
+		err = s.precompile.UnpackIntoInterface(&out, gov.GetTallyResultMethod, bz)
+		s.Require().NoError(err)
+
+		s.Require().Equal("0", out.TallyResult.No)
+		s.Require().Equal("0", out.TallyResult.Abstain)
+		s.Require().Equal("0", out.TallyResult.NoWithVeto)
+		s.Require().NotEmpty(out.TallyResult.Yes)
+	})
+}
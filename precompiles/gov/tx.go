@@ -0,0 +1,232 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+const (
+	// SubmitProposalMethod defines the ABI method name for the gov SubmitProposal transaction.
+	SubmitProposalMethod = "submitProposal"
+	// DepositMethod defines the ABI method name for the gov Deposit transaction.
+	DepositMethod = "deposit"
+	// VoteMethod defines the ABI method name for the gov Vote transaction.
+	VoteMethod = "vote"
+	// VoteWeightedMethod defines the ABI method name for the gov VoteWeighted transaction.
+	VoteWeightedMethod = "voteWeighted"
+)
+
+// SubmitProposal submits a new proposal from the JSON encoded MsgSubmitProposal
+// and returns its id.
+func (p *Precompile) SubmitProposal(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerAddr, err := NewMsgSubmitProposal(p.codec, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	isContractCaller, err := p.checkOrigin(ctx, contract, origin, proposerAddr, "proposer", SubmitProposalMsgURL)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ proposer: %s, initial_deposit: %s, title: %s }",
+			msg.Proposer, msg.InitialDeposit, msg.Title,
+		),
+	)
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	res, err := msgSrv.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if isContractCaller {
+		// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+		amt := sdk.Coins(msg.InitialDeposit).AmountOf(utils.BaseDenom).BigInt()
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(proposerAddr, amt, cmn.Sub))
+	}
+
+	if err = p.EmitSubmitProposalEvent(ctx, stateDB, proposerAddr, res.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ProposalId)
+}
+
+// Deposit adds a deposit to a proposal that is in its deposit or voting period.
+func (p *Precompile) Deposit(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, depositorAddr, err := NewMsgDeposit(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	isContractCaller, err := p.checkOrigin(ctx, contract, origin, depositorAddr, "depositor", DepositMsgURL)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ depositor: %s, proposal_id: %d, amount: %s }",
+			msg.Depositor, msg.ProposalId, msg.Amount,
+		),
+	)
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Deposit(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if isContractCaller {
+		// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+		amt := sdk.Coins(msg.Amount).AmountOf(utils.BaseDenom).BigInt()
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(depositorAddr, amt, cmn.Sub))
+	}
+
+	if err = p.EmitDepositEvent(ctx, stateDB, depositorAddr, msg.ProposalId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Vote casts a vote with a single option on a proposal in its voting period.
+func (p *Precompile) Vote(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, voterAddr, err := NewMsgVote(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.checkOrigin(ctx, contract, origin, voterAddr, "voter", VoteMsgURL); err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ voter: %s, proposal_id: %d, option: %s }",
+			msg.Voter, msg.ProposalId, msg.Option,
+		),
+	)
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Vote(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	option := uint8(msg.Option) // #nosec G701 -- the option is validated when parsing the args
+	if err = p.EmitVoteEvent(ctx, stateDB, voterAddr, msg.ProposalId, option); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// VoteWeighted casts a vote split across several weighted options on a proposal in
+// its voting period.
+func (p *Precompile) VoteWeighted(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, voterAddr, options, err := NewMsgVoteWeighted(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.checkOrigin(ctx, contract, origin, voterAddr, "voter", VoteWeightedMsgURL); err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ voter: %s, proposal_id: %d, options: %s }",
+			msg.Voter, msg.ProposalId, msg.Options,
+		),
+	)
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.VoteWeighted(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitVoteWeightedEvent(ctx, stateDB, voterAddr, msg.ProposalId, options); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// checkOrigin checks that the account acting in the transaction is either the
+// origin or the contract calling the precompile. When a contract calls the
+// precompile on behalf of the origin, an authorization for the given message
+// type must exist. It returns whether the precompile is called by a contract.
+func (p Precompile) checkOrigin(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	origin, account common.Address,
+	role, msgURL string,
+) (bool, error) {
+	isContractCaller := contract.CallerAddress != origin
+
+	// the account can only be the origin or the contract.Caller
+	isContractAccount := contract.CallerAddress == account && isContractCaller
+
+	if !isContractAccount && origin != account {
+		return false, fmt.Errorf(ErrDifferentOrigin, origin, role, account)
+	}
+
+	// in case the contract is the account don't check for auth.
+	// The smart contract should handle who is authorized to make this call
+	if isContractCaller && !isContractAccount {
+		_, _, err := authorization.CheckAuthzExists(ctx, p.AuthzKeeper, contract.CallerAddress, account, msgURL)
+		if err != nil {
+			return false, fmt.Errorf(authorization.ErrAuthzDoesNotExistOrExpired, msgURL, contract.CallerAddress)
+		}
+	}
+
+	return isContractCaller, nil
+}
@@ -0,0 +1,498 @@
+package gov_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/precompiles/gov"
+	"github.com/evmos/evmos/v19/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/utils"
+)
+
+func (s *PrecompileTestSuite) TestSubmitProposal() {
+	method := s.precompile.Methods[gov.SubmitProposalMethod]
+	var proposer common.Address
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{proposer, textProposal}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2),
+		},
+		{
+			"fail - proposer different from origin",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), textProposal, minDeposit()}
+			},
+			func([]byte) {},
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"fail - invalid JSON proposal",
+			func() []interface{} {
+				return []interface{}{proposer, []byte("{"), minDeposit()}
+			},
+			func([]byte) {},
+			true,
+			"invalid proposal",
+		},
+		{
+			"fail - proposal without title",
+			func() []interface{} {
+				return []interface{}{proposer, []byte(`{"summary":"test summary","metadata":"ipfs://test"}`), minDeposit()}
+			},
+			func([]byte) {},
+			true,
+			"proposal title cannot be empty",
+		},
+		{
+			"success - proposal enters the voting period",
+			func() []interface{} {
+				return []interface{}{proposer, textProposal, minDeposit()}
+			},
+			func(bz []byte) {
+				out, err := s.precompile.Unpack(gov.SubmitProposalMethod, bz)
+				s.Require().NoError(err)
+				proposalID, ok := out[0].(uint64)
+				s.Require().True(ok)
+
+				proposal, found := s.network.App.GovKeeper.GetProposal(s.network.GetContext(), proposalID)
+				s.Require().True(found)
+				s.Require().Equal(govv1.StatusVotingPeriod, proposal.Status)
+				s.Require().Equal(sdk.AccAddress(proposer.Bytes()).String(), proposal.Proposer)
+				s.Require().Equal("test proposal", proposal.Title)
+			},
+			false,
+			"",
+		},
+		{
+			"success - proposer and deposit in the JSON are overridden",
+			func() []interface{} {
+				jsonProposal := []byte(fmt.Sprintf(
+					`{"title":"test proposal","summary":"test summary","metadata":"ipfs://test","proposer":"%s","initial_deposit":[{"denom":"%s","amount":"1"}]}`,
+					sdk.AccAddress(utiltx.GenerateAddress().Bytes()), utils.BaseDenom,
+				))
+				return []interface{}{proposer, jsonProposal, []cmn.Coin{}}
+			},
+			func(bz []byte) {
+				out, err := s.precompile.Unpack(gov.SubmitProposalMethod, bz)
+				s.Require().NoError(err)
+				proposalID, ok := out[0].(uint64)
+				s.Require().True(ok)
+
+				proposal, found := s.network.App.GovKeeper.GetProposal(s.network.GetContext(), proposalID)
+				s.Require().True(found)
+				s.Require().Equal(govv1.StatusDepositPeriod, proposal.Status)
+				s.Require().Equal(sdk.AccAddress(proposer.Bytes()).String(), proposal.Proposer)
+				s.Require().True(sdk.Coins(proposal.TotalDeposit).IsZero())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			proposer = s.keyring.GetKey(0).Addr
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), proposer, s.precompile, 200_000)
+
+			bz, err := s.precompile.SubmitProposal(ctx, contract, proposer, stateDB, &method, tc.malleate())
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+
+				// the SubmitProposal event is emitted
+				logs := stateDB.Logs()
+				s.Require().Len(logs, 1)
+				s.Require().Equal(s.precompile.ABI.Events[gov.EventTypeSubmitProposal].ID, logs[0].Topics[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDeposit() {
+	method := s.precompile.Methods[gov.DepositMethod]
+	var depositor common.Address
+
+	testCases := []struct {
+		name        string
+		malleate    func(proposalID uint64) []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid proposal id",
+			func(uint64) []interface{} {
+				return []interface{}{depositor, uint64(0), minDeposit()}
+			},
+			true,
+			fmt.Sprintf(gov.ErrInvalidProposalID, 0),
+		},
+		{
+			"fail - negative amount",
+			func(proposalID uint64) []interface{} {
+				return []interface{}{depositor, proposalID, []cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(-1)}}}
+			},
+			true,
+			"invalid amount",
+		},
+		{
+			"fail - depositor different from origin",
+			func(proposalID uint64) []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), proposalID, minDeposit()}
+			},
+			true,
+			"does not match the depositor address",
+		},
+		{
+			"fail - proposal not found",
+			func(proposalID uint64) []interface{} {
+				return []interface{}{depositor, proposalID + 1, minDeposit()}
+			},
+			true,
+			"unknown proposal",
+		},
+		{
+			"success",
+			func(proposalID uint64) []interface{} {
+				return []interface{}{depositor, proposalID, minDeposit()}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			depositor = s.keyring.GetKey(0).Addr
+			proposalID := s.submitProposal(s.keyring.GetKey(1).Addr, sdk.NewCoins())
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), depositor, s.precompile, 200_000)
+
+			bz, err := s.precompile.Deposit(ctx, contract, depositor, stateDB, &method, tc.malleate(proposalID))
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			deposit, found := s.network.App.GovKeeper.GetDeposit(ctx, proposalID, depositor.Bytes())
+			s.Require().True(found)
+			s.Require().Equal(int64(1e18), sdk.Coins(deposit.Amount).AmountOf(utils.BaseDenom).Int64())
+
+			proposal, found := s.network.App.GovKeeper.GetProposal(ctx, proposalID)
+			s.Require().True(found)
+			s.Require().Equal(govv1.StatusVotingPeriod, proposal.Status)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[gov.EventTypeDeposit].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestVote() {
+	method := s.precompile.Methods[gov.VoteMethod]
+	var voter common.Address
+
+	testCases := []struct {
+		name        string
+		malleate    func(proposalID uint64) []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(proposalID uint64) []interface{} {
+				return []interface{}{voter, proposalID, uint8(govv1.OptionYes)}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 3),
+		},
+		{
+			"fail - invalid vote option",
+			func(proposalID uint64) []interface{} {
+				return []interface{}{voter, proposalID, uint8(10), ""}
+			},
+			true,
+			fmt.Sprintf(gov.ErrInvalidVoteOption, 10),
+		},
+		{
+			"fail - voter different from origin",
+			func(proposalID uint64) []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), proposalID, uint8(govv1.OptionYes), ""}
+			},
+			true,
+			"does not match the voter address",
+		},
+		{
+			"fail - proposal not in the voting period",
+			func(proposalID uint64) []interface{} {
+				return []interface{}{voter, proposalID + 1, uint8(govv1.OptionYes), ""}
+			},
+			true,
+			"inactive proposal",
+		},
+		{
+			"success",
+			func(proposalID uint64) []interface{} {
+				return []interface{}{voter, proposalID, uint8(govv1.OptionYes), "vote metadata"}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			voter = s.keyring.GetKey(0).Addr
+			proposalID := s.submitVotingProposal(s.keyring.GetKey(1).Addr)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), voter, s.precompile, 200_000)
+
+			bz, err := s.precompile.Vote(ctx, contract, voter, stateDB, &method, tc.malleate(proposalID))
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			vote, found := s.network.App.GovKeeper.GetVote(ctx, proposalID, voter.Bytes())
+			s.Require().True(found)
+			s.Require().Equal(govv1.NewNonSplitVoteOption(govv1.OptionYes), govv1.WeightedVoteOptions(vote.Options))
+			s.Require().Equal("vote metadata", vote.Metadata)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[gov.EventTypeVote].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestVoteWeighted() {
+	method := s.precompile.Methods[gov.VoteWeightedMethod]
+	var voter common.Address
+
+	testCases := []struct {
+		name        string
+		options     []gov.WeightedVoteOption
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid weight",
+			[]gov.WeightedVoteOption{{Option: uint8(govv1.OptionYes), Weight: "half"}},
+			true,
+			"invalid vote option",
+		},
+		{
+			"fail - weights do not add up to one",
+			[]gov.WeightedVoteOption{
+				{Option: uint8(govv1.OptionYes), Weight: "0.5"},
+				{Option: uint8(govv1.OptionNo), Weight: "0.2"},
+			},
+			true,
+			"Total weight",
+		},
+		{
+			"success",
+			[]gov.WeightedVoteOption{
+				{Option: uint8(govv1.OptionYes), Weight: "0.7"},
+				{Option: uint8(govv1.OptionAbstain), Weight: "0.3"},
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			voter = s.keyring.GetKey(0).Addr
+			proposalID := s.submitVotingProposal(s.keyring.GetKey(1).Addr)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), voter, s.precompile, 200_000)
+
+			args := []interface{}{voter, proposalID, tc.options, ""}
+			bz, err := s.precompile.VoteWeighted(ctx, contract, voter, stateDB, &method, args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			vote, found := s.network.App.GovKeeper.GetVote(ctx, proposalID, voter.Bytes())
+			s.Require().True(found)
+			s.Require().Len(vote.Options, 2)
+			s.Require().Equal(govv1.OptionYes, vote.Options[0].Option)
+			s.Require().Equal(sdk.MustNewDecFromStr("0.7").String(), vote.Options[0].Weight)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[gov.EventTypeVoteWeighted].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestVoteFromContract() {
+	method := s.precompile.Methods[gov.VoteMethod]
+	var origin common.Address
+	contractAddr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		isVoter     bool
+		malleate    func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			"success - contract votes on its own behalf",
+			true,
+			func() {},
+			false,
+			"",
+		},
+		{
+			"fail - contract votes on behalf of the origin without approval",
+			false,
+			func() {},
+			true,
+			fmt.Sprintf(authorization.ErrAuthzDoesNotExistOrExpired, gov.VoteMsgURL, contractAddr),
+		},
+		{
+			"success - contract votes on behalf of the origin with approval",
+			false,
+			func() {
+				err := gov.CreateGenericAuthz(s.network.GetContext(), s.network.App.AuthzKeeper, contractAddr, origin, gov.VoteMsgURL)
+				s.Require().NoError(err)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			origin = s.keyring.GetKey(0).Addr
+			proposalID := s.submitVotingProposal(s.keyring.GetKey(1).Addr)
+			tc.malleate()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), contractAddr, s.precompile, 200_000)
+
+			voter := origin
+			if tc.isVoter {
+				voter = contractAddr
+			}
+
+			args := []interface{}{voter, proposalID, uint8(govv1.OptionNo), ""}
+			bz, err := s.precompile.Vote(ctx, contract, origin, stateDB, &method, args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			vote, found := s.network.App.GovKeeper.GetVote(ctx, proposalID, voter.Bytes())
+			s.Require().True(found)
+			s.Require().Equal(govv1.OptionNo, vote.Options[0].Option)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestApprove() {
+	method := s.precompile.Methods[authorization.ApproveMethod]
+	var granter common.Address
+	grantee := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		typeURL     string
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - not a gov message",
+			"/cosmos.bank.v1beta1.MsgSend",
+			true,
+			fmt.Sprintf(cmn.ErrInvalidMsgType, "gov", "/cosmos.bank.v1beta1.MsgSend"),
+		},
+		{
+			"success - vote",
+			gov.VoteMsgURL,
+			false,
+			"",
+		},
+		{
+			"success - submit proposal",
+			gov.SubmitProposalMsgURL,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0).Addr
+			stateDB := s.network.GetStateDB()
+			ctx := s.network.GetContext()
+
+			bz, err := s.precompile.Approve(ctx, granter, stateDB, &method, []interface{}{grantee, tc.typeURL})
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			authz, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), tc.typeURL)
+			s.Require().NotNil(authz)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[authorization.EventTypeApproval].ID, logs[0].Topics[0])
+			s.Require().Equal(common.BytesToHash(grantee.Bytes()), logs[0].Topics[1])
+			s.Require().Equal(common.BytesToHash(granter.Bytes()), logs[0].Topics[2])
+		})
+	}
+}
@@ -0,0 +1,442 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package gov
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+)
+
+// WeightedVoteOption defines a vote option together with the weight given to it.
+type WeightedVoteOption struct {
+	Option uint8
+	Weight string
+}
+
+// WeightedVoteOptions is a struct used to parse the options parameter
+// used as input in the voteWeighted transaction.
+type WeightedVoteOptions struct {
+	Options []WeightedVoteOption
+}
+
+// Coins is a struct used to parse the coins parameters used as input
+// in the submitProposal and deposit transactions.
+type Coins struct {
+	Coins []cmn.Coin
+}
+
+// TallyResultData defines the tally of a proposal as returned by the precompile.
+type TallyResultData struct {
+	Yes        string
+	Abstain    string
+	No         string
+	NoWithVeto string
+}
+
+// ProposalData defines the proposal information returned by the getProposal query.
+// Times are unix timestamps in seconds and are zero when not set.
+type ProposalData struct {
+	Id               uint64 //nolint
+	Messages         []string
+	Status           uint32
+	FinalTallyResult TallyResultData
+	SubmitTime       uint64
+	DepositEndTime   uint64
+	TotalDeposit     []cmn.Coin
+	VotingStartTime  uint64
+	VotingEndTime    uint64
+	Metadata         string
+	Title            string
+	Summary          string
+	Proposer         common.Address
+}
+
+// WeightedVote defines a vote cast on a proposal as returned by the getVotes query.
+type WeightedVote struct {
+	ProposalId uint64 //nolint
+	Voter      common.Address
+	Options    []WeightedVoteOption
+	Metadata   string
+}
+
+// VotesInput is a struct to represent the input information for
+// the votes query. Needed to unpack arguments into the PageRequest struct.
+type VotesInput struct {
+	ProposalId  uint64 //nolint
+	PageRequest query.PageRequest
+}
+
+// VotesOutput is a struct to represent the key information from
+// a votes response.
+type VotesOutput struct {
+	Votes        []WeightedVote
+	PageResponse query.PageResponse
+}
+
+// checkApprovalArgs checks the arguments passed to the approve function.
+func checkApprovalArgs(args []interface{}) (common.Address, string, error) {
+	if len(args) != 2 {
+		return common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(authorization.ErrInvalidGrantee, args[0])
+	}
+
+	typeURL, ok := args[1].(string)
+	if !ok || typeURL == "" {
+		return common.Address{}, "", fmt.Errorf(authorization.ErrInvalidMethod, args[1])
+	}
+
+	return grantee, typeURL, nil
+}
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal instance from the JSON encoded
+// proposal. The proposer and initial deposit given as arguments take precedence over
+// the ones included in the JSON.
+func NewMsgSubmitProposal(
+	cdc codec.Codec,
+	method *abi.Method,
+	args []interface{},
+) (*govv1.MsgSubmitProposal, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	proposerAddr, ok := args[0].(common.Address)
+	if !ok || proposerAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "proposer", common.Address{}, args[0])
+	}
+
+	jsonProposal, ok := args[1].([]byte)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "jsonProposal", []byte{}, args[1])
+	}
+
+	deposit, err := parseCoins(method, 2, args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &govv1.MsgSubmitProposal{}
+	if err := cdc.UnmarshalJSON(jsonProposal, msg); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposal, err)
+	}
+
+	msg.Proposer = sdk.AccAddress(proposerAddr.Bytes()).String()
+	msg.InitialDeposit = deposit
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, proposerAddr, nil
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgDeposit(method *abi.Method, args []interface{}) (*govv1.MsgDeposit, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	depositorAddr, ok := args[0].(common.Address)
+	if !ok || depositorAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "depositor", common.Address{}, args[0])
+	}
+
+	proposalID, err := parseProposalID(args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	amount, err := parseCoins(method, 2, args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := govv1.NewMsgDeposit(depositorAddr.Bytes(), proposalID, amount)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, depositorAddr, nil
+}
+
+// NewMsgVote creates a new MsgVote instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgVote(args []interface{}) (*govv1.MsgVote, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	voterAddr, ok := args[0].(common.Address)
+	if !ok || voterAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "voter", common.Address{}, args[0])
+	}
+
+	proposalID, err := parseProposalID(args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	option, ok := args[2].(uint8)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "option", uint8(0), args[2])
+	}
+
+	metadata, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "metadata", "", args[3])
+	}
+
+	voteOption := govv1.VoteOption(option)
+	if !govv1.ValidVoteOption(voteOption) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidVoteOption, option)
+	}
+
+	msg := govv1.NewMsgVote(voterAddr.Bytes(), proposalID, voteOption, metadata)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, voterAddr, nil
+}
+
+// NewMsgVoteWeighted creates a new MsgVoteWeighted instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgVoteWeighted(method *abi.Method, args []interface{}) (*govv1.MsgVoteWeighted, common.Address, []WeightedVoteOption, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	voterAddr, ok := args[0].(common.Address)
+	if !ok || voterAddr == (common.Address{}) {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "voter", common.Address{}, args[0])
+	}
+
+	proposalID, err := parseProposalID(args[1])
+	if err != nil {
+		return nil, common.Address{}, nil, err
+	}
+
+	var input WeightedVoteOptions
+	arguments := abi.Arguments{method.Inputs[2]}
+	if err := arguments.Copy(&input, []interface{}{args[2]}); err != nil {
+		return nil, common.Address{}, nil, fmt.Errorf("error while unpacking args to WeightedVoteOptions struct: %s", err)
+	}
+
+	metadata, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "metadata", "", args[3])
+	}
+
+	options := make(govv1.WeightedVoteOptions, len(input.Options))
+	for i, o := range input.Options {
+		weight, err := sdk.NewDecFromStr(o.Weight)
+		if err != nil {
+			return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidVoteOption, o)
+		}
+		options[i] = govv1.NewWeightedVoteOption(govv1.VoteOption(o.Option), weight)
+	}
+
+	msg := govv1.NewMsgVoteWeighted(voterAddr.Bytes(), proposalID, options, metadata)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, nil, err
+	}
+
+	return msg, voterAddr, input.Options, nil
+}
+
+// NewProposalRequest creates a new QueryProposalRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewProposalRequest(args []interface{}) (*govv1.QueryProposalRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	proposalID, err := parseProposalID(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &govv1.QueryProposalRequest{ProposalId: proposalID}, nil
+}
+
+// NewTallyResultRequest creates a new QueryTallyResultRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewTallyResultRequest(args []interface{}) (*govv1.QueryTallyResultRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	proposalID, err := parseProposalID(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &govv1.QueryTallyResultRequest{ProposalId: proposalID}, nil
+}
+
+// NewVotesRequest creates a new QueryVotesRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewVotesRequest(method *abi.Method, args []interface{}) (*govv1.QueryVotesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input VotesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to VotesInput struct: %s", err)
+	}
+
+	if input.ProposalId == 0 {
+		return nil, fmt.Errorf(ErrInvalidProposalID, input.ProposalId)
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &govv1.QueryVotesRequest{
+		ProposalId: input.ProposalId,
+		Pagination: &input.PageRequest,
+	}, nil
+}
+
+// FromResponse populates the ProposalData from a Proposal.
+func (pd *ProposalData) FromResponse(proposal *govv1.Proposal) (*ProposalData, error) {
+	proposer, err := sdk.AccAddressFromBech32(proposal.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	pd.Id = proposal.Id
+	pd.Messages = make([]string, len(proposal.Messages))
+	for i, msg := range proposal.Messages {
+		pd.Messages[i] = msg.TypeUrl
+	}
+	pd.Status = uint32(proposal.Status)
+	pd.FinalTallyResult = NewTallyResultData(proposal.FinalTallyResult)
+	pd.SubmitTime = unixTime(proposal.SubmitTime)
+	pd.DepositEndTime = unixTime(proposal.DepositEndTime)
+	pd.TotalDeposit = cmn.NewCoinsResponse(proposal.TotalDeposit)
+	pd.VotingStartTime = unixTime(proposal.VotingStartTime)
+	pd.VotingEndTime = unixTime(proposal.VotingEndTime)
+	pd.Metadata = proposal.Metadata
+	pd.Title = proposal.Title
+	pd.Summary = proposal.Summary
+	pd.Proposer = common.BytesToAddress(proposer.Bytes())
+
+	return pd, nil
+}
+
+// NewTallyResultData creates a TallyResultData from a gov TallyResult. A nil
+// tally result is returned as an empty tally.
+func NewTallyResultData(tally *govv1.TallyResult) TallyResultData {
+	if tally == nil {
+		empty := govv1.EmptyTallyResult()
+		tally = &empty
+	}
+
+	return TallyResultData{
+		Yes:        tally.YesCount,
+		Abstain:    tally.AbstainCount,
+		No:         tally.NoCount,
+		NoWithVeto: tally.NoWithVetoCount,
+	}
+}
+
+// FromResponse populates the VotesOutput from a QueryVotesResponse.
+func (vo *VotesOutput) FromResponse(res *govv1.QueryVotesResponse) (*VotesOutput, error) {
+	vo.Votes = make([]WeightedVote, len(res.Votes))
+	for i, v := range res.Votes {
+		voter, err := sdk.AccAddressFromBech32(v.Voter)
+		if err != nil {
+			return nil, err
+		}
+
+		options := make([]WeightedVoteOption, len(v.Options))
+		for j, o := range v.Options {
+			options[j] = WeightedVoteOption{
+				Option: uint8(o.Option), // #nosec G701 -- vote options are a small enum
+				Weight: o.Weight,
+			}
+		}
+
+		vo.Votes[i] = WeightedVote{
+			ProposalId: v.ProposalId,
+			Voter:      common.BytesToAddress(voter.Bytes()),
+			Options:    options,
+			Metadata:   v.Metadata,
+		}
+	}
+
+	if res.Pagination != nil {
+		vo.PageResponse.Total = res.Pagination.Total
+		vo.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return vo, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (vo *VotesOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(vo.Votes, vo.PageResponse)
+}
+
+// parseProposalID parses a proposal id argument, which must be a non-zero uint64.
+func parseProposalID(arg interface{}) (uint64, error) {
+	proposalID, ok := arg.(uint64)
+	if !ok || proposalID == 0 {
+		return 0, fmt.Errorf(ErrInvalidProposalID, arg)
+	}
+
+	return proposalID, nil
+}
+
+// parseCoins parses the Coin[] argument at the given index into sorted and
+// validated sdk.Coins.
+func parseCoins(method *abi.Method, index int, args []interface{}) (sdk.Coins, error) {
+	var input Coins
+	arguments := abi.Arguments{method.Inputs[index]}
+	if err := arguments.Copy(&input, []interface{}{args[index]}); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to Coins struct: %s", err)
+	}
+
+	coins := make(sdk.Coins, len(input.Coins))
+	for i, coin := range input.Coins {
+		if coin.Amount == nil || coin.Amount.Sign() < 0 {
+			return nil, fmt.Errorf(cmn.ErrInvalidAmount, coin.Amount)
+		}
+		coins[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+
+	coins = coins.Sort()
+	if err := coins.Validate(); err != nil {
+		return nil, err
+	}
+
+	return coins, nil
+}
+
+// unixTime returns the unix timestamp of the given time or zero if it is not set.
+func unixTime(t *time.Time) uint64 {
+	if t == nil || t.IsZero() {
+		return 0
+	}
+
+	return uint64(t.Unix()) // #nosec G701 -- block times are positive
+}
@@ -0,0 +1,58 @@
+package gov_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/precompiles/gov"
+	"github.com/evmos/evmos/v19/utils"
+)
+
+// textProposal is a JSON encoded MsgSubmitProposal without messages.
+var textProposal = []byte(`{"title":"test proposal","summary":"test summary","metadata":"ipfs://test"}`)
+
+// minDeposit returns the minimum deposit to enter the voting period, as set in
+// the genesis of the test network.
+func minDeposit() []cmn.Coin {
+	return []cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(1e18)}}
+}
+
+// setupGovPrecompile is a helper function to set up an instance of the gov
+// precompile.
+func (s *PrecompileTestSuite) setupGovPrecompile() *gov.Precompile {
+	precompile, err := gov.NewPrecompile(
+		s.network.App.GovKeeper,
+		s.network.App.AuthzKeeper,
+		s.network.App.AppCodec(),
+	)
+	s.Require().NoError(err, "failed to create gov precompile")
+
+	return precompile
+}
+
+// submitProposal is a helper function to submit a text proposal from the
+// given proposer and return its id.
+func (s *PrecompileTestSuite) submitProposal(proposer common.Address, deposit sdk.Coins) uint64 {
+	msgSrv := govkeeper.NewMsgServerImpl(&s.network.App.GovKeeper)
+	res, err := msgSrv.SubmitProposal(sdk.WrapSDKContext(s.network.GetContext()), &govv1.MsgSubmitProposal{
+		InitialDeposit: deposit,
+		Proposer:       sdk.AccAddress(proposer.Bytes()).String(),
+		Metadata:       "ipfs://test",
+		Title:          "test proposal",
+		Summary:        "test summary",
+	})
+	s.Require().NoError(err, "failed to submit proposal")
+
+	return res.ProposalId
+}
+
+// submitVotingProposal is a helper function to submit a text proposal that
+// is in its voting period and return its id.
+func (s *PrecompileTestSuite) submitVotingProposal(proposer common.Address) uint64 {
+	deposit := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1e18)))
+	return s.submitProposal(proposer, deposit)
+}
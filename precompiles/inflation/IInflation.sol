@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IInflation contract's address.
+address constant IINFLATION_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000901;
+
+/// @dev The IInflation contract's instance.
+IInflation constant IINFLATION_CONTRACT = IInflation(IINFLATION_PRECOMPILE_ADDRESS);
+
+/// @dev HalvingInfo specifies the halving schedule and the supply of the mint denom.
+struct HalvingInfo {
+  /// currentEpoch is the number of the current day epoch.
+  int64 currentEpoch;
+  /// currentPeriod is the halving period of the current day epoch.
+  uint64 currentPeriod;
+  /// startEpoch is the day epoch at which the halving schedule started.
+  uint64 startEpoch;
+  /// lastHalvingEpoch is the day epoch at which the last halving occurred.
+  uint64 lastHalvingEpoch;
+  /// halvingIntervalEpochs is the number of day epochs in a halving period.
+  uint64 halvingIntervalEpochs;
+  /// nextHalvingEpoch is the day epoch at which the next halving period starts.
+  int64 nextHalvingEpoch;
+  /// epochsUntilHalving is the number of day epochs until the next halving.
+  int64 epochsUntilHalving;
+  /// dailyEmission is the amount minted at the end of the current day epoch.
+  uint256 dailyEmission;
+  /// maxSupply is the supply cap of the mint denom.
+  uint256 maxSupply;
+  /// circulatingSupply is the circulating supply of the mint denom.
+  uint256 circulatingSupply;
+}
+
+/**
+ * @author Nexqloud Team
+ * @title Inflation Interface
+ * @dev Interface for querying the halving schedule and the supply of the
+ * native token.
+ */
+interface IInflation {
+  /// @dev halvingPeriod defines a method for retrieving the current halving period.
+  /// @return period the halving period, starting at 0
+  function halvingPeriod() external view returns (uint64 period);
+
+  /// @dev dailyEmission defines a method for retrieving the amount minted at the
+  /// end of the current day epoch.
+  /// @return emission the daily emission with the halving applied
+  function dailyEmission() external view returns (uint256 emission);
+
+  /// @dev nextHalvingEpoch defines a method for retrieving the day epoch at
+  /// which the next halving occurs.
+  /// @return epoch the day epoch of the next halving
+  /// @return epochsUntilHalving the number of day epochs until the next halving
+  function nextHalvingEpoch() external view returns (int64 epoch, int64 epochsUntilHalving);
+
+  /// @dev maxSupply defines a method for retrieving the supply cap of the
+  /// native token.
+  /// @return supply the max supply
+  function maxSupply() external view returns (uint256 supply);
+
+  /// @dev circulatingSupply defines a method for retrieving the circulating
+  /// supply of the native token.
+  /// @return supply the circulating supply
+  function circulatingSupply() external view returns (uint256 supply);
+
+  /// @dev getHalvingInfo defines a method for retrieving the full halving
+  /// schedule and supply in a single call.
+  /// @return info the halving schedule and supply
+  function getHalvingInfo() external view returns (HalvingInfo memory info);
+}
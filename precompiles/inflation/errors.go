@@ -0,0 +1,9 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation
+
+const (
+	// ErrHalvingNotConfigured is raised when the halving params of the inflation module are not set.
+	ErrHalvingNotConfigured = "halving is not configured: daily emission, max supply and halving interval must be set"
+)
@@ -0,0 +1,155 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	epochskeeper "github.com/evmos/evmos/v19/x/epochs/keeper"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	inflationkeeper "github.com/evmos/evmos/v19/x/inflation/v1/keeper"
+)
+
+const (
+	// PrecompileAddress defines the inflation precompile address in Hex format
+	PrecompileAddress string = "0x0000000000000000000000000000000000000901"
+
+	// GasHalvingQuery defines the gas cost for a single halving schedule
+	// query, which reads the halving data, the inflation params and the
+	// current day epoch
+	GasHalvingQuery = 2_477
+
+	// GasSupplyQuery defines the gas cost for a single supply query, which
+	// corresponds to an ERC-20 totalSupply call
+	GasSupplyQuery = 2_477
+
+	// GasGetHalvingInfo defines the gas cost for the getHalvingInfo query,
+	// which combines the halving schedule and the supply queries
+	GasGetHalvingInfo = GasHalvingQuery + GasSupplyQuery
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the inflation precompile
+type Precompile struct {
+	cmn.Precompile
+	inflationKeeper inflationkeeper.Keeper
+	epochsKeeper    epochskeeper.Keeper
+}
+
+// NewPrecompile creates a new inflation Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	inflationKeeper inflationkeeper.Keeper,
+	epochsKeeper epochskeeper.Keeper,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: we set an empty gas configuration to avoid extra gas costs
+	// during the run execution
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newABI,
+			KvGasConfig:          storetypes.GasConfig{},
+			TransientKVGasConfig: storetypes.GasConfig{},
+		},
+		inflationKeeper: inflationKeeper,
+		epochsKeeper:    epochsKeeper,
+	}
+	// SetAddress defines the address of the inflation compile contract.
+	p.SetAddress(common.HexToAddress(PrecompileAddress))
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	switch method.Name {
+	case HalvingPeriodMethod, DailyEmissionMethod, NextHalvingEpochMethod:
+		return GasHalvingQuery
+	case MaxSupplyMethod, CirculatingSupplyMethod:
+		return GasSupplyQuery
+	case GetHalvingInfoMethod:
+		return GasGetHalvingInfo
+	}
+
+	return 0
+}
+
+// Run executes the precompiled contract inflation query methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// Halving schedule queries
+	case HalvingPeriodMethod:
+		bz, err = p.HalvingPeriod(ctx, contract, method, args)
+	case DailyEmissionMethod:
+		bz, err = p.DailyEmission(ctx, contract, method, args)
+	case NextHalvingEpochMethod:
+		bz, err = p.NextHalvingEpoch(ctx, contract, method, args)
+	// Supply queries
+	case MaxSupplyMethod:
+		bz, err = p.MaxSupply(ctx, contract, method, args)
+	case CirculatingSupplyMethod:
+		bz, err = p.CirculatingSupply(ctx, contract, method, args)
+	case GetHalvingInfoMethod:
+		bz, err = p.GetHalvingInfo(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// It returns false since all inflation methods are queries.
+func (Precompile) IsTransaction(_ string) bool {
+	return false
+}
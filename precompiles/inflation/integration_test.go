@@ -0,0 +1,186 @@
+package inflation_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/precompiles/inflation"
+	"github.com/evmos/evmos/v19/precompiles/testutil"
+	"github.com/evmos/evmos/v19/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v19/testutil/integration/evmos/grpc"
+	"github.com/evmos/evmos/v19/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v19/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v19/utils"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	inflationtypes "github.com/evmos/evmos/v19/x/inflation/v1/types"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+)
+
+var is *IntegrationTestSuite
+
+// IntegrationTestSuite is the implementation of the TestSuite interface for the
+// inflation precompile integration tests.
+type IntegrationTestSuite struct {
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     keyring.Keyring
+
+	precompile *inflation.Precompile
+}
+
+func (is *IntegrationTestSuite) SetupTest() {
+	keyring := keyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithChainID(utils.TestnetChainID+"-1"),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(integrationNetwork)
+	txFactory := factory.New(integrationNetwork, grpcHandler)
+
+	is.factory = txFactory
+	is.grpcHandler = grpcHandler
+	is.keyring = keyring
+	is.network = integrationNetwork
+
+	is.precompile = is.setupInflationPrecompile()
+}
+
+func TestIntegrationSuite(t *testing.T) {
+	is = new(IntegrationTestSuite)
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Inflation Extension Suite")
+}
+
+var _ = Describe("Inflation Extension -", func() {
+	var (
+		sender         keyring.Key
+		precompileAddr common.Address
+		passCheck      testutil.LogCheckArgs
+	)
+
+	// callPrecompile is a helper function that calls the given precompile
+	// method in a transaction and returns the response.
+	callPrecompile := func(methodName string) *evmtypes.MsgEthereumTxResponse {
+		txArgs := evmtypes.EvmTxArgs{To: &precompileAddr}
+		callArgs := factory.CallArgs{
+			ContractABI: is.precompile.ABI,
+			MethodName:  methodName,
+		}
+
+		_, ethRes, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, callArgs, passCheck)
+		Expect(err).ToNot(HaveOccurred(), "unexpected result calling precompile")
+		return ethRes
+	}
+
+	BeforeEach(func() {
+		is.SetupTest()
+
+		sender = is.keyring.GetKey(0)
+		precompileAddr = is.precompile.Address()
+		passCheck = testutil.LogCheckArgs{}.WithExpPass(true)
+
+		err := is.network.NextBlock()
+		Expect(err).ToNot(HaveOccurred(), "failed to advance block")
+	})
+
+	Context("Direct precompile queries", func() {
+		Context("halvingPeriod query", func() {
+			It("should return the halving period of the current epoch", func() {
+				ethRes := callPrecompile(inflation.HalvingPeriodMethod)
+
+				var period uint64
+				err := is.precompile.UnpackIntoInterface(&period, inflation.HalvingPeriodMethod, ethRes.Ret)
+				Expect(err).ToNot(HaveOccurred(), "failed to unpack halving period")
+				Expect(period).To(Equal(uint64(0)))
+			})
+		})
+
+		Context("dailyEmission query", func() {
+			It("should return the daily emission of the current period", func() {
+				ethRes := callPrecompile(inflation.DailyEmissionMethod)
+
+				out, err := is.precompile.Unpack(inflation.DailyEmissionMethod, ethRes.Ret)
+				Expect(err).ToNot(HaveOccurred(), "failed to unpack daily emission")
+
+				params := is.network.App.InflationKeeper.GetParams(is.network.GetContext())
+				expEmission := inflationtypes.CalculateDailyEmission(params, 0)
+				Expect(out[0].(*big.Int).String()).To(Equal(expEmission.String()))
+			})
+
+			It("should consume the correct amount of gas", func() {
+				ethRes := callPrecompile(inflation.DailyEmissionMethod)
+				Expect(ethRes.GasUsed).To(BeNumerically(">=", inflation.GasHalvingQuery))
+			})
+		})
+
+		Context("nextHalvingEpoch query", func() {
+			It("should return the first epoch of the next period", func() {
+				ethRes := callPrecompile(inflation.NextHalvingEpochMethod)
+
+				out, err := is.precompile.Unpack(inflation.NextHalvingEpochMethod, ethRes.Ret)
+				Expect(err).ToNot(HaveOccurred(), "failed to unpack next halving epoch")
+
+				halvingData := is.network.App.InflationKeeper.GetHalvingData(is.network.GetContext())
+				params := is.network.App.InflationKeeper.GetParams(is.network.GetContext())
+				// #nosec G701 -- the start epoch and the interval are small in tests
+				expEpoch := int64(halvingData.StartEpoch + params.HalvingIntervalEpochs)
+				Expect(out[0].(int64)).To(Equal(expEpoch))
+				Expect(out[1].(int64)).To(BeNumerically(">", 0))
+			})
+		})
+
+		Context("maxSupply query", func() {
+			It("should return the max supply", func() {
+				ethRes := callPrecompile(inflation.MaxSupplyMethod)
+
+				out, err := is.precompile.Unpack(inflation.MaxSupplyMethod, ethRes.Ret)
+				Expect(err).ToNot(HaveOccurred(), "failed to unpack max supply")
+				Expect(out[0].(*big.Int).String()).To(Equal(inflationtypes.DefaultMaxSupply))
+			})
+		})
+
+		Context("circulatingSupply query", func() {
+			It("should return the supply of the mint denom", func() {
+				ethRes := callPrecompile(inflation.CirculatingSupplyMethod)
+
+				out, err := is.precompile.Unpack(inflation.CirculatingSupplyMethod, ethRes.Ret)
+				Expect(err).ToNot(HaveOccurred(), "failed to unpack circulating supply")
+
+				ctx := is.network.GetContext()
+				mintDenom := is.network.App.InflationKeeper.GetParams(ctx).MintDenom
+				supply := is.network.App.BankKeeper.GetSupply(ctx, mintDenom)
+				Expect(out[0].(*big.Int).String()).To(Equal(supply.Amount.String()))
+			})
+		})
+
+		Context("getHalvingInfo query", func() {
+			It("should return the same values as the single queries", func() {
+				ethRes := callPrecompile(inflation.GetHalvingInfoMethod)
+
+				var out struct{ Info inflation.HalvingInfo }
+				err := is.precompile.UnpackIntoInterface(&out, inflation.GetHalvingInfoMethod, ethRes.Ret)
+				Expect(err).ToNot(HaveOccurred(), "failed to unpack halving info")
+
+				params := is.network.App.InflationKeeper.GetParams(is.network.GetContext())
+				Expect(out.Info.CurrentPeriod).To(Equal(uint64(0)))
+				Expect(out.Info.HalvingIntervalEpochs).To(Equal(params.HalvingIntervalEpochs))
+				Expect(out.Info.DailyEmission.String()).To(Equal(params.DailyEmission.String()))
+				Expect(out.Info.MaxSupply.String()).To(Equal(params.MaxSupply.String()))
+				Expect(out.Info.EpochsUntilHalving).To(Equal(out.Info.NextHalvingEpoch - out.Info.CurrentEpoch))
+			})
+
+			It("should consume the correct amount of gas", func() {
+				ethRes := callPrecompile(inflation.GetHalvingInfoMethod)
+				Expect(ethRes.GasUsed).To(BeNumerically(">=", inflation.GasGetHalvingInfo))
+			})
+		})
+	})
+})
@@ -0,0 +1,173 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	epochstypes "github.com/evmos/evmos/v19/x/epochs/types"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	inflationtypes "github.com/evmos/evmos/v19/x/inflation/v1/types"
+)
+
+const (
+	// HalvingPeriodMethod defines the ABI method name for the current halving
+	// period query.
+	HalvingPeriodMethod = "halvingPeriod"
+	// DailyEmissionMethod defines the ABI method name for the daily emission
+	// query.
+	DailyEmissionMethod = "dailyEmission"
+	// NextHalvingEpochMethod defines the ABI method name for the next halving
+	// epoch query.
+	NextHalvingEpochMethod = "nextHalvingEpoch"
+	// MaxSupplyMethod defines the ABI method name for the max supply query.
+	MaxSupplyMethod = "maxSupply"
+	// CirculatingSupplyMethod defines the ABI method name for the circulating
+	// supply query.
+	CirculatingSupplyMethod = "circulatingSupply"
+	// GetHalvingInfoMethod defines the ABI method name for the query that
+	// returns the full halving schedule and supply.
+	GetHalvingInfoMethod = "getHalvingInfo"
+)
+
+// HalvingPeriod returns the halving period used to compute the daily emission
+// minted at the end of the current day epoch.
+func (p Precompile) HalvingPeriod(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	info, err := p.halvingInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(info.CurrentPeriod)
+}
+
+// DailyEmission returns the amount of the mint denom minted at the end of the
+// current day epoch, with the halving of the current period applied.
+func (p Precompile) DailyEmission(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	info, err := p.halvingInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(info.DailyEmission)
+}
+
+// NextHalvingEpoch returns the day epoch at which the next halving period
+// starts, together with the number of epochs left until then.
+func (p Precompile) NextHalvingEpoch(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	info, err := p.halvingInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(info.NextHalvingEpoch, info.EpochsUntilHalving)
+}
+
+// MaxSupply returns the supply cap of the mint denom.
+func (p Precompile) MaxSupply(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	params := p.inflationKeeper.GetParams(ctx)
+	if params.MaxSupply.IsNil() {
+		return nil, errors.New(ErrHalvingNotConfigured)
+	}
+
+	return method.Outputs.Pack(params.MaxSupply.BigInt())
+}
+
+// CirculatingSupply returns the circulating supply of the mint denom as
+// computed by the inflation keeper.
+func (p Precompile) CirculatingSupply(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	params := p.inflationKeeper.GetParams(ctx)
+
+	return method.Outputs.Pack(p.circulatingSupply(ctx, params.MintDenom))
+}
+
+// GetHalvingInfo returns the halving schedule together with the max and
+// circulating supply of the mint denom.
+func (p Precompile) GetHalvingInfo(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	info, err := p.halvingInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(info)
+}
+
+// halvingInfo computes the halving schedule for the current day epoch with the
+// same functions the inflation module uses when minting. The epoch that ends
+// next is the current day epoch, so its period and emission are the ones that
+// apply to the next mint.
+func (p Precompile) halvingInfo(ctx sdk.Context) (HalvingInfo, error) {
+	params := p.inflationKeeper.GetParams(ctx)
+	if params.DailyEmission.IsNil() || params.MaxSupply.IsNil() || params.HalvingIntervalEpochs == 0 {
+		return HalvingInfo{}, errors.New(ErrHalvingNotConfigured)
+	}
+
+	halvingData := p.inflationKeeper.GetHalvingData(ctx)
+
+	// the current epoch is zero before the day epoch has started
+	var currentEpoch int64
+	if epochInfo, found := p.epochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID); found {
+		currentEpoch = epochInfo.CurrentEpoch
+	}
+
+	schedule := inflationtypes.GetHalvingScheduleInfo(currentEpoch, int64(halvingData.StartEpoch), params)
+
+	return HalvingInfo{
+		CurrentEpoch:          currentEpoch,
+		CurrentPeriod:         schedule.CurrentPeriod,
+		StartEpoch:            halvingData.StartEpoch,
+		LastHalvingEpoch:      halvingData.LastHalvingEpoch,
+		HalvingIntervalEpochs: params.HalvingIntervalEpochs,
+		NextHalvingEpoch:      schedule.NextHalvingEpoch,
+		EpochsUntilHalving:    schedule.EpochsUntilHalving,
+		DailyEmission:         schedule.CurrentEmission.BigInt(),
+		MaxSupply:             params.MaxSupply.BigInt(),
+		CirculatingSupply:     p.circulatingSupply(ctx, params.MintDenom),
+	}, nil
+}
+
+// circulatingSupply returns the circulating supply of the given denom truncated
+// to an integer. It returns zero if the supply is below the excluded team
+// allocation, since the result is packed as an unsigned integer.
+func (p Precompile) circulatingSupply(ctx sdk.Context, denom string) *big.Int {
+	circulatingSupply := p.inflationKeeper.GetCirculatingSupply(ctx, denom).TruncateInt()
+	if circulatingSupply.IsNegative() {
+		return big.NewInt(0)
+	}
+
+	return circulatingSupply.BigInt()
+}
@@ -0,0 +1,306 @@
+package inflation_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/evmos/evmos/v19/precompiles/inflation"
+	inflationtypes "github.com/evmos/evmos/v19/x/inflation/v1/types"
+)
+
+// dailyEmission is the default daily emission of the inflation module.
+var dailyEmission, _ = new(big.Int).SetString(inflationtypes.DefaultDailyEmission, 10)
+
+func (s *PrecompileTestSuite) TestHalvingPeriod() {
+	method := s.precompile.Methods[inflation.HalvingPeriodMethod]
+
+	testcases := []struct {
+		name      string
+		malleate  func()
+		expPeriod uint64
+	}{
+		{
+			"pass - first epoch of the first period",
+			func() {
+				s.setCurrentDayEpoch(1)
+			},
+			0,
+		},
+		{
+			"pass - last epoch of the first period",
+			func() {
+				s.setCurrentDayEpoch(int64(inflationtypes.DefaultHalvingIntervalEpochs))
+			},
+			0,
+		},
+		{
+			"pass - first epoch of the second period",
+			func() {
+				s.setCurrentDayEpoch(int64(inflationtypes.DefaultHalvingIntervalEpochs) + 1)
+			},
+			1,
+		},
+		{
+			"pass - later start epoch",
+			func() {
+				s.setHalvingData(inflationtypes.HalvingData{StartEpoch: 100})
+				s.setCurrentDayEpoch(int64(inflationtypes.DefaultHalvingIntervalEpochs) + 1)
+			},
+			0,
+		},
+		{
+			"pass - custom halving interval",
+			func() {
+				s.updateInflationParams(func(params *inflationtypes.Params) {
+					params.HalvingIntervalEpochs = 10
+				})
+				s.setCurrentDayEpoch(35)
+			},
+			3,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			bz, err := s.precompile.HalvingPeriod(
+				s.network.GetContext(),
+				nil,
+				&method,
+				nil,
+			)
+
+			s.Require().NoError(err)
+			var period uint64
+			err = s.precompile.UnpackIntoInterface(&period, method.Name, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expPeriod, period)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDailyEmission() {
+	method := s.precompile.Methods[inflation.DailyEmissionMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func()
+		expEmission *big.Int
+	}{
+		{
+			"pass - full emission in the first period",
+			func() {
+				s.setCurrentDayEpoch(1)
+			},
+			dailyEmission,
+		},
+		{
+			"pass - half emission in the second period",
+			func() {
+				s.setCurrentDayEpoch(int64(inflationtypes.DefaultHalvingIntervalEpochs) + 1)
+			},
+			new(big.Int).Div(dailyEmission, big.NewInt(2)),
+		},
+		{
+			"pass - quarter emission in the third period",
+			func() {
+				s.setCurrentDayEpoch(2*int64(inflationtypes.DefaultHalvingIntervalEpochs) + 1)
+			},
+			new(big.Int).Div(dailyEmission, big.NewInt(4)),
+		},
+		{
+			"pass - no emission after the max halving period",
+			func() {
+				s.updateInflationParams(func(params *inflationtypes.Params) {
+					params.HalvingIntervalEpochs = 1
+				})
+				s.setCurrentDayEpoch(inflationtypes.MaxHalvingPeriod + 1)
+			},
+			big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			bz, err := s.precompile.DailyEmission(
+				s.network.GetContext(),
+				nil,
+				&method,
+				nil,
+			)
+
+			s.Require().NoError(err)
+			out, err := s.precompile.Unpack(method.Name, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expEmission.String(), out[0].(*big.Int).String())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestNextHalvingEpoch() {
+	method := s.precompile.Methods[inflation.NextHalvingEpochMethod]
+	interval := int64(inflationtypes.DefaultHalvingIntervalEpochs)
+
+	testcases := []struct {
+		name          string
+		malleate      func()
+		expEpoch      int64
+		expEpochsLeft int64
+	}{
+		{
+			"pass - first epoch of the first period",
+			func() {
+				s.setCurrentDayEpoch(1)
+			},
+			interval + 1,
+			interval,
+		},
+		{
+			"pass - last epoch of the first period",
+			func() {
+				s.setCurrentDayEpoch(interval)
+			},
+			interval + 1,
+			1,
+		},
+		{
+			"pass - first epoch of the second period",
+			func() {
+				s.setCurrentDayEpoch(interval + 1)
+			},
+			2*interval + 1,
+			interval,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			bz, err := s.precompile.NextHalvingEpoch(
+				s.network.GetContext(),
+				nil,
+				&method,
+				nil,
+			)
+
+			s.Require().NoError(err)
+			out, err := s.precompile.Unpack(method.Name, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expEpoch, out[0].(int64))
+			s.Require().Equal(tc.expEpochsLeft, out[1].(int64))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMaxSupply() {
+	method := s.precompile.Methods[inflation.MaxSupplyMethod]
+	maxSupply, _ := new(big.Int).SetString(inflationtypes.DefaultMaxSupply, 10)
+
+	testcases := []struct {
+		name         string
+		malleate     func()
+		expMaxSupply *big.Int
+	}{
+		{
+			"pass - default max supply",
+			func() {},
+			maxSupply,
+		},
+		{
+			"pass - updated max supply",
+			func() {
+				s.updateInflationParams(func(params *inflationtypes.Params) {
+					params.MaxSupply = math.NewInt(1e18).MulRaw(1_000_000)
+				})
+			},
+			new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1_000_000)),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			bz, err := s.precompile.MaxSupply(
+				s.network.GetContext(),
+				nil,
+				&method,
+				nil,
+			)
+
+			s.Require().NoError(err)
+			out, err := s.precompile.Unpack(method.Name, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expMaxSupply.String(), out[0].(*big.Int).String())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCirculatingSupply() {
+	method := s.precompile.Methods[inflation.CirculatingSupplyMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	mintDenom := s.network.App.InflationKeeper.GetParams(ctx).MintDenom
+	supply := s.network.App.BankKeeper.GetSupply(ctx, mintDenom)
+	s.Require().True(supply.Amount.IsPositive(), "expected a positive supply")
+
+	bz, err := s.precompile.CirculatingSupply(ctx, nil, &method, nil)
+	s.Require().NoError(err)
+
+	out, err := s.precompile.Unpack(method.Name, bz)
+	s.Require().NoError(err)
+	s.Require().Equal(supply.Amount.String(), out[0].(*big.Int).String())
+}
+
+func (s *PrecompileTestSuite) TestGetHalvingInfo() {
+	method := s.precompile.Methods[inflation.GetHalvingInfoMethod]
+	interval := inflationtypes.DefaultHalvingIntervalEpochs
+
+	s.SetupTest()
+	s.setHalvingData(inflationtypes.HalvingData{
+		CurrentPeriod:    1,
+		LastHalvingEpoch: interval,
+		StartEpoch:       1,
+	})
+	s.setCurrentDayEpoch(int64(interval) + 10)
+
+	ctx := s.network.GetContext()
+	params := s.network.App.InflationKeeper.GetParams(ctx)
+	supply := s.network.App.BankKeeper.GetSupply(ctx, params.MintDenom)
+
+	bz, err := s.precompile.GetHalvingInfo(ctx, nil, &method, nil)
+	s.Require().NoError(err)
+
+	var out struct{ Info inflation.HalvingInfo }
+	err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+	s.Require().NoError(err)
+
+	info := out.Info
+	s.Require().Equal(int64(interval)+10, info.CurrentEpoch)
+	s.Require().Equal(uint64(1), info.CurrentPeriod)
+	s.Require().Equal(uint64(1), info.StartEpoch)
+	s.Require().Equal(interval, info.LastHalvingEpoch)
+	s.Require().Equal(interval, info.HalvingIntervalEpochs)
+	s.Require().Equal(2*int64(interval)+1, info.NextHalvingEpoch)
+	s.Require().Equal(int64(interval)-9, info.EpochsUntilHalving)
+	s.Require().Equal(new(big.Int).Div(dailyEmission, big.NewInt(2)).String(), info.DailyEmission.String())
+	s.Require().Equal(params.MaxSupply.String(), info.MaxSupply.String())
+	s.Require().Equal(supply.Amount.String(), info.CirculatingSupply.String())
+}
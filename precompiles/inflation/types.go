@@ -0,0 +1,23 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"math/big"
+)
+
+// HalvingInfo contains the halving schedule and the supply of the mint denom
+// as used by the inflation module to mint the daily emission.
+type HalvingInfo struct {
+	CurrentEpoch          int64
+	CurrentPeriod         uint64
+	StartEpoch            uint64
+	LastHalvingEpoch      uint64
+	HalvingIntervalEpochs uint64
+	NextHalvingEpoch      int64
+	EpochsUntilHalving    int64
+	DailyEmission         *big.Int
+	MaxSupply             *big.Int
+	CirculatingSupply     *big.Int
+}
@@ -0,0 +1,59 @@
+package inflation_test
+
+import (
+	"github.com/evmos/evmos/v19/precompiles/inflation"
+	epochstypes "github.com/evmos/evmos/v19/x/epochs/types"
+	inflationtypes "github.com/evmos/evmos/v19/x/inflation/v1/types"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+)
+
+// setupInflationPrecompile is a helper function to set up an instance of the
+// inflation precompile.
+func (s *PrecompileTestSuite) setupInflationPrecompile() *inflation.Precompile {
+	precompile, err := inflation.NewPrecompile(
+		s.network.App.InflationKeeper,
+		s.network.App.EpochsKeeper,
+	)
+	s.Require().NoError(err, "failed to create inflation precompile")
+
+	return precompile
+}
+
+// setupInflationPrecompile is a helper function to set up an instance of the
+// inflation precompile.
+func (is *IntegrationTestSuite) setupInflationPrecompile() *inflation.Precompile {
+	precompile, err := inflation.NewPrecompile(
+		is.network.App.InflationKeeper,
+		is.network.App.EpochsKeeper,
+	)
+	Expect(err).ToNot(HaveOccurred(), "failed to create inflation precompile")
+	return precompile
+}
+
+// setCurrentDayEpoch is a helper function to set the current number of the
+// day epoch.
+func (s *PrecompileTestSuite) setCurrentDayEpoch(epoch int64) {
+	ctx := s.network.GetContext()
+	epochInfo, found := s.network.App.EpochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID)
+	s.Require().True(found, "day epoch not found")
+
+	epochInfo.CurrentEpoch = epoch
+	s.network.App.EpochsKeeper.SetEpochInfo(ctx, epochInfo)
+}
+
+// setHalvingData is a helper function to set the halving data of the
+// inflation module.
+func (s *PrecompileTestSuite) setHalvingData(halvingData inflationtypes.HalvingData) {
+	s.network.App.InflationKeeper.SetHalvingData(s.network.GetContext(), halvingData)
+}
+
+// updateInflationParams is a helper function to update the inflation params.
+func (s *PrecompileTestSuite) updateInflationParams(malleate func(*inflationtypes.Params)) {
+	ctx := s.network.GetContext()
+	params := s.network.App.InflationKeeper.GetParams(ctx)
+	malleate(&params)
+	err := s.network.App.InflationKeeper.SetParams(ctx, params)
+	s.Require().NoError(err, "failed to set inflation params")
+}
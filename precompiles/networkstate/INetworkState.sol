@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The INetworkState contract's address.
+address constant INETWORK_STATE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000900;
+
+/// @dev The INetworkState contract's instance.
+INetworkState constant INETWORK_STATE_CONTRACT = INetworkState(INETWORK_STATE_PRECOMPILE_ADDRESS);
+
+/// @dev ChainStatus specifies whether the chain is open for transactions.
+struct ChainStatus {
+  /// checkEnabled is false when the chain status check is disabled or the
+  /// OnlineServerCount contract is not set, in which case the chain is open.
+  bool checkEnabled;
+  /// isOpen is true when the online server count reaches the threshold.
+  bool isOpen;
+  /// onlineServerCount is the count reported by the OnlineServerCount contract.
+  uint256 onlineServerCount;
+  /// threshold is the online server count required for the chain to be open.
+  uint256 threshold;
+}
+
+/// @dev WalletLock specifies the lock state of a wallet.
+struct WalletLock {
+  /// checkEnabled is false when the wallet lock check is disabled or the
+  /// WalletState contract is not set, in which case the wallet is unlocked.
+  bool checkEnabled;
+  /// status is the lock status: 0 = No_Lock, 1 = Amount_Lock, 2 = Absolute_Lock.
+  uint8 status;
+  /// lockedAmount is the amount locked by an Amount_Lock.
+  uint256 lockedAmount;
+  /// balance is the native balance of the wallet.
+  uint256 balance;
+  /// maxTransferable is the balance that is not locked.
+  uint256 maxTransferable;
+  /// tolerance is the amount by which a transfer can exceed maxTransferable
+  /// under an Amount_Lock and still be accepted.
+  uint256 tolerance;
+}
+
+/// @dev ValidatorRequirements specifies the requirements to create a validator.
+struct ValidatorRequirements {
+  /// minSelfDelegation is the minimum self delegation of the validator.
+  uint256 minSelfDelegation;
+  /// nftCount is the number of NXQNFTs the validator operator must own.
+  uint256 nftCount;
+}
+
+/**
+ * @author Nexqloud Team
+ * @title Network State Interface
+ * @dev Interface for querying the chain status, wallet locks and validator
+ * requirements enforced by the node.
+ */
+interface INetworkState {
+  /// @dev getChainStatus defines a method for retrieving whether the chain is
+  /// open for transactions.
+  /// @return status the chain status
+  function getChainStatus() external view returns (ChainStatus memory status);
+
+  /// @dev getWalletLock defines a method for retrieving the lock state of a wallet.
+  /// @param account the address of the wallet
+  /// @return walletLock the lock state of the wallet
+  function getWalletLock(address account) external view returns (WalletLock memory walletLock);
+
+  /// @dev canTransact defines a method for checking whether the node accepts an
+  /// Ethereum transaction sent by the account with the given value.
+  /// @param account the address of the sender
+  /// @param value the value of the transaction
+  /// @return allowed true if the transaction passes the chain status and wallet lock checks
+  function canTransact(address account, uint256 value) external view returns (bool allowed);
+
+  /// @dev getValidatorRequirements defines a method for retrieving the
+  /// requirements to create a validator.
+  /// @return requirements the validator requirements
+  function getValidatorRequirements() external view returns (ValidatorRequirements memory requirements);
+
+  /// @dev isApprovedValidator defines a method for checking whether an address
+  /// is on the approved validators list.
+  /// @param validator the address of the validator operator
+  /// @return approved true if the validator is approved
+  function isApprovedValidator(address validator) external view returns (bool approved);
+}
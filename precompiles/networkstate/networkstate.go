@@ -0,0 +1,171 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package networkstate
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	stakingkeeper "github.com/evmos/evmos/v19/x/staking/keeper"
+)
+
+const (
+	// PrecompileAddress defines the network state precompile address in Hex format
+	PrecompileAddress string = "0x0000000000000000000000000000000000000900"
+
+	// GasGetChainStatus defines the base gas cost for the getChainStatus query,
+	// which calls the OnlineServerCount contract. The gas used by the contract
+	// call is charged on top of it.
+	GasGetChainStatus = 10_000
+
+	// GasGetWalletLock defines the base gas cost for the getWalletLock query,
+	// which calls the WalletState contract. The gas used by the contract call
+	// is charged on top of it.
+	GasGetWalletLock = 10_000
+
+	// GasCanTransact defines the base gas cost for the canTransact query, which
+	// calls both the OnlineServerCount and WalletState contracts. The gas used by
+	// the contract calls is charged on top of it.
+	GasCanTransact = 20_000
+
+	// GasGetValidatorRequirements defines the gas cost for the
+	// getValidatorRequirements query, which calls the ValidatorApproval contract
+	GasGetValidatorRequirements = 10_000
+
+	// GasIsApprovedValidator defines the gas cost for the isApprovedValidator
+	// query, which calls the ValidatorApproval contract
+	GasIsApprovedValidator = 10_000
+
+	// ContractCallGasCap defines the gas cap of the calls to the network state
+	// contracts. The contracts only expose simple getters, so the cap is kept
+	// low as the gas used by the calls is charged to the caller.
+	ContractCallGasCap = uint64(100_000)
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the network state precompile
+type Precompile struct {
+	cmn.Precompile
+	evmKeeper     EVMKeeper
+	stakingKeeper stakingkeeper.Keeper
+}
+
+// NewPrecompile creates a new network state Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	evmKeeper EVMKeeper,
+	stakingKeeper stakingkeeper.Keeper,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: we set an empty gas configuration to avoid extra gas costs
+	// during the run execution
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newABI,
+			KvGasConfig:          storetypes.GasConfig{},
+			TransientKVGasConfig: storetypes.GasConfig{},
+		},
+		evmKeeper:     evmKeeper,
+		stakingKeeper: stakingKeeper,
+	}
+	// SetAddress defines the address of the network state compile contract.
+	p.SetAddress(common.HexToAddress(PrecompileAddress))
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	switch method.Name {
+	case GetChainStatusMethod:
+		return GasGetChainStatus
+	case GetWalletLockMethod:
+		return GasGetWalletLock
+	case CanTransactMethod:
+		return GasCanTransact
+	case GetValidatorRequirementsMethod:
+		return GasGetValidatorRequirements
+	case IsApprovedValidatorMethod:
+		return GasIsApprovedValidator
+	}
+
+	return 0
+}
+
+// Run executes the precompiled contract network state query methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// Network state queries
+	case GetChainStatusMethod:
+		bz, err = p.GetChainStatus(ctx, contract, method, args)
+	case GetWalletLockMethod:
+		bz, err = p.GetWalletLock(ctx, contract, method, args)
+	case CanTransactMethod:
+		bz, err = p.CanTransact(ctx, contract, method, args)
+	// Validator queries
+	case GetValidatorRequirementsMethod:
+		bz, err = p.GetValidatorRequirements(ctx, contract, method, args)
+	case IsApprovedValidatorMethod:
+		bz, err = p.IsApprovedValidator(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// It returns false since all network state methods are queries.
+func (Precompile) IsTransaction(_ string) bool {
+	return false
+}
@@ -0,0 +1,230 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package networkstate
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+const (
+	// GetChainStatusMethod defines the ABI method name for the chain status
+	// query.
+	GetChainStatusMethod = "getChainStatus"
+	// GetWalletLockMethod defines the ABI method name for the wallet lock
+	// query.
+	GetWalletLockMethod = "getWalletLock"
+	// CanTransactMethod defines the ABI method name for the query that checks
+	// if an account can send a transaction with a given value.
+	CanTransactMethod = "canTransact"
+	// GetValidatorRequirementsMethod defines the ABI method name for the
+	// validator requirements query.
+	GetValidatorRequirementsMethod = "getValidatorRequirements"
+	// IsApprovedValidatorMethod defines the ABI method name for the validator
+	// approval query.
+	IsApprovedValidatorMethod = "isApprovedValidator"
+)
+
+// GetChainStatus returns whether the chain is open for transactions, using the
+// same online server threshold as the EVM keeper.
+func (p Precompile) GetChainStatus(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	status, err := p.chainStatus(ctx, contract.CallerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(status)
+}
+
+// GetWalletLock returns the lock state of the given account, together with the
+// maximum amount it can transfer as interpreted by the EVM keeper.
+func (p Precompile) GetWalletLock(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	account, err := ParseAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	walletLock, checkEnabled, err := p.walletLock(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(WalletLock{
+		CheckEnabled:    checkEnabled,
+		Status:          uint8(walletLock.Status),
+		LockedAmount:    walletLock.LockedAmount,
+		Balance:         walletLock.Balance,
+		MaxTransferable: walletLock.MaxTransferable(),
+		Tolerance:       evmtypes.AmountLockTolerance,
+	})
+}
+
+// CanTransact returns true if the EVM keeper would accept an Ethereum
+// transaction with the given value sent by the account. Errors raised while
+// reading the network state contracts result in a rejection, as they do for
+// transactions.
+func (p Precompile) CanTransact(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	account, value, err := ParseCanTransactArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	params := p.evmKeeper.GetParams(ctx)
+	if params.IsWhitelistedAddress(account) || params.IsBootstrapMode() {
+		return method.Outputs.Pack(true)
+	}
+
+	status, err := p.chainStatus(ctx, account)
+	if err != nil || !status.IsOpen {
+		return method.Outputs.Pack(false)
+	}
+
+	walletLock, _, err := p.walletLock(ctx, account)
+	if err != nil {
+		return method.Outputs.Pack(false)
+	}
+
+	return method.Outputs.Pack(walletLock.ValidateTransfer(value) == nil)
+}
+
+// GetValidatorRequirements returns the minimum self delegation and the number of
+// NFTs required to create a validator, falling back to the same defaults as the
+// staking keeper.
+func (p Precompile) GetValidatorRequirements(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	minSelfDelegation, nftCount := p.stakingKeeper.ValidatorRequirements(ctx)
+
+	return method.Outputs.Pack(ValidatorRequirements{
+		MinSelfDelegation: minSelfDelegation,
+		NftCount:          nftCount,
+	})
+}
+
+// IsApprovedValidator returns true if the given address is on the approved
+// validators list of the ValidatorApproval contract.
+func (p Precompile) IsApprovedValidator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	validator, err := ParseAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	isApproved, err := p.stakingKeeper.IsApprovedValidator(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(isApproved)
+}
+
+// chainStatus returns the chain status. The chain is always open when the
+// chain status check is disabled or the contract is not set.
+func (p Precompile) chainStatus(ctx sdk.Context, from common.Address) (ChainStatus, error) {
+	params := p.evmKeeper.GetParams(ctx)
+
+	status := ChainStatus{
+		IsOpen:            true,
+		OnlineServerCount: big.NewInt(0),
+		Threshold:         big.NewInt(evmtypes.OnlineServerThreshold),
+	}
+
+	if !params.EnableChainStatusCheck || !evmtypes.IsContractSet(params.OnlineServerCountContract) {
+		return status, nil
+	}
+
+	res, err := p.evmKeeper.CallOnlineServerCount(ctx, from, contractCallGasCap(ctx))
+	if err != nil {
+		return ChainStatus{}, err
+	}
+	if err := chargeContractCall(ctx, res); err != nil {
+		return ChainStatus{}, err
+	}
+	count := new(big.Int).SetBytes(res.Ret)
+
+	status.CheckEnabled = true
+	status.OnlineServerCount = count
+	status.IsOpen = evmtypes.IsChainOpenForServerCount(count)
+	return status, nil
+}
+
+// walletLock returns the wallet lock of the account and whether the wallet lock
+// check is enabled. The wallet is considered unlocked when the wallet lock check
+// is disabled or the contract is not set.
+func (p Precompile) walletLock(ctx sdk.Context, account common.Address) (evmtypes.WalletLock, bool, error) {
+	params := p.evmKeeper.GetParams(ctx)
+
+	if !params.EnableWalletLockCheck || !evmtypes.IsContractSet(params.WalletStateContractAddress) {
+		return evmtypes.WalletLock{
+			Status:       evmtypes.WalletLockNone,
+			LockedAmount: big.NewInt(0),
+			Balance:      p.evmKeeper.GetBalance(ctx, account),
+		}, false, nil
+	}
+
+	res, err := p.evmKeeper.CallWalletLock(ctx, account, contractCallGasCap(ctx))
+	if err != nil {
+		return evmtypes.WalletLock{}, false, err
+	}
+	if err := chargeContractCall(ctx, res); err != nil {
+		return evmtypes.WalletLock{}, false, err
+	}
+
+	status, lockedAmount, err := evmtypes.UnpackWalletLock(res.Ret)
+	if err != nil {
+		return evmtypes.WalletLock{}, false, err
+	}
+
+	return evmtypes.WalletLock{
+		Status:       status,
+		LockedAmount: lockedAmount,
+		Balance:      p.evmKeeper.GetBalance(ctx, account),
+	}, true, nil
+}
+
+// contractCallGasCap returns the gas cap of a call to the network state
+// contracts, bounded by the gas left to the precompile.
+func contractCallGasCap(ctx sdk.Context) uint64 {
+	return min(ContractCallGasCap, ctx.GasMeter().GasRemaining())
+}
+
+// chargeContractCall consumes the gas used by a call to the network state
+// contracts, so that the nested execution is paid by the caller of the
+// precompile, and returns an error if the call failed.
+func chargeContractCall(ctx sdk.Context, res *evmtypes.MsgEthereumTxResponse) error {
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "network state contract call")
+
+	if res.Failed() {
+		return errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+	return nil
+}
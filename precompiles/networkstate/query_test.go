@@ -0,0 +1,541 @@
+package networkstate_test
+
+import (
+	"math/big"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v19/precompiles/networkstate"
+	evmosutiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	stakingtypes "github.com/evmos/evmos/v19/x/staking/types"
+)
+
+func (s *PrecompileTestSuite) TestGetChainStatus() {
+	method := s.precompile.Methods[networkstate.GetChainStatusMethod]
+	threshold := big.NewInt(evmtypes.OnlineServerThreshold)
+
+	testcases := []struct {
+		name      string
+		malleate  func()
+		expStatus networkstate.ChainStatus
+	}{
+		{
+			"pass - chain status check disabled",
+			func() {},
+			networkstate.ChainStatus{
+				CheckEnabled:      false,
+				IsOpen:            true,
+				OnlineServerCount: big.NewInt(0),
+				Threshold:         threshold,
+			},
+		},
+		{
+			"pass - online server count below threshold",
+			func() {
+				contract := s.deployStaticContract(big.NewInt(999))
+				s.updateEvmParams(func(params *evmtypes.Params) {
+					params.EnableChainStatusCheck = true
+					params.OnlineServerCountContract = contract.Hex()
+				})
+			},
+			networkstate.ChainStatus{
+				CheckEnabled:      true,
+				IsOpen:            false,
+				OnlineServerCount: big.NewInt(999),
+				Threshold:         threshold,
+			},
+		},
+		{
+			"pass - online server count at threshold",
+			func() {
+				contract := s.deployStaticContract(big.NewInt(1000))
+				s.updateEvmParams(func(params *evmtypes.Params) {
+					params.EnableChainStatusCheck = true
+					params.OnlineServerCountContract = contract.Hex()
+				})
+			},
+			networkstate.ChainStatus{
+				CheckEnabled:      true,
+				IsOpen:            true,
+				OnlineServerCount: big.NewInt(1000),
+				Threshold:         threshold,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 100_000)
+			bz, err := s.precompile.GetChainStatus(
+				s.network.GetContext(),
+				contract,
+				&method,
+				nil,
+			)
+
+			s.Require().NoError(err)
+			var out struct{ Status networkstate.ChainStatus }
+			err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+			s.Require().NoError(err)
+			s.requireEqualOutput(tc.expStatus, out.Status)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetChainStatusGas() {
+	method := s.precompile.Methods[networkstate.GetChainStatusMethod]
+
+	testcases := []struct {
+		name     string
+		gasLimit uint64
+		expPass  bool
+	}{
+		{"pass - contract call charged", 1_000_000, true},
+		{"fail - not enough gas for the contract call", 1_000, false},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contract := s.deployStaticContract(big.NewInt(1000))
+			s.updateEvmParams(func(params *evmtypes.Params) {
+				params.EnableChainStatusCheck = true
+				params.OnlineServerCountContract = contract.Hex()
+			})
+
+			// only the nested contract call is charged, as in the precompile execution
+			ctx := s.network.GetContext().
+				WithGasMeter(storetypes.NewGasMeter(tc.gasLimit)).
+				WithKVGasConfig(storetypes.GasConfig{}).
+				WithTransientKVGasConfig(storetypes.GasConfig{})
+			_, err := s.precompile.GetChainStatus(
+				ctx,
+				vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gasLimit),
+				&method,
+				nil,
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				// the gas used by the nested call includes at least the intrinsic gas
+				s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), params.TxGas)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetWalletLock() {
+	method := s.precompile.Methods[networkstate.GetWalletLockMethod]
+	lockedAmount := big.NewInt(1e18)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expLock     func(balance *big.Int) networkstate.WalletLock
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"fail - invalid account address",
+			func() []interface{} {
+				return []interface{}{"random text"}
+			},
+			false,
+			"invalid type for account",
+			nil,
+		},
+		{
+			"pass - wallet lock check disabled",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			true,
+			"",
+			func(balance *big.Int) networkstate.WalletLock {
+				return networkstate.WalletLock{
+					CheckEnabled:    false,
+					Status:          uint8(evmtypes.WalletLockNone),
+					LockedAmount:    big.NewInt(0),
+					Balance:         balance,
+					MaxTransferable: balance,
+					Tolerance:       evmtypes.AmountLockTolerance,
+				}
+			},
+		},
+		{
+			"pass - amount lock",
+			func() []interface{} {
+				contract := s.deployStaticContract(big.NewInt(int64(evmtypes.WalletLockAmount)), lockedAmount, big.NewInt(0))
+				s.updateEvmParams(func(params *evmtypes.Params) {
+					params.EnableWalletLockCheck = true
+					params.WalletStateContractAddress = contract.Hex()
+				})
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			true,
+			"",
+			func(balance *big.Int) networkstate.WalletLock {
+				return networkstate.WalletLock{
+					CheckEnabled:    true,
+					Status:          uint8(evmtypes.WalletLockAmount),
+					LockedAmount:    lockedAmount,
+					Balance:         balance,
+					MaxTransferable: new(big.Int).Sub(balance, lockedAmount),
+					Tolerance:       evmtypes.AmountLockTolerance,
+				}
+			},
+		},
+		{
+			"pass - absolute lock",
+			func() []interface{} {
+				contract := s.deployStaticContract(big.NewInt(int64(evmtypes.WalletLockAbsolute)), big.NewInt(0), big.NewInt(0))
+				s.updateEvmParams(func(params *evmtypes.Params) {
+					params.EnableWalletLockCheck = true
+					params.WalletStateContractAddress = contract.Hex()
+				})
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			true,
+			"",
+			func(balance *big.Int) networkstate.WalletLock {
+				return networkstate.WalletLock{
+					CheckEnabled:    true,
+					Status:          uint8(evmtypes.WalletLockAbsolute),
+					LockedAmount:    big.NewInt(0),
+					Balance:         balance,
+					MaxTransferable: big.NewInt(0),
+					Tolerance:       evmtypes.AmountLockTolerance,
+				}
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.GetWalletLock(
+				s.network.GetContext(),
+				nil,
+				&method,
+				tc.malleate(),
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var out struct{ WalletLock networkstate.WalletLock }
+				err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+				s.Require().NoError(err)
+
+				balance := s.network.App.EvmKeeper.GetBalance(s.network.GetContext(), s.keyring.GetAddr(0))
+				s.requireEqualOutput(tc.expLock(balance), out.WalletLock)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCanTransact() {
+	method := s.precompile.Methods[networkstate.CanTransactMethod]
+	lockedAmount := big.NewInt(1e18)
+
+	// enableChecks enables both checks with the given online server count and
+	// wallet lock status
+	enableChecks := func(serverCount int64, lockStatus evmtypes.WalletLockStatus) {
+		chainContract := s.deployStaticContract(big.NewInt(serverCount))
+		walletContract := s.deployStaticContract(big.NewInt(int64(lockStatus)), lockedAmount, big.NewInt(0))
+		s.updateEvmParams(func(params *evmtypes.Params) {
+			params.EnableChainStatusCheck = true
+			params.OnlineServerCountContract = chainContract.Hex()
+			params.EnableWalletLockCheck = true
+			params.WalletStateContractAddress = walletContract.Hex()
+		})
+	}
+
+	// maxTransferable returns the unlocked balance of the first account under
+	// an amount lock
+	maxTransferable := func() *big.Int {
+		balance := s.network.App.EvmKeeper.GetBalance(s.network.GetContext(), s.keyring.GetAddr(0))
+		return new(big.Int).Sub(balance, lockedAmount)
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expAllowed  bool
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			false,
+			"invalid number of arguments",
+			false,
+		},
+		{
+			"fail - invalid value",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), "1"}
+			},
+			false,
+			"invalid amount",
+			false,
+		},
+		{
+			"pass - bootstrap mode",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), big.NewInt(1)}
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"pass - chain closed",
+			func() []interface{} {
+				enableChecks(999, evmtypes.WalletLockNone)
+				return []interface{}{s.keyring.GetAddr(0), big.NewInt(1)}
+			},
+			true,
+			"",
+			false,
+		},
+		{
+			"pass - chain closed but address whitelisted",
+			func() []interface{} {
+				enableChecks(999, evmtypes.WalletLockAbsolute)
+				s.updateEvmParams(func(params *evmtypes.Params) {
+					params.WhitelistedAddresses = []string{s.keyring.GetAddr(0).Hex()}
+				})
+				return []interface{}{s.keyring.GetAddr(0), big.NewInt(1)}
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"pass - chain open and wallet unlocked",
+			func() []interface{} {
+				enableChecks(1000, evmtypes.WalletLockNone)
+				return []interface{}{s.keyring.GetAddr(0), big.NewInt(1)}
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"pass - amount lock within tolerance",
+			func() []interface{} {
+				enableChecks(1000, evmtypes.WalletLockAmount)
+				value := new(big.Int).Add(maxTransferable(), evmtypes.AmountLockTolerance)
+				return []interface{}{s.keyring.GetAddr(0), value}
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"pass - amount lock above tolerance",
+			func() []interface{} {
+				enableChecks(1000, evmtypes.WalletLockAmount)
+				value := new(big.Int).Add(maxTransferable(), evmtypes.AmountLockTolerance)
+				value.Add(value, big.NewInt(1))
+				return []interface{}{s.keyring.GetAddr(0), value}
+			},
+			true,
+			"",
+			false,
+		},
+		{
+			"pass - absolute lock",
+			func() []interface{} {
+				enableChecks(1000, evmtypes.WalletLockAbsolute)
+				return []interface{}{s.keyring.GetAddr(0), big.NewInt(0)}
+			},
+			true,
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.CanTransact(
+				s.network.GetContext(),
+				nil,
+				&method,
+				tc.malleate(),
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var allowed bool
+				err = s.precompile.UnpackIntoInterface(&allowed, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expAllowed, allowed)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetValidatorRequirements() {
+	method := s.precompile.Methods[networkstate.GetValidatorRequirementsMethod]
+
+	testcases := []struct {
+		name            string
+		malleate        func()
+		expRequirements networkstate.ValidatorRequirements
+	}{
+		{
+			"pass - default requirements when the contract is not set",
+			func() {},
+			networkstate.ValidatorRequirements{
+				MinSelfDelegation: stakingtypes.DefaultRequiredSelfDelegation,
+				NftCount:          stakingtypes.DefaultRequiredNFTs,
+			},
+		},
+		{
+			"pass - requirements from the contract",
+			func() {
+				contract := s.deployStaticContract(big.NewInt(1e18), big.NewInt(3))
+				s.updateEvmParams(func(params *evmtypes.Params) {
+					params.ValidatorApprovalContractAddress = contract.Hex()
+				})
+			},
+			networkstate.ValidatorRequirements{
+				MinSelfDelegation: big.NewInt(1e18),
+				NftCount:          big.NewInt(3),
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			bz, err := s.precompile.GetValidatorRequirements(
+				s.network.GetContext(),
+				nil,
+				&method,
+				nil,
+			)
+
+			s.Require().NoError(err)
+			var out struct {
+				Requirements networkstate.ValidatorRequirements
+			}
+			err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+			s.Require().NoError(err)
+			s.requireEqualOutput(tc.expRequirements, out.Requirements)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestIsApprovedValidator() {
+	method := s.precompile.Methods[networkstate.IsApprovedValidatorMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expApproved bool
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			"invalid number of arguments",
+			false,
+		},
+		{
+			"pass - approved validator",
+			func() []interface{} {
+				contract := s.deployStaticContract(big.NewInt(1))
+				s.updateEvmParams(func(params *evmtypes.Params) {
+					params.ValidatorApprovalContractAddress = contract.Hex()
+				})
+				return []interface{}{evmosutiltx.GenerateAddress()}
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"pass - validator not approved",
+			func() []interface{} {
+				contract := s.deployStaticContract(big.NewInt(0))
+				s.updateEvmParams(func(params *evmtypes.Params) {
+					params.ValidatorApprovalContractAddress = contract.Hex()
+				})
+				return []interface{}{common.Address{}}
+			},
+			true,
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.IsApprovedValidator(
+				s.network.GetContext(),
+				nil,
+				&method,
+				tc.malleate(),
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var approved bool
+				err = s.precompile.UnpackIntoInterface(&approved, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expApproved, approved)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package networkstate
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// EVMKeeper defines the expected EVM keeper used to read the network state
+// contracts. It is an interface to avoid an import cycle with the EVM keeper,
+// which registers this precompile.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	CallOnlineServerCount(ctx sdk.Context, from common.Address, gasCap uint64) (*evmtypes.MsgEthereumTxResponse, error)
+	CallWalletLock(ctx sdk.Context, account common.Address, gasCap uint64) (*evmtypes.MsgEthereumTxResponse, error)
+	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
+}
+
+// ChainStatus contains whether the chain is open for transactions together
+// with the online server count it is derived from.
+type ChainStatus struct {
+	CheckEnabled      bool
+	IsOpen            bool
+	OnlineServerCount *big.Int
+	Threshold         *big.Int
+}
+
+// WalletLock contains the lock state of a wallet as interpreted by the node.
+type WalletLock struct {
+	CheckEnabled    bool
+	Status          uint8
+	LockedAmount    *big.Int
+	Balance         *big.Int
+	MaxTransferable *big.Int
+	Tolerance       *big.Int
+}
+
+// ValidatorRequirements contains the requirements to become a validator.
+type ValidatorRequirements struct {
+	MinSelfDelegation *big.Int
+	NftCount          *big.Int
+}
+
+// ParseAccountArgs parses the call arguments for the queries that only take an
+// account address.
+func ParseAccountArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "account", common.Address{}, args[0])
+	}
+
+	return account, nil
+}
+
+// ParseCanTransactArgs parses the call arguments for the canTransact query.
+func ParseCanTransactArgs(args []interface{}) (common.Address, *big.Int, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "account", common.Address{}, args[0])
+	}
+
+	value, ok := args[1].(*big.Int)
+	if !ok || value == nil {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidAmount, args[1])
+	}
+
+	return account, value, nil
+}
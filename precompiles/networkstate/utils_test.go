@@ -0,0 +1,68 @@
+package networkstate_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v19/precompiles/networkstate"
+	"github.com/evmos/evmos/v19/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// setupNetworkStatePrecompile is a helper function to set up an instance of the
+// network state precompile.
+func (s *PrecompileTestSuite) setupNetworkStatePrecompile() *networkstate.Precompile {
+	precompile, err := networkstate.NewPrecompile(
+		s.network.App.EvmKeeper,
+		s.network.App.StakingKeeper,
+	)
+	s.Require().NoError(err, "failed to create network state precompile")
+
+	return precompile
+}
+
+// deployStaticContract is a helper function that deploys a contract at a
+// random address which returns the given 32-byte words for any call.
+func (s *PrecompileTestSuite) deployStaticContract(words ...*big.Int) common.Address {
+	// runtime code: PUSH32 word, PUSH1 offset, MSTORE for each word, then
+	// PUSH1 size, PUSH1 0, RETURN
+	code := make([]byte, 0, len(words)*35+5)
+	for i, word := range words {
+		code = append(code, 0x7f)
+		code = append(code, common.LeftPadBytes(word.Bytes(), 32)...)
+		code = append(code, 0x60, byte(i*32), 0x52)
+	}
+	code = append(code, 0x60, byte(len(words)*32), 0x60, 0x00, 0xf3)
+
+	addr := common.BytesToAddress(crypto.Keccak256(code)[12:])
+	codeHash := crypto.Keccak256(code)
+
+	ctx := s.network.GetContext()
+	s.network.App.EvmKeeper.SetCode(ctx, codeHash, code)
+	err := s.network.App.EvmKeeper.SetAccount(ctx, addr, statedb.Account{
+		Nonce:    1,
+		Balance:  big.NewInt(0),
+		CodeHash: codeHash,
+	})
+	s.Require().NoError(err)
+
+	return addr
+}
+
+// updateEvmParams is a helper function to update the EVM params.
+func (s *PrecompileTestSuite) updateEvmParams(malleate func(params *evmtypes.Params)) {
+	params := s.network.App.EvmKeeper.GetParams(s.network.GetContext())
+	malleate(&params)
+	err := s.network.UpdateEvmParams(params)
+	s.Require().NoError(err)
+}
+
+// requireEqualOutput is a helper function that compares an unpacked output
+// struct with the expected one. The structs are compared by their formatted
+// values, since unpacked big integers can differ in their internal
+// representation from the expected ones.
+func (s *PrecompileTestSuite) requireEqualOutput(expected, actual interface{}) {
+	s.Require().Equal(fmt.Sprintf("%+v", expected), fmt.Sprintf("%+v", actual))
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The ISlashing contract's address.
+address constant SLASHING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+/// @dev The ISlashing contract's instance.
+ISlashing constant SLASHING_CONTRACT = ISlashing(SLASHING_PRECOMPILE_ADDRESS);
+
+/// @dev Define all the available slashing methods.
+string constant MSG_UNJAIL = "/cosmos.slashing.v1beta1.MsgUnjail";
+
+// SigningInfo defines the signing info of a validator used to track its downtime.
+struct SigningInfo {
+    // validatorAddress is the consensus address of the validator.
+    address validatorAddress;
+    // startHeight is the height at which the validator was first a candidate or was unjailed.
+    int64 startHeight;
+    // indexOffset is the index used to track the missed blocks in the signed blocks window.
+    int64 indexOffset;
+    // jailedUntil is the unix time in seconds until which the validator is jailed.
+    int64 jailedUntil;
+    // tombstoned is true if the validator was permanently jailed for double signing.
+    bool tombstoned;
+    // missedBlocksCounter is the number of blocks missed in the signed blocks window.
+    int64 missedBlocksCounter;
+}
+
+// Params defines the parameters of the slashing module.
+struct Params {
+    int64 signedBlocksWindow;
+    Dec minSignedPerWindow;
+    // downtimeJailDuration is expressed in seconds.
+    int64 downtimeJailDuration;
+    Dec slashFractionDoubleSign;
+    Dec slashFractionDowntime;
+}
+
+/// @author Nexqloud Team
+/// @title Slashing Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with slashing.
+/// @custom:address 0x0000000000000000000000000000000000000806
+interface ISlashing {
+    /// @dev Defines an event that is emitted when a validator is unjailed.
+    /// @param validator The address of the validator operator.
+    event Unjail(
+        address indexed validator
+    );
+
+    /// @dev Defines a method for unjailing a validator once its jail period is over.
+    /// @param validatorAddress The address of the validator operator.
+    /// @return success Whether the validator was unjailed.
+    function unjail(
+        address validatorAddress
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev Defines a query for getting the signing info of a validator.
+    /// @param consAddress The consensus address of the validator.
+    function getSigningInfo(
+        address consAddress
+    ) external view returns (SigningInfo memory signingInfo);
+
+    /// @dev Defines a query for getting the signing info of all validators.
+    /// @param pageRequest The pagination of the query.
+    function getSigningInfos(
+        PageRequest calldata pageRequest
+    ) external view returns (SigningInfo[] memory signingInfos, PageResponse memory pageResponse);
+
+    /// @dev Defines a query for getting the slashing module parameters.
+    function params() external view returns (Params memory params);
+}
@@ -0,0 +1,10 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package slashing
+
+const (
+	// ErrValidatorDifferentOrigin is raised when the tx origin address is not the same as the validator address.
+	ErrValidatorDifferentOrigin = "tx origin address %s does not match the validator address %s"
+	// ErrInvalidConsAddress is raised when the consensus address is not valid.
+	ErrInvalidConsAddress = "invalid consensus address: %v"
+)
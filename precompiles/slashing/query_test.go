@@ -0,0 +1,99 @@
+package slashing_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/precompiles/slashing"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestGetSigningInfo() {
+	method := s.precompile.Methods[slashing.GetSigningInfoMethod]
+
+	s.Run("fail - signing info not found", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetSigningInfo(s.network.GetContext(), nil, &method, []interface{}{utiltx.GenerateAddress()})
+		s.Require().ErrorContains(err, "SigningInfo not found")
+	})
+
+	s.Run("success", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		consAddr := utiltx.GenerateAddress()
+		jailedUntil := time.Unix(1_700_000_000, 0).UTC()
+		s.network.App.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr.Bytes(), slashingtypes.NewValidatorSigningInfo(
+			consAddr.Bytes(), 10, 3, jailedUntil, true, 5,
+		))
+
+		bz, err := s.precompile.GetSigningInfo(ctx, nil, &method, []interface{}{consAddr})
+		s.Require().NoError(err)
+
+		var out struct{ SigningInfo slashing.SigningInfo }
+		err = s.precompile.UnpackIntoInterface(&out, slashing.GetSigningInfoMethod, bz)
+		s.Require().NoError(err)
+
+		s.Require().Equal(slashing.SigningInfo{
+			ValidatorAddress:    consAddr,
+			StartHeight:         10,
+			IndexOffset:         3,
+			JailedUntil:         jailedUntil.Unix(),
+			Tombstoned:          true,
+			MissedBlocksCounter: 5,
+		}, out.SigningInfo)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetSigningInfos() {
+	method := s.precompile.Methods[slashing.GetSigningInfosMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	consAddrs := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+	for _, consAddr := range consAddrs {
+		s.network.App.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr.Bytes(), slashingtypes.NewValidatorSigningInfo(
+			consAddr.Bytes(), 1, 0, time.Unix(0, 0), false, 0,
+		))
+	}
+	total := 0
+	s.network.App.SlashingKeeper.IterateValidatorSigningInfos(ctx, func(_ sdk.ConsAddress, _ slashingtypes.ValidatorSigningInfo) bool {
+		total++
+		return false
+	})
+
+	bz, err := s.precompile.GetSigningInfos(ctx, nil, &method, []interface{}{query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out slashing.SigningInfosOutput
+	err = s.precompile.UnpackIntoInterface(&out, slashing.GetSigningInfosMethod, bz)
+	s.Require().NoError(err)
+
+	s.Require().Len(out.SigningInfos, 1)
+	s.Require().Equal(uint64(total), out.PageResponse.Total)
+	s.Require().NotEmpty(out.PageResponse.NextKey)
+}
+
+func (s *PrecompileTestSuite) TestParams() {
+	method := s.precompile.Methods[slashing.ParamsMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	params := s.network.App.SlashingKeeper.GetParams(ctx)
+
+	bz, err := s.precompile.Params(ctx, nil, &method, []interface{}{})
+	s.Require().NoError(err)
+
+	var out struct{ Params slashing.Params }
+	err = s.precompile.UnpackIntoInterface(&out, slashing.ParamsMethod, bz)
+	s.Require().NoError(err)
+
+	s.Require().Equal(params.SignedBlocksWindow, out.Params.SignedBlocksWindow)
+	s.Require().Equal(params.MinSignedPerWindow.BigInt().String(), out.Params.MinSignedPerWindow.Value.String())
+	s.Require().Equal(uint8(18), out.Params.MinSignedPerWindow.Precision)
+	s.Require().Equal(int64(params.DowntimeJailDuration.Seconds()), out.Params.DowntimeJailDuration)
+	s.Require().Equal(params.SlashFractionDoubleSign.BigInt().String(), out.Params.SlashFractionDoubleSign.Value.String())
+	s.Require().Equal(params.SlashFractionDowntime.BigInt().String(), out.Params.SlashFractionDowntime.Value.String())
+}
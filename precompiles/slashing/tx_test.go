@@ -0,0 +1,102 @@
+package slashing_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+	"github.com/evmos/evmos/v19/precompiles/slashing"
+	"github.com/evmos/evmos/v19/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestUnjail() {
+	method := s.precompile.Methods[slashing.UnjailMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(validator common.Address) (caller, origin common.Address, args []interface{})
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(validator common.Address) (common.Address, common.Address, []interface{}) {
+				return validator, validator, []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - validator different from origin",
+			func(validator common.Address) (common.Address, common.Address, []interface{}) {
+				origin := utiltx.GenerateAddress()
+				return origin, origin, []interface{}{validator}
+			},
+			true,
+			"does not match the validator address",
+		},
+		{
+			"fail - contract caller is not the validator",
+			func(validator common.Address) (common.Address, common.Address, []interface{}) {
+				return utiltx.GenerateAddress(), utiltx.GenerateAddress(), []interface{}{validator}
+			},
+			true,
+			"does not match the validator address",
+		},
+		{
+			"fail - validator not found",
+			func(common.Address) (common.Address, common.Address, []interface{}) {
+				addr := utiltx.GenerateAddress()
+				return addr, addr, []interface{}{addr}
+			},
+			true,
+			"address is not associated with any known validator",
+		},
+		{
+			"success - validator is the origin",
+			func(validator common.Address) (common.Address, common.Address, []interface{}) {
+				return validator, validator, []interface{}{validator}
+			},
+			false,
+			"",
+		},
+		{
+			"success - validator is the contract calling the precompile",
+			func(validator common.Address) (common.Address, common.Address, []interface{}) {
+				return validator, utiltx.GenerateAddress(), []interface{}{validator}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			validator := s.jailValidator()
+			stateDB := s.network.GetStateDB()
+
+			caller, origin, args := tc.malleate(validator)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile, 200_000)
+
+			bz, err := s.precompile.Unjail(ctx, origin, contract, stateDB, &method, args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			val, found := s.network.App.StakingKeeper.GetValidator(ctx, validator.Bytes())
+			s.Require().True(found)
+			s.Require().False(val.IsJailed())
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[slashing.EventTypeUnjail].ID, logs[0].Topics[0])
+			s.Require().Equal(common.BytesToHash(validator.Bytes()), logs[0].Topics[1])
+		})
+	}
+}
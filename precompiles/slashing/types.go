@@ -0,0 +1,163 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package slashing
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v19/precompiles/common"
+)
+
+// SigningInfo defines the signing info of a validator as returned by the precompile.
+type SigningInfo struct {
+	ValidatorAddress    common.Address
+	StartHeight         int64
+	IndexOffset         int64
+	JailedUntil         int64
+	Tombstoned          bool
+	MissedBlocksCounter int64
+}
+
+// Params defines the slashing module parameters as returned by the precompile.
+// The downtime jail duration is expressed in seconds.
+type Params struct {
+	SignedBlocksWindow      int64
+	MinSignedPerWindow      cmn.Dec
+	DowntimeJailDuration    int64
+	SlashFractionDoubleSign cmn.Dec
+	SlashFractionDowntime   cmn.Dec
+}
+
+// SigningInfosInput is a struct to represent the input information for
+// the signing infos query. Needed to unpack arguments into the PageRequest struct.
+type SigningInfosInput struct {
+	PageRequest query.PageRequest
+}
+
+// SigningInfosOutput is a struct to represent the key information from
+// a signing infos response.
+type SigningInfosOutput struct {
+	SigningInfos []SigningInfo
+	PageResponse query.PageResponse
+}
+
+// NewMsgUnjail creates a new MsgUnjail instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgUnjail(args []interface{}) (*slashingtypes.MsgUnjail, common.Address, error) {
+	if len(args) != 1 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	validatorAddress, ok := args[0].(common.Address)
+	if !ok || validatorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidValidator, args[0])
+	}
+
+	msg := slashingtypes.NewMsgUnjail(validatorAddress.Bytes())
+	return msg, validatorAddress, nil
+}
+
+// NewSigningInfoRequest creates a new QuerySigningInfoRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewSigningInfoRequest(args []interface{}) (*slashingtypes.QuerySigningInfoRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	consAddress, ok := args[0].(common.Address)
+	if !ok || consAddress == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidConsAddress, args[0])
+	}
+
+	return &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: sdk.ConsAddress(consAddress.Bytes()).String(),
+	}, nil
+}
+
+// NewSigningInfosRequest creates a new QuerySigningInfosRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewSigningInfosRequest(method *abi.Method, args []interface{}) (*slashingtypes.QuerySigningInfosRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input SigningInfosInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to SigningInfosInput struct: %s", err)
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &slashingtypes.QuerySigningInfosRequest{
+		Pagination: &input.PageRequest,
+	}, nil
+}
+
+// NewSigningInfo creates a SigningInfo from a ValidatorSigningInfo.
+func NewSigningInfo(info slashingtypes.ValidatorSigningInfo) (SigningInfo, error) {
+	consAddress, err := sdk.ConsAddressFromBech32(info.Address)
+	if err != nil {
+		return SigningInfo{}, err
+	}
+
+	return SigningInfo{
+		ValidatorAddress:    common.BytesToAddress(consAddress.Bytes()),
+		StartHeight:         info.StartHeight,
+		IndexOffset:         info.IndexOffset,
+		JailedUntil:         info.JailedUntil.UTC().Unix(),
+		Tombstoned:          info.Tombstoned,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+	}, nil
+}
+
+// FromResponse populates the SigningInfosOutput from a QuerySigningInfosResponse.
+func (so *SigningInfosOutput) FromResponse(res *slashingtypes.QuerySigningInfosResponse) (*SigningInfosOutput, error) {
+	so.SigningInfos = make([]SigningInfo, len(res.Info))
+	for i, info := range res.Info {
+		signingInfo, err := NewSigningInfo(info)
+		if err != nil {
+			return nil, err
+		}
+		so.SigningInfos[i] = signingInfo
+	}
+
+	if res.Pagination != nil {
+		so.PageResponse.Total = res.Pagination.Total
+		so.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return so, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (so *SigningInfosOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(so.SigningInfos, so.PageResponse)
+}
+
+// NewParams creates a Params from the slashing module parameters.
+func NewParams(params slashingtypes.Params) Params {
+	return Params{
+		SignedBlocksWindow:      params.SignedBlocksWindow,
+		MinSignedPerWindow:      newDec(params.MinSignedPerWindow),
+		DowntimeJailDuration:    int64(params.DowntimeJailDuration.Seconds()),
+		SlashFractionDoubleSign: newDec(params.SlashFractionDoubleSign),
+		SlashFractionDowntime:   newDec(params.SlashFractionDowntime),
+	}
+}
+
+// newDec converts a sdk.Dec into the Dec type used in the ABI.
+func newDec(dec sdk.Dec) cmn.Dec {
+	return cmn.Dec{
+		Value:     dec.BigInt(),
+		Precision: math.LegacyPrecision,
+	}
+}
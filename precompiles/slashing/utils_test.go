@@ -0,0 +1,38 @@
+package slashing_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/precompiles/slashing"
+)
+
+// setupSlashingPrecompile is a helper function to set up an instance of the
+// slashing precompile.
+func (s *PrecompileTestSuite) setupSlashingPrecompile() *slashing.Precompile {
+	precompile, err := slashing.NewPrecompile(
+		s.network.App.SlashingKeeper,
+		s.network.App.AuthzKeeper,
+	)
+	s.Require().NoError(err, "failed to create slashing precompile")
+
+	return precompile
+}
+
+// jailValidator is a helper function to jail the first validator of the
+// network and add a self delegation so it can be unjailed. It returns the hex
+// address of the validator operator.
+func (s *PrecompileTestSuite) jailValidator() common.Address {
+	ctx := s.network.GetContext()
+	validator := s.network.GetValidators()[0]
+	valAddr := validator.GetOperator()
+
+	delegation := stakingtypes.NewDelegation(valAddr.Bytes(), valAddr, sdk.OneDec())
+	s.network.App.StakingKeeper.SetDelegation(ctx, delegation)
+
+	consAddr, err := validator.GetConsAddr()
+	s.Require().NoError(err, "failed to get consensus address")
+	s.network.App.StakingKeeper.Jail(ctx, consAddr)
+
+	return common.BytesToAddress(valAddr.Bytes())
+}
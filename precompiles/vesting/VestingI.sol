@@ -22,6 +22,14 @@ struct Period {
     Coin[] amount;
 }
 
+// SchedulePeriod defines a period of a vesting schedule with its absolute
+// start and end unix times and the amount of coins released at its end.
+struct SchedulePeriod {
+    int64 startTime;
+    int64 endTime;
+    Coin[] amount;
+}
+
 /// @author Evmos Team
 /// @title Vesting Precompiled Contract
 /// @dev The interface through which solidity contracts will interact with vesting.
@@ -155,4 +163,35 @@ interface VestingI {
     function balances(
         address vestingAddress
     ) external view returns (Coin[] memory locked, Coin[] memory unvested, Coin[] memory vested);
+
+    /// @dev Defines a query for getting the lockup and vesting schedule of a vesting account.
+    /// @param vestingAddress The address of the vesting account.
+    function vestingSchedule(
+        address vestingAddress
+    )
+        external
+        view
+        returns (
+            address funder,
+            int64 startTime,
+            int64 endTime,
+            SchedulePeriod[] memory lockupPeriods,
+            SchedulePeriod[] memory vestingPeriods
+        );
+
+    /// @dev Defines a query for projecting the balances of a vesting account at a given time.
+    /// @param vestingAddress The address of the vesting account.
+    /// @param time The unix time in seconds of the projection. The current block time is used if zero.
+    function vestingProjection(
+        address vestingAddress,
+        int64 time
+    )
+        external
+        view
+        returns (
+            Coin[] memory locked,
+            Coin[] memory unvested,
+            Coin[] memory vested,
+            Coin[] memory delegatable
+        );
 }
@@ -10,6 +10,10 @@ import (
 const (
 	// BalancesMethod defines the ABI method name for the Balances query.
 	BalancesMethod = "balances"
+	// VestingScheduleMethod defines the ABI method name for the VestingSchedule query.
+	VestingScheduleMethod = "vestingSchedule"
+	// VestingProjectionMethod defines the ABI method name for the VestingProjection query.
+	VestingProjectionMethod = "vestingProjection"
 )
 
 // Balances queries the balances of a clawback vesting account.
@@ -32,3 +36,48 @@ func (p Precompile) Balances(
 
 	return method.Outputs.Pack(out.Locked, out.Unvested, out.Vested)
 }
+
+// VestingSchedule queries the lockup and vesting schedule of a clawback vesting account.
+func (p Precompile) VestingSchedule(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewVestingScheduleRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.vestingKeeper.VestingSchedule(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(VestingScheduleOutput).FromResponse(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out.Funder, out.StartTime, out.EndTime, out.LockupPeriods, out.VestingPeriods)
+}
+
+// VestingProjection queries the balances of a clawback vesting account at a given time.
+func (p Precompile) VestingProjection(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewVestingProjectionRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.vestingKeeper.VestingProjection(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(VestingProjectionOutput).FromResponse(response)
+
+	return method.Outputs.Pack(out.Locked, out.Unvested, out.Vested, out.Delegatable)
+}
@@ -4,9 +4,12 @@ package vesting_test
 
 import (
 	"fmt"
+	"math/big"
+	"time"
 
 	cmn "github.com/evmos/evmos/v19/precompiles/common"
 	"github.com/evmos/evmos/v19/precompiles/vesting"
+	"github.com/evmos/evmos/v19/utils"
 )
 
 func (s *PrecompileTestSuite) TestBalances() {
@@ -93,3 +96,197 @@ func (s *PrecompileTestSuite) TestBalances() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestVestingSchedule() {
+	method := s.precompile.Methods[vesting.VestingScheduleMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid address",
+			func() []interface{} {
+				return []interface{}{
+					"12asji1",
+				}
+			},
+			func([]byte) {},
+			true,
+			"invalid type for vestingAddress",
+		},
+		{
+			"fail - account is not a vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+				}
+			},
+			func([]byte) {},
+			true,
+			"is not a vesting account",
+		},
+		{
+			"success - should return vesting account schedule",
+			func() []interface{} {
+				s.CreateTestClawbackVestingAccount(s.address, toAddr)
+				s.FundTestClawbackVestingAccount()
+				return []interface{}{
+					toAddr,
+				}
+			},
+			func(data []byte) {
+				var out vesting.VestingScheduleOutput
+				err := s.precompile.UnpackIntoInterface(&out, vesting.VestingScheduleMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal(s.address, out.Funder)
+				s.Require().Len(out.LockupPeriods, len(lockupPeriods))
+				s.Require().Len(out.VestingPeriods, len(vestingPeriods))
+
+				s.Require().Equal(out.StartTime, out.LockupPeriods[0].StartTime)
+				s.Require().Equal(out.StartTime+lockupPeriods[0].Length, out.LockupPeriods[0].EndTime)
+				s.Require().Equal(lockupPeriods[0].Amount, out.LockupPeriods[0].Amount)
+
+				s.Require().Equal(out.StartTime, out.VestingPeriods[0].StartTime)
+				s.Require().Equal(out.StartTime+vestingPeriods[0].Length, out.VestingPeriods[0].EndTime)
+				s.Require().Equal(vestingPeriods[0].Amount, out.VestingPeriods[0].Amount)
+				s.Require().Equal(out.VestingPeriods[len(out.VestingPeriods)-1].EndTime, out.EndTime)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			bz, err := s.precompile.VestingSchedule(s.ctx, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestVestingProjection() {
+	method := s.precompile.Methods[vesting.VestingProjectionMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid time",
+			func() []interface{} {
+				return []interface{}{
+					toAddr, "now",
+				}
+			},
+			func([]byte) {},
+			true,
+			"invalid type for time",
+		},
+		{
+			"fail - account is not a vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.address, int64(0),
+				}
+			},
+			func([]byte) {},
+			true,
+			"is not a vesting account",
+		},
+		{
+			"success - should return the balances at the current block time",
+			func() []interface{} {
+				s.CreateTestClawbackVestingAccount(s.address, toAddr)
+				s.FundTestClawbackVestingAccount()
+				return []interface{}{
+					toAddr, int64(0),
+				}
+			},
+			func(data []byte) {
+				var out vesting.VestingProjectionOutput
+				err := s.precompile.UnpackIntoInterface(&out, vesting.VestingProjectionMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal(lockupPeriods[0].Amount, out.Locked)
+				s.Require().Equal(lockupPeriods[0].Amount, out.Unvested)
+				// only the coins funded outside of the schedule can be delegated
+				s.Require().Equal([]cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(100)}}, out.Delegatable)
+			},
+			false,
+			"",
+		},
+		{
+			"success - should return the balances after the schedule ended",
+			func() []interface{} {
+				s.CreateTestClawbackVestingAccount(s.address, toAddr)
+				s.FundTestClawbackVestingAccount()
+				return []interface{}{
+					toAddr, time.Now().Add(24 * time.Hour).Unix(),
+				}
+			},
+			func(data []byte) {
+				var out vesting.VestingProjectionOutput
+				err := s.precompile.UnpackIntoInterface(&out, vesting.VestingProjectionMethod, data)
+				s.Require().NoError(err)
+				s.Require().Empty(out.Locked)
+				s.Require().Empty(out.Unvested)
+				s.Require().Equal(lockupPeriods[0].Amount, out.Vested)
+				// the vested coins and the coins funded outside of the schedule can be delegated
+				s.Require().Equal([]cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(1100)}}, out.Delegatable)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			bz, err := s.precompile.VestingProjection(s.ctx, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
@@ -230,6 +230,48 @@ func NewBalancesRequest(args []interface{}) (*vestingtypes.QueryBalancesRequest,
 	return msg, nil
 }
 
+// NewVestingScheduleRequest creates a new QueryVestingScheduleRequest instance.
+func NewVestingScheduleRequest(args []interface{}) (*vestingtypes.QueryVestingScheduleRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	address, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "vestingAddress", "Address", args[0])
+	}
+
+	msg := &vestingtypes.QueryVestingScheduleRequest{
+		Address: sdk.AccAddress(address.Bytes()).String(),
+	}
+
+	return msg, nil
+}
+
+// NewVestingProjectionRequest creates a new QueryVestingProjectionRequest instance.
+func NewVestingProjectionRequest(args []interface{}) (*vestingtypes.QueryVestingProjectionRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	address, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "vestingAddress", "Address", args[0])
+	}
+
+	atTime, ok := args[1].(int64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "time", "int64", args[1])
+	}
+
+	msg := &vestingtypes.QueryVestingProjectionRequest{
+		Address: sdk.AccAddress(address.Bytes()).String(),
+		AtTime:  atTime,
+	}
+
+	return msg, nil
+}
+
 // validateBasicArgs validates the basic arguments and length of the provided arguments.
 func validateBasicArgs(args []interface{}, expectedLength int) (common.Address, common.Address, error) {
 	if len(args) != expectedLength {
@@ -282,6 +324,68 @@ func (bo *BalancesOutput) FromResponse(res *vestingtypes.QueryBalancesResponse)
 	return bo
 }
 
+// SchedulePeriod represents a period of a vesting schedule with its absolute
+// start and end unix times
+type SchedulePeriod struct {
+	StartTime int64
+	EndTime   int64
+	Amount    []cmn.Coin
+}
+
+// VestingScheduleOutput represents the schedule of a ClawbackVestingAccount
+type VestingScheduleOutput struct {
+	Funder         common.Address
+	StartTime      int64
+	EndTime        int64
+	LockupPeriods  []SchedulePeriod
+	VestingPeriods []SchedulePeriod
+}
+
+// FromResponse populates the VestingScheduleOutput from a QueryVestingScheduleResponse.
+func (vso *VestingScheduleOutput) FromResponse(res *vestingtypes.QueryVestingScheduleResponse) (*VestingScheduleOutput, error) {
+	funder, err := sdk.AccAddressFromBech32(res.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	vso.Funder = common.BytesToAddress(funder)
+	vso.StartTime = res.StartTime.Unix()
+	vso.EndTime = res.EndTime.Unix()
+	vso.LockupPeriods = newSchedulePeriods(res.LockupPeriods)
+	vso.VestingPeriods = newSchedulePeriods(res.VestingPeriods)
+	return vso, nil
+}
+
+// newSchedulePeriods creates a SchedulePeriod slice from the schedule periods of a query response.
+func newSchedulePeriods(periods []vestingtypes.SchedulePeriod) []SchedulePeriod {
+	schedulePeriods := make([]SchedulePeriod, len(periods))
+	for i, period := range periods {
+		schedulePeriods[i] = SchedulePeriod{
+			StartTime: period.StartTime.Unix(),
+			EndTime:   period.EndTime.Unix(),
+			Amount:    cmn.NewCoinsResponse(period.Amount),
+		}
+	}
+	return schedulePeriods
+}
+
+// VestingProjectionOutput represents the projected balances of a ClawbackVestingAccount
+type VestingProjectionOutput struct {
+	Locked      []cmn.Coin
+	Unvested    []cmn.Coin
+	Vested      []cmn.Coin
+	Delegatable []cmn.Coin
+}
+
+// FromResponse populates the VestingProjectionOutput from a QueryVestingProjectionResponse.
+func (vpo *VestingProjectionOutput) FromResponse(res *vestingtypes.QueryVestingProjectionResponse) *VestingProjectionOutput {
+	vpo.Locked = cmn.NewCoinsResponse(res.Locked)
+	vpo.Unvested = cmn.NewCoinsResponse(res.Unvested)
+	vpo.Vested = cmn.NewCoinsResponse(res.Vested)
+	vpo.Delegatable = cmn.NewCoinsResponse(res.Delegatable)
+	return vpo
+}
+
 // ClawbackOutput represents the clawed back coins from a Clawback transaction.
 type ClawbackOutput struct {
 	Coins []cmn.Coin
@@ -109,6 +109,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// Vesting queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, method, args)
+	case VestingScheduleMethod:
+		bz, err = p.VestingSchedule(ctx, method, args)
+	case VestingProjectionMethod:
+		bz, err = p.VestingProjection(ctx, method, args)
 	}
 
 	if err != nil {
@@ -0,0 +1,433 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/evmos/evmos/v19/server/config"
+)
+
+const (
+	// APIKeyHeader is the HTTP header of the JSON-RPC API keys.
+	APIKeyHeader = "X-API-Key"
+
+	// maxRequestContentLength is the maximum body size accepted by the go-ethereum rpc server.
+	maxRequestContentLength = 1024 * 1024 * 5
+
+	// limiterIdleTimeout is the idle time after which the rate limiters of a client are removed.
+	limiterIdleTimeout = 5 * time.Minute
+
+	// JSON-RPC error codes, see EIP-1474.
+	errCodeInvalidRequest = -32600
+	errCodeMethodNotFound = -32601
+	errCodeUnauthorized   = -32000
+	errCodeLimitExceeded  = -32005
+)
+
+// jsonrpcCall is the subset of a JSON-RPC request used by the access control.
+type jsonrpcCall struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+// isNotification returns true if the call doesn't expect a response.
+func (c jsonrpcCall) isNotification() bool {
+	return len(c.ID) == 0
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonrpcErrorResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   jsonrpcError    `json:"error"`
+}
+
+func newErrorResponse(id json.RawMessage, code int, msg string) jsonrpcErrorResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return jsonrpcErrorResponse{Jsonrpc: "2.0", ID: id, Error: jsonrpcError{Code: code, Message: msg}}
+}
+
+// methodPatterns matches the JSON-RPC methods by name, or by prefix with a trailing "*".
+type methodPatterns []string
+
+func (p methodPatterns) match(method string) bool {
+	for _, pattern := range p {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(method, prefix) {
+				return true
+			}
+		} else if pattern == method {
+			return true
+		}
+	}
+	return false
+}
+
+// clientLimiters are the token buckets of a client.
+type clientLimiters struct {
+	calls     *rate.Limiter
+	expensive *rate.Limiter
+	lastSeen  time.Time
+}
+
+// AccessControl enforces the method allow/deny lists, the API keys, the per client
+// rate limits and the batch size limit of the JSON-RPC server.
+type AccessControl struct {
+	allowed   methodPatterns
+	denied    methodPatterns
+	expensive methodPatterns
+	apiKeys   map[string]struct{}
+
+	rateLimit          rate.Limit
+	rateBurst          int
+	expensiveRateLimit rate.Limit
+	expensiveRateBurst int
+	batchLimit         int
+
+	mu        sync.Mutex
+	limiters  map[string]*clientLimiters
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewAccessControl creates the JSON-RPC access control from the config.
+func NewAccessControl(cfg config.JSONRPCConfig) *AccessControl {
+	apiKeys := make(map[string]struct{}, len(cfg.APIKeys))
+	for _, key := range cfg.APIKeys {
+		apiKeys[key] = struct{}{}
+	}
+	return &AccessControl{
+		allowed:            cfg.AllowedMethods,
+		denied:             cfg.DeniedMethods,
+		expensive:          cfg.ExpensiveMethods,
+		apiKeys:            apiKeys,
+		rateLimit:          rate.Limit(cfg.RateLimit),
+		rateBurst:          cfg.RateBurst,
+		expensiveRateLimit: rate.Limit(cfg.ExpensiveRateLimit),
+		expensiveRateBurst: cfg.ExpensiveRateBurst,
+		batchLimit:         cfg.BatchRequestLimit,
+		limiters:           make(map[string]*clientLimiters),
+		now:                time.Now,
+	}
+}
+
+// Enabled returns true if any access rule is configured.
+func (ac *AccessControl) Enabled() bool {
+	return len(ac.allowed) > 0 || len(ac.denied) > 0 || len(ac.apiKeys) > 0 ||
+		ac.rateLimit > 0 || ac.expensiveRateLimit > 0 || ac.batchLimit > 0
+}
+
+// RequireAPIKey returns true if the clients must provide an API key.
+func (ac *AccessControl) RequireAPIKey() bool {
+	return len(ac.apiKeys) > 0
+}
+
+// MethodAllowed returns true if the method can be called.
+func (ac *AccessControl) MethodAllowed(method string) bool {
+	if ac.denied.match(method) {
+		return false
+	}
+	return len(ac.allowed) == 0 || ac.allowed.match(method)
+}
+
+// APIKey returns the API key of the request, from the X-API-Key header or the URL path.
+func APIKey(r *http.Request) string {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return key
+	}
+	return strings.Trim(r.URL.Path, "/")
+}
+
+// Authorized returns true if the request provides a valid API key, or if no key is required.
+func (ac *AccessControl) Authorized(r *http.Request) bool {
+	if !ac.RequireAPIKey() {
+		return true
+	}
+	_, ok := ac.apiKeys[APIKey(r)]
+	return ok
+}
+
+// ClientID returns the identifier of the client the rate limits apply to: the API key if
+// required, otherwise the IP address. The X-Forwarded-For header is only trusted from the
+// loopback interface, which is the case of the websocket server and local reverse proxies.
+func (ac *AccessControl) ClientID(r *http.Request) string {
+	if ac.RequireAPIKey() {
+		return "key:" + APIKey(r)
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			// the last address is the one appended by the closest proxy
+			addrs := strings.Split(forwarded, ",")
+			host = strings.TrimSpace(addrs[len(addrs)-1])
+		}
+	}
+	return "ip:" + host
+}
+
+// Handler wraps the JSON-RPC http handler with the access rules.
+func (ac *AccessControl) Handler(next http.Handler) http.Handler {
+	if !ac.Enabled() {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !ac.Authorized(r) {
+			writeJSON(w, http.StatusUnauthorized, newErrorResponse(nil, errCodeUnauthorized, "missing or invalid API key"))
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(body) > maxRequestContentLength {
+			http.Error(w, fmt.Sprintf("content length too large (>%d)", maxRequestContentLength), http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		calls, batch, err := parseCalls(body)
+		if err != nil {
+			// let the rpc server report the parse errors
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if batch && ac.batchLimit > 0 && len(calls) > ac.batchLimit {
+			writeJSON(w, http.StatusOK, newErrorResponse(nil, errCodeInvalidRequest,
+				fmt.Sprintf("batch too large, max %d calls", ac.batchLimit)))
+			return
+		}
+
+		if retryAfter, ok := ac.allow(ac.ClientID(r), calls); !ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			writeJSON(w, http.StatusTooManyRequests, newErrorResponse(nil, errCodeLimitExceeded, "request rate limit exceeded"))
+			return
+		}
+
+		ac.serveAllowedCalls(w, r, next, body, calls, batch)
+	})
+}
+
+// serveAllowedCalls forwards the allowed calls to the rpc server, and responds with an
+// error to the other ones.
+func (ac *AccessControl) serveAllowedCalls(
+	w http.ResponseWriter,
+	r *http.Request,
+	next http.Handler,
+	body []byte,
+	calls []jsonrpcCall,
+	batch bool,
+) {
+	var (
+		allowed []int
+		denied  []jsonrpcErrorResponse
+	)
+	for i, call := range calls {
+		if ac.MethodAllowed(call.Method) {
+			allowed = append(allowed, i)
+			continue
+		}
+		if batch && call.isNotification() {
+			continue
+		}
+		denied = append(denied, newErrorResponse(call.ID, errCodeMethodNotFound,
+			fmt.Sprintf("the method %s does not exist/is not available", call.Method)))
+	}
+
+	switch {
+	case len(denied) == 0:
+		next.ServeHTTP(w, r)
+		return
+	case !batch:
+		writeJSON(w, http.StatusOK, denied[0])
+		return
+	case len(allowed) == 0:
+		writeJSON(w, http.StatusOK, denied)
+		return
+	}
+
+	// forward the allowed subset of the batch, then merge the responses
+	var raw []json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		next.ServeHTTP(w, r)
+		return
+	}
+	subset := make([]json.RawMessage, len(allowed))
+	for i, idx := range allowed {
+		subset[i] = raw[idx]
+	}
+	bz, err := json.Marshal(subset)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rec := newResponseBuffer()
+	req := r.Clone(r.Context())
+	req.Body = io.NopCloser(bytes.NewReader(bz))
+	req.ContentLength = int64(len(bz))
+	next.ServeHTTP(rec, req)
+
+	var responses []json.RawMessage
+	if rec.code == http.StatusOK && rec.body.Len() > 0 {
+		if err := json.Unmarshal(rec.body.Bytes(), &responses); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	for _, res := range denied {
+		bz, err := json.Marshal(res)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		responses = append(responses, bz)
+	}
+	writeJSON(w, http.StatusOK, responses)
+}
+
+// allow consumes the tokens of the calls from the client buckets, it returns the
+// time to wait if a limit is exceeded.
+func (ac *AccessControl) allow(clientID string, calls []jsonrpcCall) (time.Duration, bool) {
+	if ac.rateLimit <= 0 && ac.expensiveRateLimit <= 0 {
+		return 0, true
+	}
+
+	expensive := 0
+	for _, call := range calls {
+		if ac.expensive.match(call.Method) {
+			expensive++
+		}
+	}
+
+	now := ac.now()
+	limiters := ac.clientLimiters(clientID, now)
+
+	var reservations []*rate.Reservation
+	cancel := func() {
+		for _, res := range reservations {
+			res.CancelAt(now)
+		}
+	}
+	reserve := func(limiter *rate.Limiter, n int) (time.Duration, bool) {
+		if limiter == nil || n == 0 {
+			return 0, true
+		}
+		res := limiter.ReserveN(now, n)
+		if !res.OK() {
+			cancel()
+			// the calls exceed the burst, retry after the bucket is full again
+			return time.Duration(float64(limiter.Burst()) / float64(limiter.Limit()) * float64(time.Second)), false
+		}
+		if delay := res.DelayFrom(now); delay > 0 {
+			res.CancelAt(now)
+			cancel()
+			return delay, false
+		}
+		reservations = append(reservations, res)
+		return 0, true
+	}
+
+	if delay, ok := reserve(limiters.calls, len(calls)); !ok {
+		return delay, false
+	}
+	if delay, ok := reserve(limiters.expensive, expensive); !ok {
+		return delay, false
+	}
+	return 0, true
+}
+
+// clientLimiters returns the token buckets of the client, and removes the idle ones.
+func (ac *AccessControl) clientLimiters(clientID string, now time.Time) *clientLimiters {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	if now.Sub(ac.lastSweep) > limiterIdleTimeout {
+		for id, limiters := range ac.limiters {
+			if now.Sub(limiters.lastSeen) > limiterIdleTimeout {
+				delete(ac.limiters, id)
+			}
+		}
+		ac.lastSweep = now
+	}
+
+	limiters, ok := ac.limiters[clientID]
+	if !ok {
+		limiters = &clientLimiters{}
+		if ac.rateLimit > 0 {
+			limiters.calls = rate.NewLimiter(ac.rateLimit, ac.rateBurst)
+		}
+		if ac.expensiveRateLimit > 0 {
+			limiters.expensive = rate.NewLimiter(ac.expensiveRateLimit, ac.expensiveRateBurst)
+		}
+		ac.limiters[clientID] = limiters
+	}
+	limiters.lastSeen = now
+	return limiters
+}
+
+// parseCalls decodes a single or batch JSON-RPC request.
+func parseCalls(body []byte) ([]jsonrpcCall, bool, error) {
+	if isBatch(body) {
+		var calls []jsonrpcCall
+		if err := json.Unmarshal(body, &calls); err != nil {
+			return nil, true, err
+		}
+		return calls, true, nil
+	}
+	var call jsonrpcCall
+	if err := json.Unmarshal(body, &call); err != nil {
+		return nil, false, err
+	}
+	return []jsonrpcCall{call}, false, nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v) // #nosec G703
+}
+
+// responseBuffer records the response of the rpc server to a subset of a batch.
+type responseBuffer struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func newResponseBuffer() *responseBuffer {
+	return &responseBuffer{header: make(http.Header), code: http.StatusOK}
+}
+
+func (b *responseBuffer) Header() http.Header         { return b.header }
+func (b *responseBuffer) Write(p []byte) (int, error) { return b.body.Write(p) }
+func (b *responseBuffer) WriteHeader(code int)        { b.code = code }
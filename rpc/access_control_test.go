@@ -0,0 +1,241 @@
+package rpc
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/server/config"
+)
+
+// echoHandler responds to each call with its method as result.
+var echoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	calls, batch, err := parseCalls(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	type response struct {
+		ID     json.RawMessage `json:"id"`
+		Result string          `json:"result"`
+	}
+	responses := make([]response, len(calls))
+	for i, call := range calls {
+		responses[i] = response{ID: call.ID, Result: call.Method}
+	}
+	if batch {
+		writeJSON(w, http.StatusOK, responses)
+		return
+	}
+	writeJSON(w, http.StatusOK, responses[0])
+})
+
+type testResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result string          `json:"result"`
+	Error  *jsonrpcError   `json:"error"`
+}
+
+func doRequest(handler http.Handler, path, body string, header http.Header, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if remoteAddr != "" {
+		req.RemoteAddr = remoteAddr
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAccessControlMethods(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.AllowedMethods = []string{"eth_*", "net_version"}
+	cfg.DeniedMethods = []string{"eth_sign"}
+	handler := NewAccessControl(*cfg).Handler(echoHandler)
+
+	testCases := []struct {
+		name      string
+		body      string
+		expResult map[string]string
+		expErrors map[string]int
+	}{
+		{
+			"allowed by prefix",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`,
+			map[string]string{"1": "eth_blockNumber"},
+			nil,
+		},
+		{
+			"allowed by name",
+			`{"jsonrpc":"2.0","id":1,"method":"net_version"}`,
+			map[string]string{"1": "net_version"},
+			nil,
+		},
+		{
+			"not allowed",
+			`{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"}`,
+			nil,
+			map[string]int{"1": errCodeMethodNotFound},
+		},
+		{
+			"denied takes precedence",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_sign"}`,
+			nil,
+			map[string]int{"1": errCodeMethodNotFound},
+		},
+		{
+			"batch with denied calls",
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_sign"},` +
+				`{"jsonrpc":"2.0","id":3,"method":"net_version"},{"jsonrpc":"2.0","method":"debug_foo"}]`,
+			map[string]string{"1": "eth_chainId", "3": "net_version"},
+			map[string]int{"2": errCodeMethodNotFound},
+		},
+		{
+			"batch with only denied calls",
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_sign"},{"jsonrpc":"2.0","id":2,"method":"personal_sign"}]`,
+			nil,
+			map[string]int{"1": errCodeMethodNotFound, "2": errCodeMethodNotFound},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := doRequest(handler, "/", tc.body, nil, "")
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var responses []testResponse
+			if strings.HasPrefix(tc.body, "[") {
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responses))
+			} else {
+				var res testResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+				responses = []testResponse{res}
+			}
+			require.Len(t, responses, len(tc.expResult)+len(tc.expErrors))
+
+			for _, res := range responses {
+				id := string(res.ID)
+				if code, ok := tc.expErrors[id]; ok {
+					require.NotNil(t, res.Error, id)
+					require.Equal(t, code, res.Error.Code)
+					continue
+				}
+				require.Nil(t, res.Error, id)
+				require.Equal(t, tc.expResult[id], res.Result)
+			}
+		})
+	}
+}
+
+func TestAccessControlAPIKeys(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.APIKeys = []string{"key1", "key2"}
+	handler := NewAccessControl(*cfg).Handler(echoHandler)
+	body := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`
+
+	testCases := []struct {
+		name    string
+		path    string
+		header  http.Header
+		expCode int
+	}{
+		{"key in header", "/", http.Header{APIKeyHeader: {"key1"}}, http.StatusOK},
+		{"key in path", "/key2", nil, http.StatusOK},
+		{"missing key", "/", nil, http.StatusUnauthorized},
+		{"invalid key in header", "/", http.Header{APIKeyHeader: {"key3"}}, http.StatusUnauthorized},
+		{"invalid key in path", "/key3", nil, http.StatusUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := doRequest(handler, tc.path, body, tc.header, "")
+			require.Equal(t, tc.expCode, rec.Code)
+			if tc.expCode != http.StatusOK {
+				var res testResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+				require.Equal(t, errCodeUnauthorized, res.Error.Code)
+			}
+		})
+	}
+}
+
+func TestAccessControlBatchLimit(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.BatchRequestLimit = 2
+	handler := NewAccessControl(*cfg).Handler(echoHandler)
+
+	rec := doRequest(handler, "/", `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"}]`, nil, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var responses []testResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responses))
+	require.Len(t, responses, 2)
+
+	rec = doRequest(handler, "/", `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"},{"id":3,"method":"eth_chainId"}]`, nil, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var res testResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, errCodeInvalidRequest, res.Error.Code)
+	require.Equal(t, "null", string(res.ID))
+}
+
+func TestAccessControlRateLimit(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.RateLimit = 1
+	cfg.RateBurst = 3
+	cfg.ExpensiveRateLimit = 1
+	cfg.ExpensiveRateBurst = 1
+	ac := NewAccessControl(*cfg)
+	// no token is refilled during the test
+	now := time.Now()
+	ac.now = func() time.Time { return now }
+	handler := ac.Handler(echoHandler)
+
+	call := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`
+	expensiveCall := `{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"}`
+	client1, client2 := "10.0.0.1:1234", "10.0.0.2:1234"
+
+	// the expensive budget is consumed by a single call
+	require.Equal(t, http.StatusOK, doRequest(handler, "/", expensiveCall, nil, client1).Code)
+	rec := doRequest(handler, "/", expensiveCall, nil, client1)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+	var res testResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, errCodeLimitExceeded, res.Error.Code)
+
+	// the rejected call doesn't consume the general budget
+	require.Equal(t, http.StatusOK, doRequest(handler, "/", call, nil, client1).Code)
+	require.Equal(t, http.StatusOK, doRequest(handler, "/", call, nil, client1).Code)
+	require.Equal(t, http.StatusTooManyRequests, doRequest(handler, "/", call, nil, client1).Code)
+
+	// each call of a batch consumes a token, and the clients have separate budgets
+	batch := `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"},{"id":3,"method":"eth_chainId"}]`
+	require.Equal(t, http.StatusOK, doRequest(handler, "/", batch, nil, client2).Code)
+	require.Equal(t, http.StatusTooManyRequests, doRequest(handler, "/", call, nil, client2).Code)
+
+	// a batch above the burst can never be served
+	batch = `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"},{"id":3,"method":"eth_chainId"},{"id":4,"method":"eth_chainId"}]`
+	rec = doRequest(handler, "/", batch, nil, "10.0.0.3:1234")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "3", rec.Header().Get("Retry-After"))
+
+	// the forwarded address is only trusted from the loopback interface
+	header := http.Header{"X-Forwarded-For": {"10.0.0.2"}}
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.RemoteAddr = "127.0.0.1:1234"
+	req.Header = header
+	require.Equal(t, "ip:10.0.0.2", ac.ClientID(req))
+	req.RemoteAddr = client1
+	require.Equal(t, "ip:10.0.0.1", ac.ClientID(req))
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/miner"
 	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/net"
+	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/ots"
 	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/personal"
+	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/trace"
 	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/evmos/v19/rpc/namespaces/ethereum/web3"
 	"github.com/evmos/evmos/v19/types"
@@ -40,6 +42,14 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// Otterscan namespace
+
+	OtsNamespace = "ots"
+
+	// OpenEthereum trace namespace
+
+	TraceNamespace = "trace"
+
 	apiVersion = "1.0"
 )
 
@@ -157,6 +167,38 @@ func init() {
 				},
 			}
 		},
+		OtsNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: OtsNamespace,
+					Version:   apiVersion,
+					Service:   ots.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
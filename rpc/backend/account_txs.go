@@ -0,0 +1,108 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	evmostypes "github.com/evmos/evmos/v19/types"
+	"github.com/pkg/errors"
+)
+
+// errAccountTxIndexerDisabled is returned when the account tx history is queried
+// without the custom EVM indexer.
+var errAccountTxIndexerDisabled = errors.New("the account transaction history requires the custom EVM indexer to be enabled")
+
+// SearchTransactions returns a page of the transactions sent by or to the address,
+// in the blocks strictly before or after the given block number and in descending
+// order. A zero block number searches from the latest or the earliest block.
+func (b *Backend) SearchTransactions(address common.Address, blockNumber uint64, pageSize uint16, before bool) (*rpctypes.TransactionsSearchResult, error) {
+	accountTxIndexer, ok := b.indexer.(evmostypes.EVMAccountTxIndexer)
+	if !ok {
+		return nil, errAccountTxIndexerDisabled
+	}
+
+	hashes, hasMore, err := accountTxIndexer.SearchAccountTxs(address, int64(blockNumber), before, int(pageSize)) //#nosec G701 -- block numbers fit in int64
+	if err != nil {
+		return nil, err
+	}
+	if !before {
+		// txs after the block number are searched in ascending order
+		for i, j := 0, len(hashes)-1; i < j; i, j = i+1, j-1 {
+			hashes[i], hashes[j] = hashes[j], hashes[i]
+		}
+	}
+
+	result := &rpctypes.TransactionsSearchResult{
+		Txs:      make([]*rpctypes.RPCTransaction, 0, len(hashes)),
+		Receipts: make([]map[string]interface{}, 0, len(hashes)),
+	}
+	if before {
+		result.FirstPage = blockNumber == 0
+		result.LastPage = !hasMore
+	} else {
+		result.FirstPage = !hasMore
+		result.LastPage = blockNumber == 0
+	}
+
+	timestamps := make(map[int64]uint64)
+	for _, hash := range hashes {
+		tx, err := b.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := b.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || receipt == nil {
+			return nil, fmt.Errorf("transaction %s not found", hash.Hex())
+		}
+
+		height := tx.BlockNumber.ToInt().Int64()
+		timestamp, ok := timestamps[height]
+		if !ok {
+			resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			if resBlock == nil || resBlock.Block == nil {
+				return nil, fmt.Errorf("block not found for height %d", height)
+			}
+			timestamp = uint64(resBlock.Block.Time.Unix()) //#nosec G701 -- block times are after the unix epoch
+			timestamps[height] = timestamp
+		}
+		receipt["timestamp"] = hexutil.Uint64(timestamp)
+
+		result.Txs = append(result.Txs, tx)
+		result.Receipts = append(result.Receipts, receipt)
+	}
+	return result, nil
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by the
+// sender with the given nonce, returns nil if the transaction is not found.
+func (b *Backend) GetTransactionBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	accountTxIndexer, ok := b.indexer.(evmostypes.EVMAccountTxIndexer)
+	if !ok {
+		return nil, errAccountTxIndexerDisabled
+	}
+	return accountTxIndexer.GetTxHashBySenderAndNonce(sender, nonce)
+}
+
+// GetContractCreator returns the transaction and the account that created the
+// contract, returns nil if the address is not a contract created on chain.
+func (b *Backend) GetContractCreator(address common.Address) (*rpctypes.ContractCreator, error) {
+	accountTxIndexer, ok := b.indexer.(evmostypes.EVMAccountTxIndexer)
+	if !ok {
+		return nil, errAccountTxIndexerDisabled
+	}
+	creator, err := accountTxIndexer.GetContractCreator(address)
+	if err != nil || creator == nil {
+		return nil, err
+	}
+	return &rpctypes.ContractCreator{Hash: creator.TxHash, Creator: creator.Creator}, nil
+}
@@ -0,0 +1,120 @@
+package backend
+
+import (
+	dbm "github.com/cometbft/cometbft-db"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/indexer"
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+)
+
+func (suite *BackendTestSuite) TestSearchTransactions() {
+	testCases := []struct {
+		name        string
+		withIndexer bool
+		blockNumber uint64
+		before      bool
+		expResult   *rpctypes.TransactionsSearchResult
+		expPass     bool
+	}{
+		{
+			"fail - indexer disabled",
+			false,
+			0,
+			true,
+			nil,
+			false,
+		},
+		{
+			"pass - before the latest block",
+			true,
+			0,
+			true,
+			&rpctypes.TransactionsSearchResult{
+				Txs:       []*rpctypes.RPCTransaction{},
+				Receipts:  []map[string]interface{}{},
+				FirstPage: true,
+				LastPage:  true,
+			},
+			true,
+		},
+		{
+			"pass - before a block",
+			true,
+			10,
+			true,
+			&rpctypes.TransactionsSearchResult{
+				Txs:       []*rpctypes.RPCTransaction{},
+				Receipts:  []map[string]interface{}{},
+				FirstPage: false,
+				LastPage:  true,
+			},
+			true,
+		},
+		{
+			"pass - after a block",
+			true,
+			10,
+			false,
+			&rpctypes.TransactionsSearchResult{
+				Txs:       []*rpctypes.RPCTransaction{},
+				Receipts:  []map[string]interface{}{},
+				FirstPage: true,
+				LastPage:  false,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.backend.indexer = nil
+			if tc.withIndexer {
+				suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			}
+
+			res, err := suite.backend.SearchTransactions(utiltx.GenerateAddress(), tc.blockNumber, 25, tc.before)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetTransactionBySenderAndNonce() {
+	suite.SetupTest()
+	suite.backend.indexer = nil
+	_, err := suite.backend.GetTransactionBySenderAndNonce(utiltx.GenerateAddress(), 0)
+	suite.Require().ErrorContains(err, "requires the custom EVM indexer")
+
+	suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+	hash, err := suite.backend.GetTransactionBySenderAndNonce(utiltx.GenerateAddress(), 0)
+	suite.Require().NoError(err)
+	suite.Require().Nil(hash)
+}
+
+func (suite *BackendTestSuite) TestGetContractCreator() {
+	suite.SetupTest()
+	suite.backend.indexer = nil
+	_, err := suite.backend.GetContractCreator(utiltx.GenerateAddress())
+	suite.Require().ErrorContains(err, "requires the custom EVM indexer")
+
+	db := dbm.NewMemDB()
+	contract, creator := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	txHash := common.HexToHash("0x1")
+	suite.Require().NoError(db.Set(indexer.ContractCreatorKey(contract), append(txHash.Bytes(), creator.Bytes()...)))
+	suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+
+	res, err := suite.backend.GetContractCreator(contract)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&rpctypes.ContractCreator{Hash: txHash, Creator: creator}, res)
+
+	res, err = suite.backend.GetContractCreator(utiltx.GenerateAddress())
+	suite.Require().NoError(err)
+	suite.Require().Nil(res)
+}
@@ -80,6 +80,7 @@ type EVMBackend interface {
 	HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Header, error)
 	HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 	RPCBlockFromTendermintBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults, fullTx bool) (map[string]interface{}, error)
+	BlockProposer(height int64) (map[string]interface{}, error)
 	EthBlockByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Block, error)
 	EthBlockFromTendermintBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) (*ethtypes.Block, error)
 
@@ -110,6 +111,11 @@ type EVMBackend interface {
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 
+	// Account Tx History
+	SearchTransactions(address common.Address, blockNumber uint64, pageSize uint16, before bool) (*rpctypes.TransactionsSearchResult, error)
+	GetTransactionBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error)
+	GetContractCreator(address common.Address) (*rpctypes.ContractCreator, error)
+
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
@@ -126,6 +132,10 @@ type EVMBackend interface {
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	FlatTraceTransaction(hash common.Hash) ([]*rpctypes.FlatTrace, error)
+	FlatTraceBlock(block *tmrpctypes.ResultBlock) ([]*rpctypes.FlatTrace, error)
+	ReplayBlockTransactions(block *tmrpctypes.ResultBlock, traceTypes []string) ([]*rpctypes.TraceResults, error)
+	FilterTraces(args rpctypes.TraceFilterArgs) ([]*rpctypes.FlatTrace, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -140,6 +150,8 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             evmostypes.EVMTxIndexer
+	// traceCache holds the block traces by block hash and tracer config, nil if disabled
+	traceCache *TraceCache
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -160,6 +172,20 @@ func NewBackend(
 		panic(err)
 	}
 
+	var traceCache *TraceCache
+	if appConf.JSONRPC.TraceCacheSize > 0 {
+		traceCache, err = OpenTraceCache(
+			ctx.Config.RootDir,
+			server.GetAppDBBackend(ctx.Viper),
+			appConf.JSONRPC.TraceCacheSize,
+			appConf.JSONRPC.TraceCacheTTL,
+		)
+		if err != nil {
+			// the block traces are still served, re-executing the blocks
+			logger.Error("failed to open the trace cache, block traces are not cached", "error", err.Error())
+		}
+	}
+
 	return &Backend{
 		ctx:                 context.Background(),
 		clientCtx:           clientCtx,
@@ -169,5 +195,6 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		traceCache:          traceCache,
 	}
 }
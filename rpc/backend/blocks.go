@@ -281,7 +281,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 				continue
 			}
 
-			ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
+			ethMsg.Hash = ethMsg.ComputeHash().Hex()
 			result = append(result, ethMsg)
 		}
 	}
@@ -390,11 +390,10 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			continue
 		}
 
-		tx := ethMsg.AsTransaction()
 		height := uint64(block.Height) //#nosec G701 -- checked for int overflow already
 		index := uint64(txIndex)       //#nosec G701 -- checked for int overflow already
-		rpcTx, err := rpctypes.NewRPCTransaction(
-			tx,
+		rpcTx, err := rpctypes.NewTransactionFromMsg(
+			ethMsg,
 			common.BytesToHash(block.Hash()),
 			height,
 			index,
@@ -402,7 +401,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			b.chainID,
 		)
 		if err != nil {
-			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", tx.Hash().Hex(), "error", err.Error())
+			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", ethMsg.Hash, "error", err.Error())
 			continue
 		}
 		ethRPCTxs = append(ethRPCTxs, rpcTx)
@@ -460,9 +459,37 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		gasLimit, new(big.Int).SetUint64(gasUsed),
 		ethRPCTxs, bloom, validatorAddr, baseFee,
 	)
+
+	if len(block.Header.ProposerAddress) != 0 {
+		if proposer, err := b.BlockProposer(block.Height); err != nil {
+			b.logger.Debug("failed to query block proposer", "height", block.Height, "error", err.Error())
+		} else {
+			formattedBlock["proposer"] = proposer
+		}
+	}
+
 	return formattedBlock, nil
 }
 
+// BlockProposer returns the validator operator, EVM coinbase address and
+// moniker of the proposer of the block at the given height.
+func (b *Backend) BlockProposer(height int64) (map[string]interface{}, error) {
+	res, err := b.queryClient.BlockProposer(
+		rpctypes.ContextWithHeight(height),
+		&evmtypes.QueryBlockProposerRequest{Height: height},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"consAddress":      res.Proposer.ConsAddress,
+		"validatorAddress": res.Proposer.ValidatorAddress,
+		"coinbase":         res.Proposer.Coinbase,
+		"moniker":          res.Proposer.Moniker,
+	}, nil
+}
+
 // EthBlockByNumber returns the Ethereum Block identified by number.
 func (b *Backend) EthBlockByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Block, error) {
 	resBlock, err := b.TendermintBlockByNumber(blockNum)
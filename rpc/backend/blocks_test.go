@@ -1131,6 +1131,130 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 	}
 }
 
+func (suite *BackendTestSuite) TestBlockProposer() {
+	proposer := evmtypes.BlockProposer{
+		Height:           1,
+		ConsAddress:      sdk.ConsAddress(utiltx.GenerateAddress().Bytes()).String(),
+		ValidatorAddress: sdk.ValAddress(utiltx.GenerateAddress().Bytes()).String(),
+		Coinbase:         utiltx.GenerateAddress().Hex(),
+		Moniker:          "validator",
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expProposer  map[string]interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block proposer not found",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockProposerError(queryClient, proposer.Height)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - block proposer found",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockProposer(queryClient, proposer)
+			},
+			map[string]interface{}{
+				"consAddress":      proposer.ConsAddress,
+				"validatorAddress": proposer.ValidatorAddress,
+				"coinbase":         proposer.Coinbase,
+				"moniker":          proposer.Moniker,
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.BlockProposer(proposer.Height)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expProposer, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestRPCBlockFromTendermintBlockProposer() {
+	validator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	consAddress := sdk.ConsAddress(utiltx.GenerateAddress().Bytes())
+	proposer := evmtypes.BlockProposer{
+		Height:           1,
+		ConsAddress:      consAddress.String(),
+		ValidatorAddress: sdk.ValAddress(validator).String(),
+		Coinbase:         common.BytesToAddress(validator).Hex(),
+		Moniker:          "validator",
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func(queryClient *mocks.EVMQueryClient)
+		expProposer  bool
+	}{
+		{
+			"pass - block proposer not found is omitted",
+			func(queryClient *mocks.EVMQueryClient) {
+				RegisterBlockProposerError(queryClient, proposer.Height)
+			},
+			false,
+		},
+		{
+			"pass - block proposer is included",
+			func(queryClient *mocks.EVMQueryClient) {
+				RegisterBlockProposer(queryClient, proposer)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+
+			block := tmtypes.MakeBlock(1, []tmtypes.Tx{}, nil, nil)
+			block.ProposerAddress = consAddress.Bytes()
+			blockRes := &tmrpctypes.ResultBlockResults{
+				Height:     1,
+				TxsResults: []*types.ResponseDeliverTx{{Code: 0, GasUsed: 0}},
+			}
+
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			RegisterBaseFee(queryClient, math.NewInt(1))
+			queryClient.On("ValidatorAccount", ethrpc.ContextWithHeight(1), &evmtypes.QueryValidatorAccountRequest{ConsAddress: consAddress.String()}).
+				Return(&evmtypes.QueryValidatorAccountResponse{AccountAddress: validator.String()}, nil)
+			tc.registerMock(queryClient)
+
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			RegisterConsensusParams(client, 1)
+
+			res, err := suite.backend.RPCBlockFromTendermintBlock(&tmrpctypes.ResultBlock{Block: block}, blockRes, false)
+			suite.Require().NoError(err)
+			suite.Require().Equal(common.BytesToAddress(validator), res["miner"])
+
+			if tc.expProposer {
+				suite.Require().Equal(map[string]interface{}{
+					"consAddress":      proposer.ConsAddress,
+					"validatorAddress": proposer.ValidatorAddress,
+					"coinbase":         proposer.Coinbase,
+					"moniker":          proposer.Moniker,
+				}, res["proposer"])
+			} else {
+				suite.Require().NotContains(res, "proposer")
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestEthMsgsFromTendermintBlock() {
 	msgEthereumTx, bz := suite.buildEthereumTx()
 
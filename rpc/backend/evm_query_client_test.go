@@ -222,6 +222,17 @@ func TestRegisterValidatorAccount(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// BlockProposer
+func RegisterBlockProposer(queryClient *mocks.EVMQueryClient, proposer evmtypes.BlockProposer) {
+	queryClient.On("BlockProposer", rpc.ContextWithHeight(proposer.Height), &evmtypes.QueryBlockProposerRequest{Height: proposer.Height}).
+		Return(&evmtypes.QueryBlockProposerResponse{Proposer: proposer}, nil)
+}
+
+func RegisterBlockProposerError(queryClient *mocks.EVMQueryClient, height int64) {
+	queryClient.On("BlockProposer", rpc.ContextWithHeight(height), &evmtypes.QueryBlockProposerRequest{Height: height}).
+		Return(nil, status.Errorf(codes.NotFound, "block proposer not found for height %d", height))
+}
+
 // Code
 func RegisterCode(queryClient *mocks.EVMQueryClient, addr common.Address, code []byte) {
 	queryClient.On("Code", rpc.ContextWithHeight(1), &evmtypes.QueryCodeRequest{Address: addr.String()}).
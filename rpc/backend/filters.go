@@ -5,6 +5,7 @@ package backend
 import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmostypes "github.com/evmos/evmos/v19/types"
 	"github.com/pkg/errors"
 )
 
@@ -36,3 +37,38 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 func (b *Backend) BloomStatus() (uint64, uint64) {
 	return 4096, 0
 }
+
+// GetLogHeights returns the heights within [from, to] that can contain logs
+// emitted by one of the addresses with one of the topic0s as first topic, based
+// on the log indexes of the EVM indexer. The blocks after the last log indexed
+// block are all returned, as the indexer can lag behind the chain. It returns
+// false when the log indexes are not available for the range, or when the blocks
+// after the last log indexed block exceed the block range cap.
+func (b *Backend) GetLogHeights(from, to int64, addresses []common.Address, topic0s []common.Hash) ([]int64, bool, error) {
+	logIndexer, ok := b.indexer.(evmostypes.EVMLogIndexer)
+	if !ok || (len(addresses) == 0 && len(topic0s) == 0) {
+		return nil, false, nil
+	}
+
+	first, last, err := logIndexer.LogIndexedRange()
+	if err != nil {
+		return nil, false, err
+	}
+	if first == -1 || from < first || from > last {
+		return nil, false, nil
+	}
+	// the blocks that are not indexed yet are fetched one by one, so they are
+	// bounded by the block range cap like an unindexed query
+	if to-last > int64(b.RPCBlockRangeCap()) {
+		return nil, false, nil
+	}
+
+	heights, err := logIndexer.GetLogHeights(from, min(to, last), addresses, topic0s)
+	if err != nil {
+		return nil, false, err
+	}
+	for height := last + 1; height <= to; height++ {
+		heights = append(heights, height)
+	}
+	return heights, true, nil
+}
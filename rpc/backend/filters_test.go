@@ -3,9 +3,12 @@ package backend
 import (
 	"encoding/json"
 
+	dbm "github.com/cometbft/cometbft-db"
+	tmlog "github.com/cometbft/cometbft/libs/log"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v19/indexer"
 	"github.com/evmos/evmos/v19/rpc/backend/mocks"
 	ethrpc "github.com/evmos/evmos/v19/rpc/types"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
@@ -120,3 +123,43 @@ func (suite *BackendTestSuite) TestBloomStatus() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetLogHeights() {
+	address := common.HexToAddress("0x1")
+
+	testCases := []struct {
+		name       string
+		logIndex   bool
+		from, to   int64
+		addresses  []common.Address
+		expIndexed bool
+		expResult  []int64
+	}{
+		{"pass - log index disabled", false, 2, 3, []common.Address{address}, false, nil},
+		{"pass - no address nor topic", true, 2, 3, nil, false, nil},
+		{"pass - range starts before the log indexes", true, 1, 3, []common.Address{address}, false, nil},
+		{"pass - range starts after the log indexes", true, 4, 5, []common.Address{address}, false, nil},
+		{"pass - range covered by the log indexes", true, 2, 3, []common.Address{address}, true, []int64{}},
+		{"pass - blocks after the log indexes are returned", true, 2, 5, []common.Address{address}, true, []int64{4, 5}},
+		{"pass - blocks after the log indexes exceed the block range cap", true, 2, 6, []common.Address{address}, false, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx).WithLogIndex(tc.logIndex)
+			for height := int64(2); height <= 3; height++ {
+				err := idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: height}}, nil)
+				suite.Require().NoError(err)
+			}
+			suite.backend.indexer = idxer
+			suite.backend.cfg.JSONRPC.BlockRangeCap = 2
+
+			heights, indexed, err := suite.backend.GetLogHeights(tc.from, tc.to, tc.addresses, nil)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expIndexed, indexed)
+			suite.Require().Equal(tc.expResult, heights)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+// traceCacheDBName is the name of the database persisting the block traces in the
+// data directory of the node home.
+const traceCacheDBName = "tracecache"
+
+var (
+	// traceCaches holds the trace caches opened by the process, by database path.
+	// A backend is created for each JSON-RPC namespace, so they share the same
+	// trace cache and the database is only opened once.
+	traceCaches    = map[string]*TraceCache{}
+	traceCachesMtx sync.Mutex
+)
+
+// traceCacheEntry is the value stored in the trace cache database.
+type traceCacheEntry struct {
+	// CreatedAt is the unix time in nanoseconds at which the trace was cached
+	CreatedAt int64                     `json:"created_at"`
+	Results   []*evmtypes.TxTraceResult `json:"results"`
+}
+
+// TraceCache is a node-local cache of the block traces, keyed by block hash and
+// tracer config. The traces are persisted in a database under the node home, so
+// that they survive the node restarts. The least recently used traces are evicted
+// once the cache size is reached, and the traces are evicted after the TTL.
+type TraceCache struct {
+	db  dbm.DB
+	ttl time.Duration
+	// lru tracks the keys of the database entries to enforce the size and the TTL
+	lru *expirable.LRU[string, struct{}]
+}
+
+// OpenTraceCache opens the trace cache persisted in the data directory of the
+// given node home. The cache is shared by the callers opening the same directory.
+func OpenTraceCache(rootDir string, backendType dbm.BackendType, size int, ttl time.Duration) (*TraceCache, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	path := filepath.Join(dataDir, traceCacheDBName)
+
+	traceCachesMtx.Lock()
+	defer traceCachesMtx.Unlock()
+
+	if cache, ok := traceCaches[path]; ok {
+		return cache, nil
+	}
+
+	db, err := dbm.NewDB(traceCacheDBName, backendType, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := NewTraceCache(db, size, ttl)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	traceCaches[path] = cache
+	return cache, nil
+}
+
+// NewTraceCache creates a trace cache persisted in the given database, with at
+// most size entries evicted after the TTL (0 = never). The entries already stored
+// in the database are loaded, and the expired or exceeding ones are deleted.
+func NewTraceCache(db dbm.DB, size int, ttl time.Duration) (*TraceCache, error) {
+	c := &TraceCache{
+		db:  db,
+		ttl: ttl,
+	}
+	c.lru = expirable.NewLRU[string, struct{}](size, func(key string, _ struct{}) {
+		_ = c.db.Delete([]byte(key))
+	}, ttl)
+
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// load adds the keys of the stored entries to the LRU, from the oldest to the
+// most recent one, so that the oldest entries are evicted first.
+func (c *TraceCache) load() error {
+	type storedKey struct {
+		key       string
+		createdAt int64
+	}
+
+	var keys []storedKey
+	var expired [][]byte
+
+	itr, err := c.db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	for ; itr.Valid(); itr.Next() {
+		var entry traceCacheEntry
+		if err := json.Unmarshal(itr.Value(), &entry); err != nil || c.isExpired(entry) {
+			expired = append(expired, append([]byte{}, itr.Key()...))
+			continue
+		}
+		keys = append(keys, storedKey{key: string(itr.Key()), createdAt: entry.CreatedAt})
+	}
+	if err := itr.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := c.db.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].createdAt < keys[j].createdAt })
+	for _, k := range keys {
+		c.lru.Add(k.key, struct{}{})
+	}
+	return nil
+}
+
+// Get returns the cached trace results of the given key, if any.
+func (c *TraceCache) Get(key string) ([]*evmtypes.TxTraceResult, bool) {
+	if _, ok := c.lru.Get(key); !ok {
+		return nil, false
+	}
+
+	bz, err := c.db.Get([]byte(key))
+	if err != nil || len(bz) == 0 {
+		return nil, false
+	}
+
+	var entry traceCacheEntry
+	if err := json.Unmarshal(bz, &entry); err != nil {
+		return nil, false
+	}
+	// the entries loaded from the database keep their original creation time
+	if c.isExpired(entry) {
+		c.lru.Remove(key)
+		return nil, false
+	}
+	return entry.Results, true
+}
+
+// Add stores the trace results under the given key, evicting the least recently
+// used entry if the cache is full.
+func (c *TraceCache) Add(key string, results []*evmtypes.TxTraceResult) error {
+	bz, err := json.Marshal(traceCacheEntry{
+		CreatedAt: time.Now().UnixNano(),
+		Results:   results,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := c.db.Set([]byte(key), bz); err != nil {
+		return err
+	}
+	c.lru.Add(key, struct{}{})
+	return nil
+}
+
+// Len returns the number of cached traces.
+func (c *TraceCache) Len() int {
+	return c.lru.Len()
+}
+
+func (c *TraceCache) isExpired(entry traceCacheEntry) bool {
+	return c.ttl > 0 && time.Since(time.Unix(0, entry.CreatedAt)) > c.ttl
+}
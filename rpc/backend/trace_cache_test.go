@@ -0,0 +1,127 @@
+package backend
+
+import (
+	"encoding/json"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestTraceCache() {
+	results := []*evmtypes.TxTraceResult{{Result: map[string]interface{}{"type": "CALL"}}}
+
+	testCases := []struct {
+		name string
+		run  func(db dbm.DB)
+	}{
+		{
+			"pass - traces are kept across restarts",
+			func(db dbm.DB) {
+				cache, err := NewTraceCache(db, 10, time.Minute)
+				suite.Require().NoError(err)
+				suite.Require().NoError(cache.Add("block1", results))
+
+				cache, err = NewTraceCache(db, 10, time.Minute)
+				suite.Require().NoError(err)
+				cached, ok := cache.Get("block1")
+				suite.Require().True(ok)
+				suite.Require().Len(cached, 1)
+				suite.Require().Equal("CALL", cached[0].Result.(map[string]interface{})["type"])
+			},
+		},
+		{
+			"pass - least recently used trace evicted from the database",
+			func(db dbm.DB) {
+				cache, err := NewTraceCache(db, 2, time.Minute)
+				suite.Require().NoError(err)
+				suite.Require().NoError(cache.Add("block1", results))
+				suite.Require().NoError(cache.Add("block2", results))
+				_, ok := cache.Get("block1")
+				suite.Require().True(ok)
+				suite.Require().NoError(cache.Add("block3", results))
+
+				_, ok = cache.Get("block2")
+				suite.Require().False(ok)
+				has, err := db.Has([]byte("block2"))
+				suite.Require().NoError(err)
+				suite.Require().False(has)
+				suite.Require().Equal(2, cache.Len())
+			},
+		},
+		{
+			"pass - stored traces exceeding the size evicted on restart",
+			func(db dbm.DB) {
+				cache, err := NewTraceCache(db, 3, time.Minute)
+				suite.Require().NoError(err)
+				for _, key := range []string{"block1", "block2", "block3"} {
+					suite.Require().NoError(cache.Add(key, results))
+				}
+
+				cache, err = NewTraceCache(db, 1, time.Minute)
+				suite.Require().NoError(err)
+				suite.Require().Equal(1, cache.Len())
+				_, ok := cache.Get("block3")
+				suite.Require().True(ok)
+				for _, key := range []string{"block1", "block2"} {
+					has, err := db.Has([]byte(key))
+					suite.Require().NoError(err)
+					suite.Require().False(has)
+				}
+			},
+		},
+		{
+			"pass - expired traces deleted on restart",
+			func(db dbm.DB) {
+				bz, err := json.Marshal(traceCacheEntry{
+					CreatedAt: time.Now().Add(-time.Hour).UnixNano(),
+					Results:   results,
+				})
+				suite.Require().NoError(err)
+				suite.Require().NoError(db.Set([]byte("block1"), bz))
+
+				cache, err := NewTraceCache(db, 10, time.Minute)
+				suite.Require().NoError(err)
+				_, ok := cache.Get("block1")
+				suite.Require().False(ok)
+				has, err := db.Has([]byte("block1"))
+				suite.Require().NoError(err)
+				suite.Require().False(has)
+			},
+		},
+		{
+			"pass - traces never expire with a zero TTL",
+			func(db dbm.DB) {
+				bz, err := json.Marshal(traceCacheEntry{
+					CreatedAt: time.Now().Add(-time.Hour).UnixNano(),
+					Results:   results,
+				})
+				suite.Require().NoError(err)
+				suite.Require().NoError(db.Set([]byte("block1"), bz))
+
+				cache, err := NewTraceCache(db, 10, 0)
+				suite.Require().NoError(err)
+				_, ok := cache.Get("block1")
+				suite.Require().True(ok)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.run(dbm.NewMemDB())
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestOpenTraceCache() {
+	rootDir := suite.T().TempDir()
+
+	cache, err := OpenTraceCache(rootDir, dbm.GoLevelDBBackend, 10, time.Minute)
+	suite.Require().NoError(err)
+
+	// the backends of the different namespaces share the same cache
+	other, err := OpenTraceCache(rootDir, dbm.GoLevelDBBackend, 10, time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().Same(cache, other)
+}
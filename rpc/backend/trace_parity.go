@@ -0,0 +1,384 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+const (
+	// TraceTypeTrace defines the replay trace type returning the flat call traces.
+	TraceTypeTrace = "trace"
+	// TraceTypeStateDiff defines the replay trace type returning the state changes.
+	TraceTypeStateDiff = "stateDiff"
+	// TraceTypeVMTrace defines the replay trace type returning the executed opcodes.
+	TraceTypeVMTrace = "vmTrace"
+)
+
+var (
+	flatCallTraceConfig = &evmtypes.TraceConfig{Tracer: "flatCallTracer", TracerJsonConfig: `{"convertParityErrors":true}`}
+	stateDiffConfig     = &evmtypes.TraceConfig{Tracer: "prestateTracer", TracerJsonConfig: `{"diffMode":true}`}
+	vmTraceConfig       = &evmtypes.TraceConfig{Tracer: "vmTracer"}
+)
+
+// prestateAccount defines the account state returned by the prestateTracer in
+// diff mode. The post state only contains the modified fields.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Nonce   *uint64                     `json:"nonce"`
+	Code    *hexutil.Bytes              `json:"code"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// prestateDiff defines the result of the prestateTracer in diff mode.
+type prestateDiff struct {
+	Pre  map[common.Address]*prestateAccount `json:"pre"`
+	Post map[common.Address]*prestateAccount `json:"post"`
+}
+
+// FlatTraceTransaction returns the call traces of the transaction in the
+// OpenEthereum flat format.
+func (b *Backend) FlatTraceTransaction(hash common.Hash) ([]*rpctypes.FlatTrace, error) {
+	result, err := b.TraceTransaction(hash, flatCallTraceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*rpctypes.FlatTrace
+	if err := decodeTraceResult(result, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+// FlatTraceBlock returns the call traces of all the transactions in the block
+// in the OpenEthereum flat format.
+func (b *Backend) FlatTraceBlock(block *tmrpctypes.ResultBlock) ([]*rpctypes.FlatTrace, error) {
+	txTraces, err := b.flatTraceBlockTxs(block)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []*rpctypes.FlatTrace{}
+	for _, txTrace := range txTraces {
+		traces = append(traces, txTrace...)
+	}
+	return traces, nil
+}
+
+// ReplayBlockTransactions replays all the transactions in the block and returns
+// the requested trace types for each one of them: the flat call traces, the
+// state changes and the executed opcodes.
+func (b *Backend) ReplayBlockTransactions(block *tmrpctypes.ResultBlock, traceTypes []string) ([]*rpctypes.TraceResults, error) {
+	for _, traceType := range traceTypes {
+		if traceType != TraceTypeTrace && traceType != TraceTypeStateDiff && traceType != TraceTypeVMTrace {
+			return nil, fmt.Errorf("invalid trace type %s", traceType)
+		}
+	}
+
+	// the call traces are always needed for the output and the tx hash
+	txTraces, err := b.flatTraceBlockTxs(block)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*rpctypes.TraceResults, len(txTraces))
+	for i, traces := range txTraces {
+		results[i] = &rpctypes.TraceResults{Output: hexutil.Bytes{}}
+		if len(traces) == 0 {
+			continue
+		}
+		results[i].TransactionHash = traces[0].TransactionHash
+		if res := traces[0].Result; res != nil {
+			switch {
+			case res.Output != nil:
+				results[i].Output = *res.Output
+			case res.Code != nil:
+				results[i].Output = *res.Code
+			}
+		}
+
+		if slices.Contains(traceTypes, TraceTypeTrace) {
+			// the block and tx details are not included in the replayed traces
+			for _, trace := range traces {
+				trace.BlockHash = nil
+				trace.BlockNumber = nil
+				trace.TransactionHash = nil
+				trace.TransactionPosition = nil
+			}
+			results[i].Trace = traces
+		}
+	}
+
+	if slices.Contains(traceTypes, TraceTypeStateDiff) {
+		txResults, err := b.traceBlockTxs(block, stateDiffConfig, len(results))
+		if err != nil {
+			return nil, err
+		}
+		for i, txResult := range txResults {
+			var diff prestateDiff
+			if err := decodeTraceResult(txResult, &diff); err != nil {
+				return nil, err
+			}
+			results[i].StateDiff = stateDiffFromPrestate(diff.Pre, diff.Post)
+		}
+	}
+
+	if slices.Contains(traceTypes, TraceTypeVMTrace) {
+		txResults, err := b.traceBlockTxs(block, vmTraceConfig, len(results))
+		if err != nil {
+			return nil, err
+		}
+		for i, txResult := range txResults {
+			if err := decodeTraceResult(txResult, &results[i].VMTrace); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// FilterTraces returns the call traces in the OpenEthereum flat format of the
+// transactions within the block range that match the from and to addresses.
+// The block range defaults to the latest block, and is limited by the
+// json-rpc.block-range-cap configuration.
+func (b *Backend) FilterTraces(args rpctypes.TraceFilterArgs) ([]*rpctypes.FlatTrace, error) {
+	head, err := b.BlockNumber()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch the latest block number")
+	}
+
+	from, to := int64(head), int64(head) // #nosec G701 -- block height fits in int64
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		from = args.FromBlock.Int64()
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		to = args.ToBlock.Int64()
+	}
+
+	if to < from {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if blockLimit := int64(b.RPCBlockRangeCap()); to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+	if to > int64(head) { // #nosec G701
+		to = int64(head) // #nosec G701
+	}
+
+	var (
+		traces  = []*rpctypes.FlatTrace{}
+		skipped uint64
+	)
+	for height := from; height <= to; height++ {
+		block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		if block == nil || block.Block == nil {
+			continue
+		}
+
+		blockTraces, err := b.FlatTraceBlock(block)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if !traceMatches(trace, args.FromAddress, args.ToAddress) {
+				continue
+			}
+			if args.After != nil && skipped < *args.After {
+				skipped++
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// flatTraceBlockTxs returns the flat call traces of each transaction in the block.
+func (b *Backend) flatTraceBlockTxs(block *tmrpctypes.ResultBlock) ([][]*rpctypes.FlatTrace, error) {
+	txResults, err := b.traceBlockTxs(block, flatCallTraceConfig, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	txTraces := make([][]*rpctypes.FlatTrace, len(txResults))
+	for i, txResult := range txResults {
+		if err := decodeTraceResult(txResult, &txTraces[i]); err != nil {
+			return nil, err
+		}
+	}
+	return txTraces, nil
+}
+
+// traceBlockTxs traces the transactions in the block with the given config. It
+// fails if a transaction can't be traced or, unless negative, if the number of
+// traced transactions doesn't match the expected one.
+func (b *Backend) traceBlockTxs(block *tmrpctypes.ResultBlock, config *evmtypes.TraceConfig, expected int) ([]interface{}, error) {
+	if block.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	txResults, err := b.TraceBlock(rpctypes.BlockNumber(block.Block.Height), config, block)
+	if err != nil {
+		return nil, err
+	}
+	if expected >= 0 && len(txResults) != expected {
+		return nil, fmt.Errorf("expected %d traced transactions, got %d", expected, len(txResults))
+	}
+
+	results := make([]interface{}, len(txResults))
+	for i, txResult := range txResults {
+		if txResult.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %d in block %d: %s", i, block.Block.Height, txResult.Error)
+		}
+		results[i] = txResult.Result
+	}
+	return results, nil
+}
+
+// decodeTraceResult decodes the generic result of a tracer into the given value.
+func decodeTraceResult(result interface{}, v interface{}) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
+
+// traceMatches returns whether the sender and recipient of the call trace are
+// included in the given address lists. An empty list matches any address.
+func traceMatches(trace *rpctypes.FlatTrace, fromAddresses, toAddresses []common.Address) bool {
+	var from, to *common.Address
+	switch {
+	case trace.Action.Address != nil:
+		// selfdestruct
+		from, to = trace.Action.Address, trace.Action.RefundAddress
+	case trace.Action.Init != nil:
+		// contract creation
+		from = trace.Action.From
+		if trace.Result != nil {
+			to = trace.Result.Address
+		}
+	default:
+		from, to = trace.Action.From, trace.Action.To
+	}
+
+	return addressMatches(from, fromAddresses) && addressMatches(to, toAddresses)
+}
+
+func addressMatches(address *common.Address, addresses []common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	return address != nil && slices.Contains(addresses, *address)
+}
+
+// stateDiffFromPrestate converts the pre and post states returned by the
+// prestateTracer in diff mode to the OpenEthereum state diff format.
+func stateDiffFromPrestate(pre, post map[common.Address]*prestateAccount) map[common.Address]*rpctypes.AccountDiff {
+	stateDiff := make(map[common.Address]*rpctypes.AccountDiff)
+
+	for address, preAccount := range pre {
+		postAccount, ok := post[address]
+		if !ok {
+			// the account was deleted
+			diff := &rpctypes.AccountDiff{
+				Balance: rpctypes.RemovedDiff(preAccount.balance()),
+				Code:    rpctypes.RemovedDiff(preAccount.code()),
+				Nonce:   rpctypes.RemovedDiff(preAccount.nonce()),
+				Storage: make(map[common.Hash]interface{}),
+			}
+			for key, value := range preAccount.Storage {
+				diff.Storage[key] = rpctypes.RemovedDiff(value.Hex())
+			}
+			stateDiff[address] = diff
+			continue
+		}
+
+		diff := &rpctypes.AccountDiff{
+			Balance: rpctypes.UnchangedDiff,
+			Code:    rpctypes.UnchangedDiff,
+			Nonce:   rpctypes.UnchangedDiff,
+			Storage: make(map[common.Hash]interface{}),
+		}
+		if postAccount.Balance != nil {
+			diff.Balance = rpctypes.ChangedDiff(preAccount.balance(), postAccount.balance())
+		}
+		if postAccount.Code != nil {
+			diff.Code = rpctypes.ChangedDiff(preAccount.code(), postAccount.code())
+		}
+		if postAccount.Nonce != nil {
+			diff.Nonce = rpctypes.ChangedDiff(preAccount.nonce(), postAccount.nonce())
+		}
+		// the pre and post storages only contain the modified slots, the
+		// cleared slots are missing from the post storage
+		for key, value := range postAccount.Storage {
+			diff.Storage[key] = rpctypes.ChangedDiff(preAccount.Storage[key].Hex(), value.Hex())
+		}
+		for key, value := range preAccount.Storage {
+			if _, ok := postAccount.Storage[key]; !ok {
+				diff.Storage[key] = rpctypes.ChangedDiff(value.Hex(), common.Hash{}.Hex())
+			}
+		}
+		stateDiff[address] = diff
+	}
+
+	for address, postAccount := range post {
+		if _, ok := pre[address]; ok {
+			continue
+		}
+		// the account was created
+		diff := &rpctypes.AccountDiff{
+			Balance: rpctypes.AddedDiff(postAccount.balance()),
+			Code:    rpctypes.AddedDiff(postAccount.code()),
+			Nonce:   rpctypes.AddedDiff(postAccount.nonce()),
+			Storage: make(map[common.Hash]interface{}),
+		}
+		for key, value := range postAccount.Storage {
+			diff.Storage[key] = rpctypes.AddedDiff(value.Hex())
+		}
+		stateDiff[address] = diff
+	}
+
+	return stateDiff
+}
+
+func (a *prestateAccount) balance() string {
+	if a.Balance == nil {
+		return hexutil.EncodeBig(common.Big0)
+	}
+	return a.Balance.String()
+}
+
+func (a *prestateAccount) code() string {
+	if a.Code == nil {
+		return hexutil.Bytes{}.String()
+	}
+	return a.Code.String()
+}
+
+func (a *prestateAccount) nonce() string {
+	if a.Nonce == nil {
+		return hexutil.EncodeUint64(0)
+	}
+	return hexutil.EncodeUint64(*a.Nonce)
+}
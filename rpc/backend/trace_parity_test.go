@@ -0,0 +1,175 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/evmos/evmos/v19/rpc/backend/mocks"
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestFlatTraceBlock() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	block := types.MakeBlock(1, []types.Tx{bz}, nil, nil)
+	block.ChainID = ChainID
+	resBlock := &tmrpctypes.ResultBlock{Block: block, BlockID: block.LastBlockID}
+
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	data := fmt.Sprintf(`[{"result":[{"action":{"callType":"call","from":"%s","gas":"0x5208","input":"0x","to":"%s","value":"0x1"},`+
+		`"blockHash":null,"blockNumber":1,"result":{"gasUsed":"0x0","output":"0x"},"subtraces":0,"traceAddress":[],`+
+		`"transactionHash":"%s","transactionPosition":0,"type":"call"}]}]`,
+		from.Hex(), to.Hex(), msgEthTx.Hash)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - tx not traceable",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient.On("TraceBlock", mock.Anything, mock.Anything).
+					Return(&evmtypes.QueryTraceBlockResponse{Data: []byte(`[{"error":"execution timeout"}]`)}, nil)
+				RegisterConsensusParams(client, 1)
+			},
+			false,
+		},
+		{
+			"pass",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient.On("TraceBlock", mock.Anything, mock.MatchedBy(func(req *evmtypes.QueryTraceBlockRequest) bool {
+					return req.TraceConfig.Tracer == "flatCallTracer"
+				})).Return(&evmtypes.QueryTraceBlockResponse{Data: []byte(data)}, nil)
+				RegisterConsensusParams(client, 1)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			traces, err := suite.backend.FlatTraceBlock(resBlock)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(traces, 1)
+				suite.Require().Equal(from, *traces[0].Action.From)
+				suite.Require().Equal(to, *traces[0].Action.To)
+				suite.Require().Equal(uint64(1), *traces[0].BlockNumber)
+				suite.Require().Equal(common.HexToHash(msgEthTx.Hash), *traces[0].TransactionHash)
+				suite.Require().True(traceMatches(traces[0], []common.Address{from}, nil))
+				suite.Require().False(traceMatches(traces[0], []common.Address{to}, nil))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestReplayBlockTransactionsInvalidTraceType() {
+	_, err := suite.backend.ReplayBlockTransactions(&tmrpctypes.ResultBlock{}, []string{"trace", "invalid"})
+	suite.Require().ErrorContains(err, "invalid trace type")
+}
+
+func (suite *BackendTestSuite) TestTraceMatches() {
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	created := common.HexToAddress("0x3")
+	other := common.HexToAddress("0x4")
+
+	call := &rpctypes.FlatTrace{Action: rpctypes.FlatTraceAction{From: &from, To: &to}}
+	create := &rpctypes.FlatTrace{
+		Action: rpctypes.FlatTraceAction{From: &from, Init: &hexutil.Bytes{}},
+		Result: &rpctypes.FlatTraceResult{Address: &created},
+	}
+	suicide := &rpctypes.FlatTrace{Action: rpctypes.FlatTraceAction{Address: &created, RefundAddress: &to}}
+
+	testCases := []struct {
+		name     string
+		trace    *rpctypes.FlatTrace
+		from, to []common.Address
+		expMatch bool
+	}{
+		{"call - any address", call, nil, nil, true},
+		{"call - from and to", call, []common.Address{from}, []common.Address{other, to}, true},
+		{"call - from only", call, []common.Address{from}, []common.Address{other}, false},
+		{"call - other sender", call, []common.Address{other}, nil, false},
+		{"create - created contract", create, nil, []common.Address{created}, true},
+		{"failed create - no contract", &rpctypes.FlatTrace{Action: create.Action}, nil, []common.Address{created}, false},
+		{"suicide - refund address", suicide, []common.Address{created}, []common.Address{to}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.Require().Equal(tc.expMatch, traceMatches(tc.trace, tc.from, tc.to))
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestStateDiffFromPrestate() {
+	var (
+		modified = common.HexToAddress("0x1")
+		created  = common.HexToAddress("0x2")
+		deleted  = common.HexToAddress("0x3")
+		slot     = common.HexToHash("0x1")
+		cleared  = common.HexToHash("0x2")
+		one      = uint64(1)
+		two      = uint64(2)
+	)
+
+	pre := map[common.Address]*prestateAccount{
+		modified: {
+			Balance: (*hexutil.Big)(big.NewInt(10)),
+			Nonce:   &one,
+			Code:    &hexutil.Bytes{},
+			Storage: map[common.Hash]common.Hash{slot: common.HexToHash("0xa"), cleared: common.HexToHash("0xb")},
+		},
+		deleted: {
+			Balance: (*hexutil.Big)(big.NewInt(5)),
+			Nonce:   &one,
+			Code:    &hexutil.Bytes{0x60},
+			Storage: map[common.Hash]common.Hash{},
+		},
+	}
+	post := map[common.Address]*prestateAccount{
+		modified: {
+			Nonce:   &two,
+			Storage: map[common.Hash]common.Hash{slot: common.HexToHash("0xc")},
+		},
+		created: {
+			Balance: (*hexutil.Big)(big.NewInt(1)),
+			Code:    &hexutil.Bytes{0x60},
+		},
+	}
+
+	stateDiff := stateDiffFromPrestate(pre, post)
+	suite.Require().Len(stateDiff, 3)
+
+	suite.Require().Equal(rpctypes.UnchangedDiff, stateDiff[modified].Balance)
+	suite.Require().Equal(rpctypes.UnchangedDiff, stateDiff[modified].Code)
+	suite.Require().Equal(rpctypes.ChangedDiff("0x1", "0x2"), stateDiff[modified].Nonce)
+	suite.Require().Equal(rpctypes.ChangedDiff(common.HexToHash("0xa").Hex(), common.HexToHash("0xc").Hex()), stateDiff[modified].Storage[slot])
+	suite.Require().Equal(rpctypes.ChangedDiff(common.HexToHash("0xb").Hex(), common.Hash{}.Hex()), stateDiff[modified].Storage[cleared])
+
+	suite.Require().Equal(rpctypes.AddedDiff("0x1"), stateDiff[created].Balance)
+	suite.Require().Equal(rpctypes.AddedDiff("0x60"), stateDiff[created].Code)
+	suite.Require().Equal(rpctypes.AddedDiff("0x0"), stateDiff[created].Nonce)
+
+	suite.Require().Equal(rpctypes.RemovedDiff("0x5"), stateDiff[deleted].Balance)
+	suite.Require().Equal(rpctypes.RemovedDiff("0x60"), stateDiff[deleted].Code)
+	suite.Require().Equal(rpctypes.RemovedDiff("0x1"), stateDiff[deleted].Nonce)
+}
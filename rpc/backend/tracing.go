@@ -148,6 +148,11 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return []*evmtypes.TxTraceResult{}, nil
 	}
 
+	cacheKey, cached := b.cachedBlockTrace(block, config)
+	if cached != nil {
+		return cached, nil
+	}
+
 	txDecoder := b.clientCtx.TxConfig.TxDecoder()
 
 	var txsMessages []*evmtypes.MsgEthereumTx
@@ -207,5 +212,43 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return nil, err
 	}
 
+	b.cacheBlockTrace(cacheKey, decodedResults)
 	return decodedResults, nil
 }
+
+// cachedBlockTrace returns the key of the block trace in the trace cache, along
+// with the cached trace results if any.
+func (b *Backend) cachedBlockTrace(block *tmrpctypes.ResultBlock, config *evmtypes.TraceConfig) (string, []*evmtypes.TxTraceResult) {
+	if b.traceCache == nil {
+		return "", nil
+	}
+
+	configBz, err := json.Marshal(config)
+	if err != nil {
+		return "", nil
+	}
+	key := common.BytesToHash(block.BlockID.Hash).Hex() + string(configBz)
+
+	results, ok := b.traceCache.Get(key)
+	if !ok {
+		return key, nil
+	}
+	return key, results
+}
+
+// cacheBlockTrace adds the block trace results to the trace cache. The results
+// containing a tx error, such as a tracing timeout, are not cached.
+func (b *Backend) cacheBlockTrace(key string, results []*evmtypes.TxTraceResult) {
+	if b.traceCache == nil || key == "" {
+		return
+	}
+
+	for _, result := range results {
+		if result == nil || result.Error != "" {
+			return
+		}
+	}
+	if err := b.traceCache.Add(key, results); err != nil {
+		b.logger.Debug("failed to cache block trace", "error", err.Error())
+	}
+}
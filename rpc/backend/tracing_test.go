@@ -2,6 +2,7 @@ package backend
 
 import (
 	"fmt"
+	"time"
 
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -15,6 +16,7 @@ import (
 	"github.com/evmos/evmos/v19/indexer"
 	"github.com/evmos/evmos/v19/rpc/backend/mocks"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	"github.com/stretchr/testify/mock"
 )
 
 func (suite *BackendTestSuite) TestTraceTransaction() {
@@ -262,3 +264,55 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceBlockCache() {
+	_, bz := suite.buildEthereumTx()
+	block := types.MakeBlock(1, []types.Tx{bz}, nil, nil)
+	block.ChainID = ChainID
+	resBlock := &tmrpctypes.ResultBlock{Block: block, BlockID: block.LastBlockID}
+
+	testCases := []struct {
+		name     string
+		data     string
+		expCalls int
+	}{
+		{
+			"pass - trace served from the cache",
+			`[{"result":{"type":"CALL"}}]`,
+			1,
+		},
+		{
+			"pass - trace with a tx error is not cached",
+			`[{"error":"execution timeout"}]`,
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			traceCache, err := NewTraceCache(dbm.NewMemDB(), 10, time.Minute)
+			suite.Require().NoError(err)
+			suite.backend.traceCache = traceCache
+
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			queryClient.On("TraceBlock", mock.Anything, mock.Anything).
+				Return(&evmtypes.QueryTraceBlockResponse{Data: []byte(tc.data)}, nil)
+			RegisterConsensusParams(client, 1)
+
+			config := &evmtypes.TraceConfig{Tracer: "callTracer"}
+			for i := 0; i < 2; i++ {
+				traceResults, err := suite.backend.TraceBlock(1, config, resBlock)
+				suite.Require().NoError(err)
+				suite.Require().Len(traceResults, 1)
+			}
+			queryClient.AssertNumberOfCalls(suite.T(), "TraceBlock", tc.expCalls)
+
+			// a different tracer config is traced again
+			_, err = suite.backend.TraceBlock(1, &evmtypes.TraceConfig{Tracer: "prestateTracer"}, resBlock)
+			suite.Require().NoError(err)
+			queryClient.AssertNumberOfCalls(suite.T(), "TraceBlock", tc.expCalls+1)
+		})
+	}
+}
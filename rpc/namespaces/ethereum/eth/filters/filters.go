@@ -142,12 +142,15 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
-	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
-		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
-	}
+	// the range cap is checked before capping the range to the head, but it only
+	// applies when the log indexes can't be used
+	exceedsBlockLimit := f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit
 
 	// check bounds
 	if f.criteria.FromBlock.Int64() > head {
+		if exceedsBlockLimit {
+			return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+		}
 		return []*ethtypes.Log{}, nil
 	} else if f.criteria.ToBlock.Int64() > head+maxToOverhang {
 		f.criteria.ToBlock = big.NewInt(head + maxToOverhang)
@@ -156,7 +159,29 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
-	for height := from; height <= to; height++ {
+	// use the log indexes of the EVM indexer when available, so only the blocks
+	// containing matching logs are fetched and the block range cap only applies to
+	// the blocks that are not indexed yet.
+	var topic0s []common.Hash
+	if len(f.criteria.Topics) > 0 {
+		topic0s = f.criteria.Topics[0]
+	}
+	heights, indexed, err := f.backend.GetLogHeights(from, to, f.criteria.Addresses, topic0s)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch heights from the log indexes")
+	}
+
+	if !indexed {
+		if exceedsBlockLimit {
+			return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+		}
+		heights = make([]int64, 0, to-from+1)
+		for height := from; height <= to; height++ {
+			heights = append(heights, height)
+		}
+	}
+
+	for _, height := range heights {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
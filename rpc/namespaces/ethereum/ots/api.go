@@ -0,0 +1,74 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ots
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v19/rpc/backend"
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+)
+
+// APILevel defines the version of the Otterscan API implemented by the node.
+const APILevel = 8
+
+// API is the ots prefixed set of APIs used by the Otterscan block explorer.
+// The account transaction history requires the custom EVM indexer.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates an instance of the Otterscan API.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("api", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the version of the Otterscan API implemented by the node.
+func (api *API) GetApiLevel() uint8 { //nolint: revive,stylecheck // the name must match the ots_getApiLevel method
+	api.logger.Debug("ots_getApiLevel")
+	return APILevel
+}
+
+// HasCode returns whether the address contains code at the given block.
+func (api *API) HasCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (bool, error) {
+	api.logger.Debug("ots_hasCode", "address", address.Hex(), "block number or hash", blockNrOrHash)
+	code, err := api.backend.GetCode(address, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// GetContractCreator returns the transaction and the account that created the
+// contract, or nil if the address is not a contract.
+func (api *API) GetContractCreator(address common.Address) (*rpctypes.ContractCreator, error) {
+	api.logger.Debug("ots_getContractCreator", "address", address.Hex())
+	return api.backend.GetContractCreator(address)
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by the
+// sender with the given nonce, or nil if not found.
+func (api *API) GetTransactionBySenderAndNonce(sender common.Address, nonce hexutil.Uint64) (*common.Hash, error) {
+	api.logger.Debug("ots_getTransactionBySenderAndNonce", "sender", sender.Hex(), "nonce", nonce)
+	return api.backend.GetTransactionBySenderAndNonce(sender, uint64(nonce))
+}
+
+// SearchTransactionsBefore returns a page of the transactions sent by or to the
+// address before the given block number, starting from the latest block if zero.
+func (api *API) SearchTransactionsBefore(address common.Address, blockNumber uint64, pageSize uint16) (*rpctypes.TransactionsSearchResult, error) {
+	api.logger.Debug("ots_searchTransactionsBefore", "address", address.Hex(), "block number", blockNumber, "page size", pageSize)
+	return api.backend.SearchTransactions(address, blockNumber, pageSize, true)
+}
+
+// SearchTransactionsAfter returns a page of the transactions sent by or to the
+// address after the given block number, starting from the earliest block if zero.
+func (api *API) SearchTransactionsAfter(address common.Address, blockNumber uint64, pageSize uint16) (*rpctypes.TransactionsSearchResult, error) {
+	api.logger.Debug("ots_searchTransactionsAfter", "address", address.Hex(), "block number", blockNumber, "page size", pageSize)
+	return api.backend.SearchTransactions(address, blockNumber, pageSize, false)
+}
@@ -0,0 +1,86 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package trace
+
+import (
+	"errors"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/rpc/backend"
+	rpctypes "github.com/evmos/evmos/v19/rpc/types"
+)
+
+// API is the trace prefixed set of APIs returning the call traces of the
+// transactions in the OpenEthereum (Parity) flat format.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates an instance of the trace API.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("api", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the call traces of all the transactions in the block.
+func (api *API) Block(blockNumber rpctypes.BlockNumber) ([]*rpctypes.FlatTrace, error) {
+	api.logger.Debug("trace_block", "block number", blockNumber)
+	block, err := api.tendermintBlock(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.FlatTraceBlock(block)
+}
+
+// Transaction returns the call traces of the transaction.
+func (api *API) Transaction(hash common.Hash) ([]*rpctypes.FlatTrace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash.Hex())
+	return api.backend.FlatTraceTransaction(hash)
+}
+
+// ReplayBlockTransactions replays all the transactions in the block and returns
+// the requested trace types ("trace", "stateDiff" and "vmTrace") for each one.
+func (api *API) ReplayBlockTransactions(blockNrOrHash rpctypes.BlockNumberOrHash, traceTypes []string) ([]*rpctypes.TraceResults, error) {
+	api.logger.Debug("trace_replayBlockTransactions", "block number or hash", blockNrOrHash, "trace types", traceTypes)
+	blockNumber, err := api.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := api.tendermintBlock(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.ReplayBlockTransactions(block, traceTypes)
+}
+
+// Filter returns the call traces of the transactions within the block range
+// that match the from and to addresses.
+func (api *API) Filter(args rpctypes.TraceFilterArgs) ([]*rpctypes.FlatTrace, error) {
+	api.logger.Debug("trace_filter", "from block", args.FromBlock, "to block", args.ToBlock)
+	return api.backend.FilterTraces(args)
+}
+
+// tendermintBlock returns the block at the given height, failing for the
+// genesis block which is not traceable.
+func (api *API) tendermintBlock(blockNumber rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	if blockNumber == rpctypes.EthEarliestBlockNumber {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	block, err := api.backend.TendermintBlockByNumber(blockNumber)
+	if err != nil {
+		api.logger.Debug("get block failed", "height", blockNumber, "error", err.Error())
+		return nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, errors.New("block not found")
+	}
+	return block, nil
+}
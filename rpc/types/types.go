@@ -3,11 +3,14 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -52,6 +55,8 @@ type RPCTransaction struct {
 	V                *hexutil.Big         `json:"v"`
 	R                *hexutil.Big         `json:"r"`
 	S                *hexutil.Big         `json:"s"`
+
+	AuthorizationList []evmtypes.SetCodeAuthorizationArgs `json:"authorizationList,omitempty"`
 }
 
 // StateOverride is the collection of overridden accounts.
@@ -89,3 +94,112 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// TransactionsSearchResult represents a page of the transactions sent by or to
+// an account, as returned by the Otterscan transaction search methods.
+type TransactionsSearchResult struct {
+	Txs       []*RPCTransaction        `json:"txs"`
+	Receipts  []map[string]interface{} `json:"receipts"`
+	FirstPage bool                     `json:"firstPage"`
+	LastPage  bool                     `json:"lastPage"`
+}
+
+// ContractCreator represents the transaction and the account that created a contract.
+type ContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// FlatTrace represents a call trace in the OpenEthereum flat format, as returned
+// by the trace namespace. The block and transaction fields are omitted from the
+// replayed transaction traces.
+type FlatTrace struct {
+	Action              FlatTraceAction  `json:"action"`
+	BlockHash           *common.Hash     `json:"blockHash,omitempty"`
+	BlockNumber         *uint64          `json:"blockNumber,omitempty"`
+	Error               string           `json:"error,omitempty"`
+	Result              *FlatTraceResult `json:"result"`
+	Subtraces           int              `json:"subtraces"`
+	TraceAddress        []int            `json:"traceAddress"`
+	TransactionHash     *common.Hash     `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64          `json:"transactionPosition,omitempty"`
+	Type                string           `json:"type"`
+	// Precompile is the decoded call of a stateful precompile, along with the
+	// balance changes and Cosmos SDK events it produced
+	Precompile json.RawMessage `json:"precompile,omitempty"`
+}
+
+// FlatTraceAction represents the action of a call, create or suicide trace.
+type FlatTraceAction struct {
+	Address       *common.Address `json:"address,omitempty"`
+	Balance       *hexutil.Big    `json:"balance,omitempty"`
+	CallType      string          `json:"callType,omitempty"`
+	From          *common.Address `json:"from,omitempty"`
+	Gas           *hexutil.Uint64 `json:"gas,omitempty"`
+	Init          *hexutil.Bytes  `json:"init,omitempty"`
+	Input         *hexutil.Bytes  `json:"input,omitempty"`
+	RefundAddress *common.Address `json:"refundAddress,omitempty"`
+	To            *common.Address `json:"to,omitempty"`
+	Value         *hexutil.Big    `json:"value,omitempty"`
+}
+
+// FlatTraceResult represents the result of a call or create trace.
+type FlatTraceResult struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// TraceResults represents the replayed traces of a transaction. The fields of
+// the trace types that were not requested are null.
+type TraceResults struct {
+	Output          hexutil.Bytes                   `json:"output"`
+	StateDiff       map[common.Address]*AccountDiff `json:"stateDiff"`
+	Trace           []*FlatTrace                    `json:"trace"`
+	VMTrace         json.RawMessage                 `json:"vmTrace"`
+	TransactionHash *common.Hash                    `json:"transactionHash,omitempty"`
+}
+
+// AccountDiff represents the changes of an account in the OpenEthereum state
+// diff format. Each field is either UnchangedDiff or one of the values returned
+// by AddedDiff, RemovedDiff and ChangedDiff.
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Code    interface{}                 `json:"code"`
+	Nonce   interface{}                 `json:"nonce"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// UnchangedDiff is the state diff of an unchanged value.
+const UnchangedDiff = "="
+
+// AddedDiff returns the state diff of a value of a created account.
+func AddedDiff(value string) interface{} {
+	return map[string]string{"+": value}
+}
+
+// RemovedDiff returns the state diff of a value of a deleted account.
+func RemovedDiff(value string) interface{} {
+	return map[string]string{"-": value}
+}
+
+// ChangedDiff returns the state diff of a modified value.
+func ChangedDiff(from, to string) interface{} {
+	if from == to {
+		return UnchangedDiff
+	}
+	return map[string]map[string]string{"*": {"from": from, "to": to}}
+}
+
+// TraceFilterArgs represents the arguments of the trace_filter method. The
+// traces must match both the from and to addresses, where an empty list
+// matches any address.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
@@ -48,7 +48,7 @@ func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) ([]*evmtypes.MsgEth
 		if !ok {
 			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, &evmtypes.MsgEthereumTx{})
 		}
-		ethTx.Hash = ethTx.AsTransaction().Hash().Hex()
+		ethTx.Hash = ethTx.ComputeHash().Hex()
 		ethTxs[i] = ethTx
 	}
 	return ethTxs, nil
@@ -161,7 +161,30 @@ func NewTransactionFromMsg(
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
 	tx := msg.AsTransaction()
-	return NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	result, err := NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	// the set code transactions are represented as dynamic fee transactions by
+	// AsTransaction, so the fields derived from the encoding are replaced
+	if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+		from, _ := setCodeTx.Sender() // #nosec G703
+		result.Type = hexutil.Uint64(setCodeTx.TxType())
+		result.From = from
+		result.Hash = setCodeTx.Hash()
+		result.AuthorizationList = make([]evmtypes.SetCodeAuthorizationArgs, len(setCodeTx.AuthorizationList))
+		for i, auth := range setCodeTx.AuthorizationList {
+			result.AuthorizationList[i] = evmtypes.NewSetCodeAuthorizationArgs(auth)
+		}
+	}
+
+	return result, nil
 }
 
 // NewTransactionFromData returns a transaction that will serialize to the RPC
@@ -12,6 +12,7 @@ import (
 	"net"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -74,6 +75,7 @@ type websocketsServer struct {
 	certFile string
 	keyFile  string
 	api      *pubSubAPI
+	access   *AccessControl
 	logger   log.Logger
 }
 
@@ -87,6 +89,7 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
 		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		access:   NewAccessControl(cfg.JSONRPC),
 		logger:   logger,
 	}
 }
@@ -94,6 +97,9 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 func (s *websocketsServer) Start() {
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
+	if s.access.RequireAPIKey() {
+		ws.Handle("/{key}", s)
+	}
 
 	go func() {
 		var err error
@@ -116,6 +122,11 @@ func (s *websocketsServer) Start() {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.access.Authorized(r) {
+		http.Error(w, "missing or invalid API key", http.StatusUnauthorized)
+		return
+	}
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: func(_ *http.Request) bool {
 			return true
@@ -129,8 +140,10 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:      new(sync.Mutex),
+		conn:     conn,
+		apiKey:   APIKey(r),
+		clientID: s.access.ClientID(r),
 	})
 }
 
@@ -150,6 +163,10 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+	// apiKey and clientID are forwarded to the rpc server, so the access rules apply
+	// to the client rather than the websocket server
+	apiKey   string
+	clientID string
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -232,6 +249,11 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			continue
 		}
 
+		if (method == "eth_subscribe" || method == "eth_unsubscribe") && !s.access.MethodAllowed(method) {
+			s.sendErrResponse(wsConn, fmt.Sprintf("the method %s does not exist/is not available", method))
+			continue
+		}
+
 		switch method {
 		case "eth_subscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
@@ -318,6 +340,11 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.access.RequireAPIKey() {
+		req.Header.Set(APIKeyHeader, wsConn.apiKey)
+	} else if ip, found := strings.CutPrefix(wsConn.clientID, "ip:"); found {
+		req.Header.Set("X-Forwarded-For", ip)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -5,6 +5,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"path"
 	"time"
 
@@ -92,6 +93,19 @@ const (
 	// DefaultAllowUnprotectedTxs value is false
 	DefaultAllowUnprotectedTxs = false
 
+	// DefaultRateBurst is the default number of JSON-RPC calls a client can burst over the rate limit
+	DefaultRateBurst = 100
+
+	// DefaultExpensiveRateBurst is the default number of expensive JSON-RPC calls a client can burst
+	// over the expensive rate limit
+	DefaultExpensiveRateBurst = 10
+
+	// DefaultBatchRequestLimit is the default maximum number of calls in a JSON-RPC batch request
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultTraceCacheTTL is the default duration the block traces are kept in the trace cache
+	DefaultTraceCacheTTL = 10 * time.Minute
+
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
@@ -181,6 +195,47 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableLogIndexer defines if the custom indexer also indexes the eth tx logs by
+	// address and first topic, which speeds up the `eth_getLogs` queries.
+	EnableLogIndexer bool `mapstructure:"enable-log-indexer"`
+	// EnableInternalTxIndexer defines if the custom indexer traces the eth txs to add the
+	// internal call recipients and created contracts to the account tx history.
+	EnableInternalTxIndexer bool `mapstructure:"enable-internal-tx-indexer"`
+	// AllowedMethods defines the JSON-RPC methods that can be called, all the methods of the
+	// enabled namespaces are allowed if empty. A trailing "*" matches any method with the prefix.
+	AllowedMethods []string `mapstructure:"allowed-methods"`
+	// DeniedMethods defines the JSON-RPC methods that cannot be called, it takes precedence
+	// over AllowedMethods.
+	DeniedMethods []string `mapstructure:"denied-methods"`
+	// APIKeys defines the keys accepted in the X-API-Key header or as URL path, the JSON-RPC
+	// server doesn't require a key if empty.
+	APIKeys []string `mapstructure:"api-keys"`
+	// RateLimit defines the JSON-RPC calls per second allowed per API key or IP address (0 = unlimited).
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateBurst defines the number of JSON-RPC calls a client can burst over the rate limit.
+	RateBurst int `mapstructure:"rate-burst"`
+	// ExpensiveMethods defines the JSON-RPC methods that are also limited by the expensive rate limit.
+	ExpensiveMethods []string `mapstructure:"expensive-methods"`
+	// ExpensiveRateLimit defines the expensive JSON-RPC calls per second allowed per API key or
+	// IP address (0 = unlimited).
+	ExpensiveRateLimit float64 `mapstructure:"expensive-rate-limit"`
+	// ExpensiveRateBurst defines the number of expensive JSON-RPC calls a client can burst over
+	// the expensive rate limit.
+	ExpensiveRateBurst int `mapstructure:"expensive-rate-burst"`
+	// BatchRequestLimit defines the maximum number of calls in a batch request (0 = unlimited).
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// IPCPath defines the unix socket the JSON-RPC server listens on, relative to the node home
+	// directory if not absolute. The IPC server is disabled if empty.
+	IPCPath string `mapstructure:"ipc-path"`
+	// IPCAPI defines the JSON-RPC namespaces served over IPC, the API namespaces are served if
+	// empty. The namespaces that are only listed here are not exposed on any TCP port.
+	IPCAPI []string `mapstructure:"ipc-api"`
+	// TraceCacheSize defines the maximum number of block traces, one per block and tracer
+	// config, persisted in the node data directory to serve the repeated `debug_traceBlock*`
+	// calls (0 = disabled).
+	TraceCacheSize int `mapstructure:"trace-cache-size"`
+	// TraceCacheTTL defines the duration after which the cached block traces are evicted (0 = never).
+	TraceCacheTTL time.Duration `mapstructure:"trace-cache-ttl"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -272,7 +327,13 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "ots", "trace"}
+}
+
+// GetDefaultExpensiveMethods returns the default list of JSON-RPC methods that are rate
+// limited with the expensive budget
+func GetDefaultExpensiveMethods() []string {
+	return []string{"debug_trace*", "eth_getLogs", "eth_call"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -295,6 +356,13 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		EnableLogIndexer:         false,
+		EnableInternalTxIndexer:  false,
+		RateBurst:                DefaultRateBurst,
+		ExpensiveMethods:         GetDefaultExpensiveMethods(),
+		ExpensiveRateBurst:       DefaultExpensiveRateBurst,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		TraceCacheTTL:            DefaultTraceCacheTTL,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 	}
@@ -338,6 +406,33 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.RateLimit < 0 || c.ExpensiveRateLimit < 0 {
+		return errors.New("JSON-RPC rate limits cannot be negative")
+	}
+
+	if (c.RateLimit > 0 && c.RateBurst <= 0) || (c.ExpensiveRateLimit > 0 && c.ExpensiveRateBurst <= 0) {
+		return errors.New("JSON-RPC rate bursts must be positive when the rate limits are enabled")
+	}
+
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.TraceCacheSize < 0 {
+		return errors.New("JSON-RPC trace cache size cannot be negative")
+	}
+
+	if c.TraceCacheTTL < 0 {
+		return errors.New("JSON-RPC trace cache TTL duration cannot be negative")
+	}
+
+	for _, key := range c.APIKeys {
+		// the keys can be passed as URL path
+		if key == "" || url.PathEscape(key) != key {
+			return fmt.Errorf("invalid JSON-RPC API key '%s'", key)
+		}
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -348,9 +443,26 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
+	seenIPCAPIs := make(map[string]bool)
+	for _, api := range c.IPCAPI {
+		if seenIPCAPIs[api] {
+			return fmt.Errorf("repeated IPC API namespace '%s'", api)
+		}
+
+		seenIPCAPIs[api] = true
+	}
+
 	return nil
 }
 
+// IPCNamespaces returns the JSON-RPC namespaces served over IPC.
+func (c JSONRPCConfig) IPCNamespaces() []string {
+	if len(c.IPCAPI) == 0 {
+		return c.API
+	}
+	return c.IPCAPI
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -68,3 +68,47 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRPCConfigIPCAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ipcAPI        []string
+		expPass       bool
+		expNamespaces []string
+	}{
+		{
+			"pass - API namespaces served when empty",
+			nil,
+			true,
+			GetDefaultAPINamespaces(),
+		},
+		{
+			"pass - namespaces only served over IPC",
+			[]string{"eth", "personal", "debug"},
+			true,
+			[]string{"eth", "personal", "debug"},
+		},
+		{
+			"fail - repeated namespace",
+			[]string{"eth", "debug", "debug"},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.IPCPath = "evmos.ipc"
+			cfg.IPCAPI = tc.ipcAPI
+
+			err := cfg.Validate()
+			if !tc.expPass {
+				require.ErrorContains(t, err, "repeated IPC API namespace")
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expNamespaces, cfg.IPCNamespaces())
+		})
+	}
+}
@@ -78,6 +78,65 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableLogIndexer enables the indexing of the ethereum transaction logs by address and first topic
+# in the custom transaction indexer, so eth_getLogs only fetches the blocks with matching logs.
+# Historical blocks can be backfilled with the "index-eth-tx --log-index" command.
+enable-log-indexer = {{ .JSONRPC.EnableLogIndexer }}
+
+# EnableInternalTxIndexer enables the tracing of the ethereum transactions in the custom transaction indexer,
+# so the account transaction history served by the ots namespace includes the internal calls.
+enable-internal-tx-indexer = {{ .JSONRPC.EnableInternalTxIndexer }}
+
+# AllowedMethods defines the JSON-RPC methods that can be called, all the methods of the enabled
+# namespaces are allowed if empty. A trailing "*" matches any method with the prefix.
+# Example: "eth_*,net_version,web3_clientVersion"
+allowed-methods = "{{range $index, $elmt := .JSONRPC.AllowedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# DeniedMethods defines the JSON-RPC methods that cannot be called, it takes precedence over AllowedMethods.
+# Example: "debug_*,eth_sign"
+denied-methods = "{{range $index, $elmt := .JSONRPC.DeniedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# APIKeys defines the keys accepted in the X-API-Key header or as URL path (e.g. http://127.0.0.1:8545/<key>),
+# the JSON-RPC server doesn't require a key if empty.
+api-keys = "{{range $index, $elmt := .JSONRPC.APIKeys}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# RateLimit defines the JSON-RPC calls per second allowed per API key, or per IP address if no key
+# is used (0=unlimited). The clients above the limit get a 429 response.
+rate-limit = {{ .JSONRPC.RateLimit }}
+
+# RateBurst defines the number of JSON-RPC calls a client can burst over the rate limit.
+rate-burst = {{ .JSONRPC.RateBurst }}
+
+# ExpensiveMethods defines the JSON-RPC methods that are also limited by the expensive rate limit.
+expensive-methods = "{{range $index, $elmt := .JSONRPC.ExpensiveMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# ExpensiveRateLimit defines the expensive JSON-RPC calls per second allowed per API key or IP address (0=unlimited).
+expensive-rate-limit = {{ .JSONRPC.ExpensiveRateLimit }}
+
+# ExpensiveRateBurst defines the number of expensive JSON-RPC calls a client can burst over the expensive rate limit.
+expensive-rate-burst = {{ .JSONRPC.ExpensiveRateBurst }}
+
+# BatchRequestLimit defines the maximum number of calls in a JSON-RPC batch request (0=unlimited).
+batch-request-limit = {{ .JSONRPC.BatchRequestLimit }}
+
+# IPCPath defines the unix socket the JSON-RPC server listens on, relative to the node home directory
+# if not absolute (e.g. "data/nxqd.ipc"). The IPC server is disabled if empty.
+# The socket is only accessible by the user running the node.
+ipc-path = "{{ .JSONRPC.IPCPath }}"
+
+# IPCAPI defines a list of JSON-RPC namespaces served over IPC, the api namespaces are served if empty.
+# The namespaces only listed here, like personal and debug, are not exposed on any TCP port.
+# Example: "eth,net,web3,txpool,personal,debug"
+ipc-api = "{{range $index, $elmt := .JSONRPC.IPCAPI}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# TraceCacheSize defines the maximum number of block traces, one per block and tracer config, kept in
+# the 'tracecache' database of the node data directory to serve the repeated 'debug_traceBlock*' calls
+# without re-executing the block (0=disabled). The cached traces are kept across restarts.
+trace-cache-size = {{ .JSONRPC.TraceCacheSize }}
+
+# TraceCacheTTL defines the duration after which the cached block traces are evicted (0=never).
+trace-cache-ttl = "{{ .JSONRPC.TraceCacheTTL }}"
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -53,6 +53,16 @@ const (
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCEnableLogIndexer    = "json-rpc.enable-log-indexer"
+	JSONRPCEnableInternalTxs   = "json-rpc.enable-internal-tx-indexer"
+	JSONRPCAllowedMethods      = "json-rpc.allowed-methods"
+	JSONRPCDeniedMethods       = "json-rpc.denied-methods"
+	JSONRPCRateLimit           = "json-rpc.rate-limit"
+	JSONRPCBatchRequestLimit   = "json-rpc.batch-request-limit"
+	JSONRPCIPCPath             = "json-rpc.ipc-path"
+	JSONRPCIPCAPI              = "json-rpc.ipc-api"
+	JSONRPCTraceCacheSize      = "json-rpc.trace-cache-size"
+	JSONRPCTraceCacheTTL       = "json-rpc.trace-cache-ttl"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -3,18 +3,39 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"runtime"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
 	tmnode "github.com/cometbft/cometbft/node"
 	sm "github.com/cometbft/cometbft/state"
 	tmstore "github.com/cometbft/cometbft/store"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/metrics"
+	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 	"github.com/evmos/evmos/v19/indexer"
 )
 
+const (
+	flagLogIndex = "log-index"
+	flagFrom     = "from"
+	flagTo       = "to"
+	flagRepair   = "repair"
+	flagWorkers  = "workers"
+	flagMetrics  = "metrics-address"
+
+	// indexProgressInterval is the minimum interval between two progress logs.
+	indexProgressInterval = 10 * time.Second
+)
+
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "index-eth-tx [backward|forward]",
@@ -25,6 +46,15 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		With the --log-index flag, the logs of the eth txs are also indexed by address and first topic, and the
+		traverse starts from the first (backward) or latest (forward) block covered by the log indexes instead,
+		which allows to backfill the log indexes of a node that already indexed the eth txs.
+
+		The blocks and ABCI responses are decoded concurrently by the --workers, while the batches are still
+		written in height order. The last written block is persisted as a cursor per direction, so an interrupted
+		run resumes where it stopped. The throughput and ETA are logged periodically, and exposed as prometheus
+		metrics when --metrics-address is set.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -34,10 +64,29 @@ func NewIndexTxCmd() *cobra.Command {
 				return err
 			}
 
+			logIndex, err := cmd.Flags().GetBool(flagLogIndex)
+			if err != nil {
+				return err
+			}
+
+			workers, err := cmd.Flags().GetInt(flagWorkers)
+			if err != nil {
+				return err
+			}
+			metricsAddress, err := cmd.Flags().GetString(flagMetrics)
+			if err != nil {
+				return err
+			}
+
 			direction := args[0]
 			if direction != "backward" && direction != "forward" {
 				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
 			}
+			// the cursors of the eth txs and log indexes traverses are kept apart
+			cursor := direction
+			if logIndex {
+				cursor += "-logs"
+			}
 
 			cfg := serverCtx.Config
 			home := cfg.RootDir
@@ -47,42 +96,51 @@ func NewIndexTxCmd() *cobra.Command {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx).WithLogIndex(logIndex)
 
-			// open local tendermint db, because the local rpc won't be available.
-			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
-			if err != nil {
-				return err
+			// firstIndexed and lastIndexed return the traverse starting points, which
+			// are based on the log indexes when they are backfilled.
+			firstIndexed := idxer.FirstIndexedBlock
+			lastIndexed := idxer.LastIndexedBlock
+			if logIndex {
+				firstIndexed = func() (int64, error) {
+					first, _, err := idxer.LogIndexedRange()
+					return first, err
+				}
+				lastIndexed = func() (int64, error) {
+					_, last, err := idxer.LogIndexedRange()
+					return last, err
+				}
 			}
-			blockStore := tmstore.NewBlockStore(tmdb)
 
-			stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+			// open local tendermint db, because the local rpc won't be available.
+			blockStore, stateStore, err := openBlockStores(cfg)
 			if err != nil {
 				return err
 			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
 
-			indexBlock := func(height int64) error {
+			// the block and state stores are safe for concurrent reads
+			loadBlock := func(height int64) (*tmtypes.Block, []*abci.ResponseDeliverTx, error) {
 				blk := blockStore.LoadBlock(height)
 				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
+					return nil, nil, fmt.Errorf("block not found %d", height)
 				}
 				resBlk, err := stateStore.LoadABCIResponses(height)
 				if err != nil {
-					return err
-				}
-				if err := idxer.IndexBlock(blk, resBlk.DeliverTxs); err != nil {
-					return err
+					return nil, nil, err
 				}
-				fmt.Println(height)
-				return nil
+				return blk, resBlk.DeliverTxs, nil
+			}
+
+			resumed, err := idxer.IndexCursor(cursor)
+			if err != nil {
+				return err
 			}
 
-			switch args[0] {
+			var start, end int64
+			switch direction {
 			case "backward":
-				first, err := idxer.FirstIndexedBlock()
+				first, err := firstIndexed()
 				if err != nil {
 					return err
 				}
@@ -90,13 +148,16 @@ func NewIndexTxCmd() *cobra.Command {
 					// start from the latest block if indexer db is empty
 					first = blockStore.Height()
 				}
-				for i := first - 1; i > 0; i-- {
-					if err := indexBlock(i); err != nil {
-						return err
-					}
+				if resumed != -1 && resumed < first {
+					first = resumed
+				}
+				start, end = first-1, 1
+				if start < end {
+					logger.Info("no block to index", "direction", direction)
+					return nil
 				}
 			case "forward":
-				latest, err := idxer.LastIndexedBlock()
+				latest, err := lastIndexed()
 				if err != nil {
 					return err
 				}
@@ -104,17 +165,240 @@ func NewIndexTxCmd() *cobra.Command {
 					// start from genesis if empty
 					latest = 0
 				}
-				for i := latest + 1; i <= blockStore.Height(); i++ {
-					if err := indexBlock(i); err != nil {
+				if resumed > latest {
+					latest = resumed
+				}
+				start, end = latest+1, blockStore.Height()
+				if start > end {
+					logger.Info("no block to index", "direction", direction)
+					return nil
+				}
+			}
+
+			total := end - start + 1
+			if start > end {
+				total = start - end + 1
+			}
+			if metricsAddress != "" {
+				metrics.Enabled = true
+				ethmetricsexp.Setup(metricsAddress)
+			}
+			progress := newIndexProgress(logger, total)
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			logger.Info("indexing blocks", "direction", direction, "from", start, "to", end, "workers", workers)
+			if err := idxer.IndexBlocks(ctx, start, end, workers, cursor, loadBlock, progress.report); err != nil {
+				return err
+			}
+			progress.log(end, total)
+			return nil
+		},
+	}
+	cmd.AddCommand(newVerifyIndexTxCmd())
+	cmd.Flags().Bool(flagLogIndex, false, "Index the eth tx logs by address and first topic, and traverse from the blocks covered by the log indexes")
+	cmd.Flags().Int(flagWorkers, runtime.NumCPU(), "Number of workers decoding the blocks and ABCI responses concurrently")
+	cmd.Flags().String(flagMetrics, "", "Address to serve the indexing progress metrics on, e.g. 127.0.0.1:6065 (disabled if empty)")
+	return cmd
+}
+
+// indexProgress reports the throughput and ETA of the historical indexing.
+type indexProgress struct {
+	logger  log.Logger
+	total   int64
+	started time.Time
+	logged  time.Time
+
+	heightGauge metrics.Gauge
+	blocksGauge metrics.Gauge
+	rateGauge   metrics.GaugeFloat64
+	etaGauge    metrics.Gauge
+}
+
+func newIndexProgress(logger log.Logger, total int64) *indexProgress {
+	now := time.Now()
+	return &indexProgress{
+		logger:      logger,
+		total:       total,
+		started:     now,
+		logged:      now,
+		heightGauge: metrics.GetOrRegisterGauge("evmindexer/height", nil),
+		blocksGauge: metrics.GetOrRegisterGauge("evmindexer/blocks", nil),
+		rateGauge:   metrics.GetOrRegisterGaugeFloat64("evmindexer/blocks_per_second", nil),
+		etaGauge:    metrics.GetOrRegisterGauge("evmindexer/eta_seconds", nil),
+	}
+}
+
+// report updates the metrics after each written block, and logs the progress
+// at most once per interval.
+func (p *indexProgress) report(height, written int64) {
+	rate, eta := p.estimate(written)
+	p.heightGauge.Update(height)
+	p.blocksGauge.Update(written)
+	p.rateGauge.Update(rate)
+	p.etaGauge.Update(int64(eta.Seconds()))
+
+	if time.Since(p.logged) >= indexProgressInterval {
+		p.log(height, written)
+	}
+}
+
+// log logs the indexing progress.
+func (p *indexProgress) log(height, written int64) {
+	rate, eta := p.estimate(written)
+	p.logged = time.Now()
+	p.logger.Info(
+		"indexing progress",
+		"height", height,
+		"indexed", fmt.Sprintf("%d/%d", written, p.total),
+		"blocks/s", fmt.Sprintf("%.2f", rate),
+		"eta", eta.Round(time.Second).String(),
+	)
+}
+
+// estimate returns the blocks written per second and the remaining time.
+func (p *indexProgress) estimate(written int64) (float64, time.Duration) {
+	elapsed := time.Since(p.started).Seconds()
+	if elapsed <= 0 || written == 0 {
+		return 0, 0
+	}
+	rate := float64(written) / elapsed
+	remaining := float64(p.total-written) / rate
+	return rate, time.Duration(remaining * float64(time.Second))
+}
+
+func newVerifyIndexTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the indexed eth txs against the blockstore",
+		Long: `Verify the indexed eth txs of the blocks in the [from, to] range against the ones built from the local blockstore
+		and ABCI responses, and report the missing, extra and mismatched tx results (gas used, eth tx index, cumulative gas used).
+
+		With the --repair flag, the heights with inconsistencies are indexed again, together with the log indexes of the
+		heights they already cover.
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := cmd.Flags().GetInt64(flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagTo)
+			if err != nil {
+				return err
+			}
+			repair, err := cmd.Flags().GetBool(flagRepair)
+			if err != nil {
+				return err
+			}
+
+			cfg := serverCtx.Config
+			logger := serverCtx.Logger
+			idxDB, err := OpenIndexerDB(cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				return err
+			}
+			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+
+			// open local tendermint db, because the local rpc won't be available.
+			blockStore, stateStore, err := openBlockStores(cfg)
+			if err != nil {
+				return err
+			}
+
+			if from <= 0 {
+				from = blockStore.Base()
+			}
+			if to <= 0 {
+				to = blockStore.Height()
+			}
+			if from > to {
+				return fmt.Errorf("invalid block range, from %d is greater than to %d", from, to)
+			}
+
+			var inconsistent, repaired int
+			for height := from; height <= to; height++ {
+				blk := blockStore.LoadBlock(height)
+				if blk == nil {
+					return fmt.Errorf("block not found %d", height)
+				}
+				resBlk, err := stateStore.LoadABCIResponses(height)
+				if err != nil {
+					return err
+				}
+
+				diff, err := idxer.VerifyBlock(blk, resBlk.DeliverTxs)
+				if err != nil {
+					return err
+				}
+				if diff.IsEmpty() {
+					continue
+				}
+				inconsistent++
+				printBlockDiff(cmd, diff)
+
+				if repair {
+					if err := idxer.RepairBlock(blk, resBlk.DeliverTxs); err != nil {
 						return err
 					}
+					repaired++
 				}
-			default:
-				return fmt.Errorf("unknown direction %s", args[0])
 			}
 
+			cmd.Printf("verified blocks %d to %d: %d inconsistent, %d repaired\n", from, to, inconsistent, repaired)
 			return nil
 		},
 	}
+	cmd.Flags().Int64(flagFrom, 0, "The first block to verify, defaults to the earliest block of the blockstore")
+	cmd.Flags().Int64(flagTo, 0, "The last block to verify, defaults to the latest block of the blockstore")
+	cmd.Flags().Bool(flagRepair, false, "Index again the blocks with inconsistencies")
 	return cmd
 }
+
+// printBlockDiff reports the inconsistencies found in the indexed eth txs of a block.
+func printBlockDiff(cmd *cobra.Command, diff indexer.BlockDiff) {
+	for _, hash := range diff.Missing {
+		cmd.Printf("%d: missing tx %s\n", diff.Height, hash.Hex())
+	}
+	for _, hash := range diff.Extra {
+		cmd.Printf("%d: extra tx %s\n", diff.Height, hash.Hex())
+	}
+	for _, m := range diff.Mismatched {
+		if m.Actual == nil {
+			cmd.Printf("%d: corrupted tx result %s\n", diff.Height, m.Hash.Hex())
+			continue
+		}
+		cmd.Printf(
+			"%d: mismatched tx %s, expected {height: %d, eth_tx_index: %d, gas_used: %d, cumulative_gas_used: %d, failed: %t}, "+
+				"got {height: %d, eth_tx_index: %d, gas_used: %d, cumulative_gas_used: %d, failed: %t}\n",
+			diff.Height, m.Hash.Hex(),
+			m.Expected.Height, m.Expected.EthTxIndex, m.Expected.GasUsed, m.Expected.CumulativeGasUsed, m.Expected.Failed,
+			m.Actual.Height, m.Actual.EthTxIndex, m.Actual.GasUsed, m.Actual.CumulativeGasUsed, m.Actual.Failed,
+		)
+	}
+}
+
+// openBlockStores opens the local blockstore and state store of the node.
+func openBlockStores(cfg *tmcfg.Config) (*tmstore.BlockStore, sm.Store, error) {
+	tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, nil, err
+	}
+	stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, nil, err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	return tmstore.NewBlockStore(tmdb), stateStore, nil
+}
@@ -4,6 +4,8 @@ package server
 
 import (
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -48,30 +50,41 @@ func StartJSONRPC(ctx *server.Context,
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	// the APIs are built once for both the HTTP and IPC servers, so the
+	// services are shared
+	ipcAPIArr := []string{}
+	if config.JSONRPC.IPCPath != "" {
+		ipcAPIArr = config.JSONRPC.IPCNamespaces()
+	}
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, unionNamespaces(rpcAPIArr, ipcAPIArr))
 
-	for _, api := range apis {
-		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
-			ctx.Logger.Error(
-				"failed to register service in JSON RPC namespace",
-				"namespace", api.Namespace,
-				"service", api.Service,
-			)
-			return nil, nil, err
-		}
+	if err := registerAPIs(ctx, rpcServer, apis, rpcAPIArr); err != nil {
+		return nil, nil, err
 	}
 
+	access := rpc.NewAccessControl(config.JSONRPC)
+
 	r := mux.NewRouter()
 	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	if access.RequireAPIKey() {
+		// the API key can also be passed as URL path
+		r.HandleFunc("/{key}", rpcServer.ServeHTTP).Methods("POST")
+	}
 
 	handlerWithCors := cors.Default()
+	if access.RequireAPIKey() {
+		handlerWithCors = cors.New(cors.Options{
+			AllowedMethods: []string{http.MethodHead, http.MethodGet, http.MethodPost},
+			AllowedHeaders: []string{"Origin", "Accept", "Content-Type", "X-Requested-With", rpc.APIKeyHeader},
+		})
+	}
 	if config.API.EnableUnsafeCORS {
 		handlerWithCors = cors.AllowAll()
 	}
 
 	httpSrv := &http.Server{
 		Addr:              config.JSONRPC.Address,
-		Handler:           handlerWithCors.Handler(r),
+		Handler:           handlerWithCors.Handler(access.Handler(r)),
 		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
 		ReadTimeout:       config.JSONRPC.HTTPTimeout,
 		WriteTimeout:      config.JSONRPC.HTTPTimeout,
@@ -84,6 +97,18 @@ func StartJSONRPC(ctx *server.Context,
 		return nil, nil, err
 	}
 
+	// the IPC server is only started once the HTTP listener is open, and is
+	// stopped with the HTTP server or if it fails to boot
+	stopIPC := func() {}
+	if config.JSONRPC.IPCPath != "" {
+		stopIPC, err = startIPC(ctx, config.JSONRPC.IPCPath, apis, ipcAPIArr)
+		if err != nil {
+			_ = ln.Close() // #nosec G703
+			return nil, nil, err
+		}
+		httpSrv.RegisterOnShutdown(stopIPC)
+	}
+
 	errCh := make(chan error)
 	go func() {
 		ctx.Logger.Info("Starting JSON-RPC server", "address", config.JSONRPC.Address)
@@ -101,6 +126,7 @@ func StartJSONRPC(ctx *server.Context,
 	select {
 	case err := <-errCh:
 		ctx.Logger.Error("failed to boot JSON-RPC server", "error", err.Error())
+		stopIPC()
 		return nil, nil, err
 	case <-time.After(types.ServerStartTime): // assume JSON RPC server started successfully
 	}
@@ -113,3 +139,71 @@ func StartJSONRPC(ctx *server.Context,
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// startIPC serves the APIs of the namespaces over a unix socket, including the
+// subscriptions. It returns the function that stops the IPC server.
+func startIPC(ctx *server.Context, path string, apis []ethrpc.API, namespaces []string) (func(), error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(ctx.Config.RootDir, path)
+	}
+
+	ipcSrv := ethrpc.NewServer()
+	if err := registerAPIs(ctx, ipcSrv, apis, namespaces); err != nil {
+		return nil, err
+	}
+
+	ln, err := ListenIPC(path)
+	if err != nil {
+		ctx.Logger.Error("failed to listen on JSON-RPC IPC socket", "path", path, "error", err.Error())
+		return nil, err
+	}
+
+	ctx.Logger.Info("Starting JSON-RPC IPC server", "path", path, "namespaces", namespaces)
+	go func() {
+		// ServeListener returns once the listener is closed
+		_ = ipcSrv.ServeListener(ln) // #nosec G703
+	}()
+
+	stop := func() {
+		_ = ln.Close()      // #nosec G703
+		_ = os.Remove(path) // #nosec G703
+		ipcSrv.Stop()
+	}
+	return stop, nil
+}
+
+// registerAPIs registers the APIs of the namespaces in the rpc server
+func registerAPIs(ctx *server.Context, rpcServer *ethrpc.Server, apis []ethrpc.API, namespaces []string) error {
+	enabled := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		enabled[ns] = true
+	}
+
+	for _, api := range apis {
+		if !enabled[api.Namespace] {
+			continue
+		}
+		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
+			ctx.Logger.Error(
+				"failed to register service in JSON RPC namespace",
+				"namespace", api.Namespace,
+				"service", api.Service,
+			)
+			return err
+		}
+	}
+	return nil
+}
+
+// unionNamespaces returns the namespaces of both lists, without duplicates
+func unionNamespaces(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	union := make([]string, 0, len(a)+len(b))
+	for _, ns := range append(append([]string{}, a...), b...) {
+		if !seen[ns] {
+			seen[ns] = true
+			union = append(union, ns)
+		}
+	}
+	return union
+}
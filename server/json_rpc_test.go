@@ -0,0 +1,94 @@
+package server
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/rpc/backend/mocks"
+	"github.com/evmos/evmos/v19/server/config"
+)
+
+type testService struct{}
+
+func (testService) Ping() string { return "pong" }
+
+// rpcModules returns the namespaces served by the rpc client's server.
+func rpcModules(t *testing.T, client *ethrpc.Client) map[string]string {
+	var modules map[string]string
+	require.NoError(t, client.Call(&modules, "rpc_modules"))
+	return modules
+}
+
+func TestIPCNamespaces(t *testing.T) {
+	ctx := server.NewDefaultContext()
+	ctx.Config.RootDir = t.TempDir()
+
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.API = []string{"eth", "net"}
+	cfg.IPCAPI = []string{"eth", "personal", "debug"}
+
+	namespaces := unionNamespaces(cfg.API, cfg.IPCNamespaces())
+	require.Equal(t, []string{"eth", "net", "personal", "debug"}, namespaces)
+
+	apis := make([]ethrpc.API, 0, len(namespaces))
+	for _, ns := range namespaces {
+		apis = append(apis, ethrpc.API{Namespace: ns, Service: testService{}})
+	}
+
+	httpSrv := ethrpc.NewServer()
+	defer httpSrv.Stop()
+	require.NoError(t, registerAPIs(ctx, httpSrv, apis, cfg.API))
+
+	// the relative IPC path is resolved from the node home
+	stop, err := startIPC(ctx, "evmos.ipc", apis, cfg.IPCNamespaces())
+	require.NoError(t, err)
+	defer stop()
+
+	httpModules := rpcModules(t, ethrpc.DialInProc(httpSrv))
+	require.Contains(t, httpModules, "eth")
+	require.Contains(t, httpModules, "net")
+	require.NotContains(t, httpModules, "personal")
+	require.NotContains(t, httpModules, "debug")
+
+	ipcClient, err := ethrpc.Dial(filepath.Join(ctx.Config.RootDir, "evmos.ipc"))
+	require.NoError(t, err)
+	defer ipcClient.Close()
+
+	ipcModules := rpcModules(t, ipcClient)
+	require.Contains(t, ipcModules, "eth")
+	require.Contains(t, ipcModules, "personal")
+	require.Contains(t, ipcModules, "debug")
+	require.NotContains(t, ipcModules, "net")
+
+	var res string
+	require.NoError(t, ipcClient.Call(&res, "debug_ping"))
+	require.Equal(t, "pong", res)
+}
+
+func TestStartJSONRPCNoIPCOnListenError(t *testing.T) {
+	ctx := server.NewDefaultContext()
+	ctx.Config.RootDir = t.TempDir()
+
+	// the JSON-RPC address is already in use
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	cfg := config.DefaultConfig()
+	cfg.JSONRPC.Address = ln.Addr().String()
+	cfg.JSONRPC.IPCPath = "evmos.ipc"
+
+	_, _, err = StartJSONRPC(ctx, client.Context{}.WithChainID("evmos_9000-1").WithClient(&mocks.Client{}), "tcp://127.0.0.1:0", "/websocket", cfg, nil)
+	require.Error(t, err)
+
+	// the IPC server is not left running
+	_, err = os.Stat(filepath.Join(ctx.Config.RootDir, "evmos.ipc"))
+	require.True(t, os.IsNotExist(err))
+}
@@ -199,6 +199,16 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Enable the indexing of the eth tx logs by address and topic in the custom tx indexer")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableInternalTxs, false, "Enable the tracing of the eth txs to index the internal txs in the custom tx indexer")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAllowedMethods, nil, "Defines a list of JSON-RPC methods that can be called, a trailing * matches any method with the prefix (empty=all)") //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCDeniedMethods, nil, "Defines a list of JSON-RPC methods that cannot be called, a trailing * matches any method with the prefix")           //nolint:lll
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimit, 0, "Sets the JSON-RPC calls per second allowed per API key or IP address (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, config.DefaultBatchRequestLimit, "Sets the maximum number of calls in a JSON-RPC batch request (0=unlimited)")
+	cmd.Flags().String(srvflags.JSONRPCIPCPath, "", "the JSON-RPC unix socket path, relative to the node home if not absolute (empty=disabled)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCIPCAPI, nil, "Defines a list of JSON-RPC namespaces served over IPC (empty=same as json-rpc.api)")
+	cmd.Flags().Int(srvflags.JSONRPCTraceCacheSize, 0, "Sets the maximum number of block traces persisted in the node data directory for the repeated debug_traceBlock calls (0=disabled)") //nolint:lll
+	cmd.Flags().Duration(srvflags.JSONRPCTraceCacheTTL, config.DefaultTraceCacheTTL, "Sets the duration after which the cached block traces are evicted (0=never)")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -419,7 +429,9 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		}
 
 		idxLogger := ctx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx).
+			WithLogIndex(config.JSONRPC.EnableLogIndexer).
+			WithInternalTxs(config.JSONRPC.EnableInternalTxIndexer)
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(idxLogger)
 
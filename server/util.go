@@ -3,8 +3,11 @@
 package server
 
 import (
+	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	// TODO update import to local pkg when rpc pkg is migrated
@@ -127,3 +130,38 @@ func Listen(addr string, config *config.Config) (net.Listener, error) {
 	}
 	return ln, err
 }
+
+// maxIPCPathLength is the maximum length of a unix socket path supported by most systems.
+const maxIPCPathLength = 104
+
+// ListenIPC creates the unix socket listener of the JSON-RPC IPC server. A stale socket
+// file is removed, and the socket is only accessible by the user running the node. It
+// fails if the path exists and is not a socket, so that no other file is removed.
+func ListenIPC(path string) (net.Listener, error) {
+	if len(path) > maxIPCPathLength {
+		return nil, fmt.Errorf("IPC path is too long (%d>%d): %s", len(path), maxIPCPathLength, path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+	info, err := os.Lstat(path)
+	switch {
+	case err == nil && info.Mode()&os.ModeSocket == 0:
+		return nil, fmt.Errorf("IPC path exists and is not a socket: %s", path)
+	case err == nil:
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		_ = ln.Close()
+		return nil, err
+	}
+	return ln, nil
+}
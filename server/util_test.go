@@ -0,0 +1,83 @@
+package server
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenIPC(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(path string)
+		path     func(dir string) string
+		expPass  bool
+	}{
+		{
+			"pass - socket created in a new directory",
+			func(string) {},
+			func(dir string) string { return filepath.Join(dir, "ipc", "evmos.ipc") },
+			true,
+		},
+		{
+			"pass - stale socket removed",
+			func(path string) {
+				ln, err := net.Listen("unix", path)
+				require.NoError(t, err)
+				// keep the socket file as if the node had crashed
+				ln.(*net.UnixListener).SetUnlinkOnClose(false)
+				require.NoError(t, ln.Close())
+			},
+			func(dir string) string { return filepath.Join(dir, "evmos.ipc") },
+			true,
+		},
+		{
+			"fail - path is not a socket",
+			func(path string) {
+				require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+			},
+			func(dir string) string { return filepath.Join(dir, "evmos.ipc") },
+			false,
+		},
+		{
+			"fail - path too long",
+			func(string) {},
+			func(dir string) string { return filepath.Join(dir, strings.Repeat("a", maxIPCPathLength)) },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := tc.path(t.TempDir())
+			tc.malleate(path)
+
+			ln, err := ListenIPC(path)
+			if !tc.expPass {
+				require.Error(t, err)
+				if _, statErr := os.Lstat(path); statErr == nil {
+					// an existing file is never removed
+					bz, err := os.ReadFile(path)
+					require.NoError(t, err)
+					require.Equal(t, []byte("data"), bz)
+				}
+				return
+			}
+			require.NoError(t, err)
+			defer ln.Close()
+
+			info, err := os.Lstat(path)
+			require.NoError(t, err)
+			require.NotZero(t, info.Mode()&os.ModeSocket)
+			require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+			conn, err := net.Dial("unix", path)
+			require.NoError(t, err)
+			require.NoError(t, conn.Close())
+		})
+	}
+}
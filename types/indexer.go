@@ -19,3 +19,31 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMLogIndexer defines the interface of an eth tx indexer that also maintains
+// secondary indexes on the emitted logs by address and first topic.
+type EVMLogIndexer interface {
+	// LogIndexedRange returns -1, -1 if the log indexes are empty
+	LogIndexedRange() (int64, int64, error)
+	// GetLogHeights returns the sorted heights within [from, to] containing logs
+	// that match any of the addresses and any of the first topics.
+	GetLogHeights(from, to int64, addresses []common.Address, topic0s []common.Hash) ([]int64, error)
+}
+
+// EVMAccountTxIndexer defines the interface of an eth tx indexer that also
+// maintains the history of the eth txs sent by or to each account.
+type EVMAccountTxIndexer interface {
+	// SearchAccountTxs returns the tx hashes of the address strictly before or after
+	// the height, and whether more txs are left to search.
+	SearchAccountTxs(address common.Address, height int64, before bool, pageSize int) ([]common.Hash, bool, error)
+	// GetTxHashBySenderAndNonce returns nil if tx not found.
+	GetTxHashBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error)
+	// GetContractCreator returns nil if the contract creation is not found.
+	GetContractCreator(contract common.Address) (*ContractCreator, error)
+}
+
+// ContractCreator defines the eth tx and the account that created a contract.
+type ContractCreator struct {
+	TxHash  common.Hash
+	Creator common.Address
+}
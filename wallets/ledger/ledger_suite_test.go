@@ -18,9 +18,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	txTypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	auxTx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v19/encoding"
 	"github.com/evmos/evmos/v19/wallets/ledger"
 	"github.com/evmos/evmos/v19/wallets/ledger/mocks"
 	"github.com/evmos/evmos/v19/wallets/usbwallet"
@@ -153,3 +157,41 @@ func (suite *LedgerTestSuite) getMockTxProtobuf() []byte {
 
 	return signBytes
 }
+
+// getMockTxForMsgs returns the sign doc bytes of a transaction containing the
+// given messages, encoded for the provided sign mode.
+func (suite *LedgerTestSuite) getMockTxForMsgs(
+	signMode signing.SignMode,
+	pubKey *ethsecp256k1.PubKey,
+	msgs ...sdk.Msg,
+) []byte {
+	txConfig := encoding.MakeConfig(app.ModuleBasics).TxConfig
+
+	txBuilder := txConfig.NewTxBuilder()
+	txBuilder.SetGasLimit(200000)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 150)))
+	txBuilder.SetMemo("memo")
+
+	err := txBuilder.SetMsgs(msgs...)
+	suite.Require().NoError(err)
+
+	err = txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: 6,
+	})
+	suite.Require().NoError(err)
+
+	signerData := authsigning.SignerData{
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+		ChainID:       "evmos_9000-1",
+		AccountNumber: 0,
+		Sequence:      6,
+		PubKey:        pubKey,
+	}
+
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	suite.Require().NoError(err)
+
+	return signBytes
+}
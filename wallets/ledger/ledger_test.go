@@ -4,15 +4,29 @@
 package ledger_test
 
 import (
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gethaccounts "github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v19/encoding"
 	"github.com/evmos/evmos/v19/ethereum/eip712"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
 	"github.com/evmos/evmos/v19/wallets/accounts"
 	"github.com/evmos/evmos/v19/wallets/ledger"
+	erc20types "github.com/evmos/evmos/v19/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v19/x/feemarket/types"
+	inflationtypes "github.com/evmos/evmos/v19/x/inflation/v1/types"
+	vestingtypes "github.com/evmos/evmos/v19/x/vesting/types"
 )
 
 // Test Mnemonic:
@@ -213,6 +227,201 @@ func (suite *LedgerTestSuite) TestSignatureEquivalence() {
 	}
 }
 
+func (suite *LedgerTestSuite) TestModuleMsgSignatures() {
+	privKey, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	addr := crypto.PubkeyToAddress(privKey.PublicKey)
+	account := accounts.Account{
+		Address:   addr,
+		PublicKey: &privKey.PublicKey,
+	}
+	pubKey := &ethsecp256k1.PubKey{Key: crypto.CompressPubkey(&privKey.PublicKey)}
+
+	signer := sdk.AccAddress(addr.Bytes())
+	valAddr := sdk.ValAddress(addr.Bytes())
+	contract := utiltx.GenerateAddress()
+	coin := sdk.NewInt64Coin("aevmos", 1000)
+	periods := sdkvesting.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("aevmos", 500))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("aevmos", 500))},
+	}
+	startTime := time.Unix(1700000000, 0).UTC()
+	commission := stakingtypes.NewCommissionRates(
+		math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2),
+	)
+
+	createValidatorMsg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr,
+		suite.newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB50"),
+		coin,
+		stakingtypes.NewDescription("validator", "", "", "", ""),
+		commission,
+		math.OneInt(),
+	)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+		msgs []sdk.Msg
+	}{
+		{
+			"vesting - MsgCreateClawbackVestingAccount",
+			[]sdk.Msg{vestingtypes.NewMsgCreateClawbackVestingAccount(utiltx.GenerateAddress().Bytes(), signer, true)},
+		},
+		{
+			"vesting - MsgFundVestingAccount",
+			[]sdk.Msg{vestingtypes.NewMsgFundVestingAccount(signer, utiltx.GenerateAddress().Bytes(), startTime, periods, periods)},
+		},
+		{
+			"vesting - MsgFundVestingAccount without lockup periods",
+			[]sdk.Msg{vestingtypes.NewMsgFundVestingAccount(signer, utiltx.GenerateAddress().Bytes(), startTime, nil, periods)},
+		},
+		{
+			"vesting - MsgBatchFundVestingAccounts",
+			[]sdk.Msg{vestingtypes.NewMsgBatchFundVestingAccounts(signer, []vestingtypes.VestingGrant{
+				{
+					VestingAddress: sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+					StartTime:      startTime,
+					LockupPeriods:  periods,
+					VestingPeriods: periods,
+				},
+				{
+					VestingAddress: sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+					StartTime:      startTime,
+					VestingPeriods: periods,
+				},
+			}, false)},
+		},
+		{
+			"vesting - MsgClawback",
+			[]sdk.Msg{vestingtypes.NewMsgClawback(signer, utiltx.GenerateAddress().Bytes(), utiltx.GenerateAddress().Bytes())},
+		},
+		{
+			"vesting - MsgClawback without destination",
+			[]sdk.Msg{vestingtypes.NewMsgClawback(signer, utiltx.GenerateAddress().Bytes(), nil)},
+		},
+		{
+			"vesting - MsgUpdateVestingFunder",
+			[]sdk.Msg{vestingtypes.NewMsgUpdateVestingFunder(signer, utiltx.GenerateAddress().Bytes(), utiltx.GenerateAddress().Bytes())},
+		},
+		{
+			"vesting - MsgConvertVestingAccount",
+			[]sdk.Msg{vestingtypes.NewMsgConvertVestingAccount(signer)},
+		},
+		{
+			"vesting - MsgFundVestingAccount and MsgUpdateVestingFunder",
+			[]sdk.Msg{
+				vestingtypes.NewMsgFundVestingAccount(signer, utiltx.GenerateAddress().Bytes(), startTime, nil, periods),
+				vestingtypes.NewMsgUpdateVestingFunder(signer, utiltx.GenerateAddress().Bytes(), utiltx.GenerateAddress().Bytes()),
+			},
+		},
+		{
+			"erc20 - MsgConvertERC20",
+			[]sdk.Msg{erc20types.NewMsgConvertERC20(math.NewInt(100), signer, contract, addr)},
+		},
+		{
+			"erc20 - MsgConvertCoin",
+			[]sdk.Msg{&erc20types.MsgConvertCoin{Coin: coin, Receiver: addr.Hex(), Sender: signer.String()}},
+		},
+		{
+			"erc20 - MsgRegisterERC20",
+			[]sdk.Msg{erc20types.NewMsgRegisterERC20(signer, contract, nil)},
+		},
+		{
+			"erc20 - MsgRegisterERC20 with owner signature",
+			[]sdk.Msg{erc20types.NewMsgRegisterERC20(signer, contract, make([]byte, crypto.SignatureLength))},
+		},
+		{
+			"erc20 - MsgUpdateParams",
+			[]sdk.Msg{&erc20types.MsgUpdateParams{Authority: signer.String(), Params: erc20types.DefaultParams()}},
+		},
+		{
+			"staking - MsgCreateValidator",
+			[]sdk.Msg{createValidatorMsg},
+		},
+		{
+			"staking - MsgEditValidator",
+			[]sdk.Msg{stakingtypes.NewMsgEditValidator(valAddr, stakingtypes.NewDescription("validator", "", "", "", ""), nil, nil)},
+		},
+		{
+			"staking - MsgDelegate",
+			[]sdk.Msg{stakingtypes.NewMsgDelegate(signer, valAddr, coin)},
+		},
+		{
+			"staking - MsgUndelegate",
+			[]sdk.Msg{stakingtypes.NewMsgUndelegate(signer, valAddr, coin)},
+		},
+		{
+			"staking - MsgBeginRedelegate",
+			[]sdk.Msg{stakingtypes.NewMsgBeginRedelegate(signer, valAddr, sdk.ValAddress(utiltx.GenerateAddress().Bytes()), coin)},
+		},
+		{
+			"staking - MsgCancelUnbondingDelegation",
+			[]sdk.Msg{stakingtypes.NewMsgCancelUnbondingDelegation(signer, valAddr, 10, coin)},
+		},
+		{
+			"evm - MsgUpdateParams",
+			[]sdk.Msg{&evmtypes.MsgUpdateParams{Authority: signer.String(), Params: evmtypes.DefaultParams()}},
+		},
+		{
+			"feemarket - MsgUpdateParams",
+			[]sdk.Msg{&feemarkettypes.MsgUpdateParams{Authority: signer.String(), Params: feemarkettypes.DefaultParams()}},
+		},
+		{
+			"inflation - MsgUpdateParams",
+			[]sdk.Msg{&inflationtypes.MsgUpdateParams{Authority: signer.String(), Params: inflationtypes.DefaultParams()}},
+		},
+	}
+
+	signModes := []signing.SignMode{
+		signing.SignMode_SIGN_MODE_DIRECT,
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	}
+
+	for _, tc := range testCases {
+		for _, signMode := range signModes {
+			suite.Run(tc.name+" - "+signMode.String(), func() {
+				suite.SetupTest() // reset
+				tx := suite.getMockTxForMsgs(signMode, pubKey, tc.msgs...)
+
+				RegisterOpen(suite.mockWallet)
+				RegisterDerive(suite.mockWallet, addr, &privKey.PublicKey)
+				err := RegisterSignTypedDataWithKey(suite.mockWallet, account, privKey, tx)
+				suite.Require().NoError(err)
+
+				signature, err := suite.ledger.SignSECP256K1(gethaccounts.DefaultBaseDerivationPath, tx)
+				suite.Require().NoError(err)
+				suite.Require().True(pubKey.VerifySignature(tx, signature), "expected valid EIP-712 signature")
+			})
+		}
+	}
+}
+
+func (suite *LedgerTestSuite) TestUnsupportedMsgSignature() {
+	privKey, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	addr := crypto.PubkeyToAddress(privKey.PublicKey)
+	pubKey := &ethsecp256k1.PubKey{Key: crypto.CompressPubkey(&privKey.PublicKey)}
+
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  big.NewInt(9000),
+		Nonce:    1,
+		GasLimit: 21000,
+		To:       &addr,
+		Amount:   big.NewInt(100),
+	})
+	msg.From = addr.Hex()
+
+	suite.SetupTest() // reset
+	tx := suite.getMockTxForMsgs(signing.SignMode_SIGN_MODE_DIRECT, pubKey, msg)
+
+	RegisterOpen(suite.mockWallet)
+	RegisterDerive(suite.mockWallet, addr, &privKey.PublicKey)
+
+	_, err = suite.ledger.SignSECP256K1(gethaccounts.DefaultBaseDerivationPath, tx)
+	suite.Require().ErrorContains(err, "message type /ethermint.evm.v1.MsgEthereumTx must be signed as an Ethereum transaction")
+}
+
 func (suite *LedgerTestSuite) TestGetAddressPubKeySECP256K1() {
 	privKey, err := crypto.GenerateKey()
 	suite.Require().NoError(err)
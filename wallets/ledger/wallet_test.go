@@ -9,6 +9,8 @@ import (
 
 	gethaccounts "github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/evmos/evmos/v19/ethereum/eip712"
 	"github.com/evmos/evmos/v19/wallets/accounts"
 	"github.com/evmos/evmos/v19/wallets/ledger/mocks"
@@ -45,3 +47,32 @@ func RegisterSignTypedDataError(mockWallet *mocks.Wallet, account accounts.Accou
 	mockWallet.On("SignTypedData", account, typedData).
 		Return([]byte{}, errors.New("error generating signature, please retry"))
 }
+
+// RegisterSignTypedDataWithKey emulates a Ledger device holding the given
+// private key, which signs the EIP-712 hash of the typed data.
+func RegisterSignTypedDataWithKey(
+	mockWallet *mocks.Wallet,
+	account accounts.Account,
+	privKey *ecdsa.PrivateKey,
+	typedDataBz []byte,
+) error {
+	typedData, err := eip712.GetEIP712TypedDataForMsg(typedDataBz)
+	if err != nil {
+		return err
+	}
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return err
+	}
+
+	signature, err := crypto.Sign(sigHash, privKey)
+	if err != nil {
+		return err
+	}
+
+	mockWallet.On("SignTypedData", account, typedData).
+		Return(signature, nil)
+
+	return nil
+}
@@ -12,5 +12,3 @@ const (
 
 	ValidatorApprovalContractAddress = "0x97dEb2D3e2C48cB85aD69d91e004C1809b22b1cE"
 )
-
-``
\ No newline at end of file
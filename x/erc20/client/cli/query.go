@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
 		GetParamsCmd(),
+		GetRegistrationDepositCmd(),
 	)
 	return cmd
 }
@@ -129,3 +130,36 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetRegistrationDepositCmd queries the deposit escrowed for a permissionless
+// token pair registration
+func GetRegistrationDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registration-deposit CONTRACT_ADDRESS",
+		Short: "Gets the deposit escrowed for a permissionless token pair registration",
+		Long:  "Gets the deposit escrowed for a permissionless token pair registration",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRegistrationDepositRequest{
+				ContractAddress: args[0],
+			}
+
+			res, err := queryClient.RegistrationDeposit(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
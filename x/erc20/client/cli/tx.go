@@ -18,6 +18,7 @@ import (
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	evmostypes "github.com/evmos/evmos/v19/types"
 
@@ -36,6 +37,8 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewConvertERC20Cmd(),
+		NewRegisterERC20Cmd(),
+		NewDeregisterERC20Cmd(),
 	)
 	return txCmd
 }
@@ -87,6 +90,76 @@ func NewConvertERC20Cmd() *cobra.Command {
 	return cmd
 }
 
+// NewRegisterERC20Cmd returns a CLI command handler for registering an ERC20
+// token pair without governance
+func NewRegisterERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 CONTRACT_ADDRESS [OWNER_SIGNATURE]",
+		Short: "Register an ERC20 token pair by escrowing the registration deposit. The hex encoded owner signature [optional] is required when the sender is not the contract owner. The contract owner must hold a non-zero token balance, used to verify that transfers have no fee.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := evmostypes.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			var signature []byte
+			if len(args) == 2 {
+				signature, err = hexutil.Decode(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid owner signature %w", err)
+				}
+			}
+
+			msg := types.NewMsgRegisterERC20(cliCtx.GetFromAddress(), common.HexToAddress(contract), signature)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDeregisterERC20Cmd returns a CLI command handler for deleting an ERC20
+// token pair registered without governance
+func NewDeregisterERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-erc20 CONTRACT_ADDRESS",
+		Short: "Deregister an ERC20 token pair registered without governance and refund the registration deposit to the depositor. The sender must be the depositor or the contract owner and all the converted coins must be converted back first.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := evmostypes.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			msg := types.NewMsgDeregisterERC20(cliCtx.GetFromAddress(), common.HexToAddress(contract))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRegisterERC20ProposalCmd implements the command to submit a community-pool-spend proposal
 func NewRegisterERC20ProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
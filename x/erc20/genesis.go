@@ -34,12 +34,17 @@ func InitGenesis(
 	for _, pair := range data.TokenPairs {
 		k.SetToken(ctx, pair)
 	}
+
+	for _, deposit := range data.RegistrationDeposits {
+		k.SetRegistrationDeposit(ctx, deposit)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		TokenPairs: k.GetTokenPairs(ctx),
+		Params:               k.GetParams(ctx),
+		TokenPairs:           k.GetTokenPairs(ctx),
+		RegistrationDeposits: k.GetRegistrationDeposits(ctx),
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
 	evmostypes "github.com/evmos/evmos/v19/types"
 
 	"github.com/evmos/evmos/v19/x/erc20/types"
@@ -86,3 +87,29 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// RegistrationDeposit returns the deposit escrowed for a permissionless token
+// pair registration
+func (k Keeper) RegistrationDeposit(
+	c context.Context,
+	req *types.QueryRegistrationDepositRequest,
+) (*types.QueryRegistrationDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.ContractAddress); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid contract hex address '%s'", req.ContractAddress,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	deposit, found := k.GetRegistrationDeposit(ctx, common.HexToAddress(req.ContractAddress))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "registration deposit for contract '%s'", req.ContractAddress)
+	}
+
+	return &types.QueryRegistrationDepositResponse{Deposit: deposit}, nil
+}
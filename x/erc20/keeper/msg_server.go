@@ -4,6 +4,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 
@@ -45,7 +46,9 @@ func (k Keeper) ConvertERC20(
 		// Remove token pair if contract is suicided
 		acc := k.evmKeeper.GetAccountWithoutBalance(ctx, pair.GetERC20Contract())
 		if acc == nil || !acc.IsContract() {
-			k.DeleteTokenPair(ctx, pair)
+			if err := k.DeleteTokenPair(ctx, pair); err != nil {
+				return nil, err
+			}
 			k.Logger(ctx).Debug(
 				"deleting selfdestructed token pair from state",
 				"contract", pair.Erc20Address,
@@ -276,3 +279,131 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RegisterERC20Permissionless implements the gRPC MsgServer interface. It
+// registers a token pair for an ERC20 contract without governance when:
+//   - the sender is the contract owner or was authorized by the owner's signature
+//   - the contract passes the registration safety checks
+//   - the sender escrows the registration deposit defined in the params
+func (k Keeper) RegisterERC20Permissionless(
+	goCtx context.Context,
+	msg *types.MsgRegisterERC20,
+) (*types.MsgRegisterERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableErc20 {
+		return nil, errorsmod.Wrap(
+			types.ErrERC20Disabled, "registration is currently disabled by governance",
+		)
+	}
+
+	// Error checked during msg validation
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	owner, err := k.verifyContractOwner(ctx, contract, sender, msg.OwnerSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.verifyRegistrationSafety(ctx, contract, owner); err != nil {
+		return nil, err
+	}
+
+	deposit := sdk.Coin{}
+	if params.HasRegistrationDeposit() {
+		deposit = params.RegistrationDeposit
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{deposit}); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to escrow registration deposit")
+		}
+	}
+
+	pair, err := k.RegisterERC20(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.HasRegistrationDeposit() {
+		k.SetRegistrationDeposit(ctx, types.NewRegistrationDeposit(contract, sender, deposit))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyDeposit, deposit.String()),
+		),
+	)
+
+	return &types.MsgRegisterERC20Response{TokenPair: *pair}, nil
+}
+
+// DeregisterERC20 implements the gRPC MsgServer interface. It deletes a token
+// pair registered without governance and refunds the registration deposit to
+// the depositor when:
+//   - the token pair was registered with a deposit
+//   - the sender is the depositor or the current contract owner
+//   - no converted coins of the token pair are in circulation
+func (k Keeper) DeregisterERC20(
+	goCtx context.Context,
+	msg *types.MsgDeregisterERC20,
+) (*types.MsgDeregisterERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, contract))
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", contract,
+		)
+	}
+
+	deposit, found := k.GetRegistrationDeposit(ctx, contract)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDepositNotFound, "token pair %s was not registered with a deposit", contract,
+		)
+	}
+
+	if !sender.Equals(sdk.MustAccAddressFromBech32(deposit.Depositor)) {
+		owner, err := k.GetContractOwner(ctx, contract)
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(owner.Bytes(), sender.Bytes()) {
+			return nil, errorsmod.Wrapf(
+				types.ErrNotContractOwner, "sender %s is neither the depositor %s nor the contract owner %s",
+				msg.Sender, deposit.Depositor, owner,
+			)
+		}
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom)
+	if supply.IsPositive() {
+		return nil, errorsmod.Wrapf(
+			types.ErrOutstandingSupply, "%s must be converted back to ERC20 tokens first", supply,
+		)
+	}
+
+	if err := k.DeleteTokenPair(ctx, pair); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterERC20,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgDeregisterERC20Response{}, nil
+}
@@ -20,7 +20,9 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	dynamicPrecompiles := k.getDynamicPrecompiles(ctx)
 	nativePrecompiles := k.getNativePrecompiles(ctx)
-	return types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params = types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params.RegistrationDeposit = k.getRegistrationDepositParam(ctx)
+	return params
 }
 
 func (k Keeper) UpdateCodeHash(ctx sdk.Context, updatedDynamicPrecompiles []string) error {
@@ -60,6 +62,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	k.setERC20Enabled(ctx, params.EnableErc20)
 	k.setDynamicPrecompiles(ctx, params.DynamicPrecompiles)
 	k.setNativePrecompiles(ctx, params.NativePrecompiles)
+	k.setRegistrationDepositParam(ctx, params)
 	return nil
 }
 
@@ -119,3 +122,25 @@ func (k Keeper) getNativePrecompiles(ctx sdk.Context) (nativePrecompiles []strin
 	}
 	return nativePrecompiles
 }
+
+// setRegistrationDepositParam sets the RegistrationDeposit param in the store
+func (k Keeper) setRegistrationDepositParam(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	if !params.HasRegistrationDeposit() {
+		store.Delete(types.ParamStoreKeyRegistrationDeposit)
+		return
+	}
+	bz := k.cdc.MustMarshal(&params.RegistrationDeposit)
+	store.Set(types.ParamStoreKeyRegistrationDeposit, bz)
+}
+
+// getRegistrationDepositParam returns the RegistrationDeposit param from the store
+func (k Keeper) getRegistrationDepositParam(ctx sdk.Context) (deposit sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyRegistrationDeposit)
+	if len(bz) == 0 {
+		return deposit
+	}
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit
+}
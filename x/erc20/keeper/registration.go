@@ -0,0 +1,248 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/contracts"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+)
+
+// GetContractOwner returns the address returned by the owner() method of the
+// given contract.
+func (k Keeper) GetContractOwner(ctx sdk.Context, contract common.Address) (common.Address, error) {
+	res, err := k.evmKeeper.CallEVM(ctx, types.OwnableABI, types.ModuleAddress, contract, false, "owner")
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(
+			types.ErrNotContractOwner, "failed to query contract owner: %s", err.Error(),
+		)
+	}
+
+	unpacked, err := types.OwnableABI.Unpack("owner", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return common.Address{}, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack owner")
+	}
+
+	owner, ok := unpacked[0].(common.Address)
+	if !ok {
+		return common.Address{}, errorsmod.Wrap(types.ErrABIUnpack, "invalid owner type")
+	}
+
+	return owner, nil
+}
+
+// verifyContractOwner checks that the sender either is the owner of the
+// contract or has been authorized by the owner through a signature of the
+// registration attestation. It returns the contract owner.
+func (k Keeper) verifyContractOwner(
+	ctx sdk.Context,
+	contract common.Address,
+	sender sdk.AccAddress,
+	ownerSignature []byte,
+) (common.Address, error) {
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return common.Address{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress, "address %s is not a contract", contract,
+		)
+	}
+
+	owner, err := k.GetContractOwner(ctx, contract)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if owner == (common.Address{}) {
+		return common.Address{}, errorsmod.Wrapf(
+			types.ErrNotContractOwner, "ownership of contract %s has been renounced", contract,
+		)
+	}
+
+	if bytes.Equal(owner.Bytes(), sender.Bytes()) {
+		return owner, nil
+	}
+
+	if len(ownerSignature) == 0 {
+		return common.Address{}, errorsmod.Wrapf(
+			types.ErrNotContractOwner, "sender %s is not the contract owner %s", common.BytesToAddress(sender), owner,
+		)
+	}
+
+	attestation := types.RegistrationAttestation(ctx.ChainID(), contract, sender)
+	signer, err := types.RecoverAttestationSigner(attestation, ownerSignature)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if signer != owner {
+		return common.Address{}, errorsmod.Wrapf(
+			types.ErrNotContractOwner, "attestation signed by %s, expected contract owner %s", signer, owner,
+		)
+	}
+
+	return owner, nil
+}
+
+// verifyRegistrationSafety runs the checks an ERC20 contract has to pass to
+// be registered without governance:
+//   - the contract implements the standard ERC20 metadata methods
+//   - decimals do not exceed MaxRegistrationDecimals
+//   - transfers move exactly the requested amount (no transfer fees)
+func (k Keeper) verifyRegistrationSafety(
+	ctx sdk.Context,
+	contract, owner common.Address,
+) error {
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrUnsafeERC20, "contract does not implement the ERC20 metadata: %s", err.Error())
+	}
+
+	if erc20Data.Decimals > types.MaxRegistrationDecimals {
+		return errorsmod.Wrapf(
+			types.ErrUnsafeERC20, "decimals %d exceed maximum of %d", erc20Data.Decimals, types.MaxRegistrationDecimals,
+		)
+	}
+
+	return k.verifyNoTransferFee(ctx, contract, owner)
+}
+
+// verifyNoTransferFee transfers the full token balance of the owner to the
+// module account on a cached context and checks that the balances changed by
+// exactly the transferred amount. The cached state is always discarded. The
+// check requires the contract owner to hold a non-zero balance of the token.
+func (k Keeper) verifyNoTransferFee(
+	ctx sdk.Context,
+	contract, owner common.Address,
+) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	balanceOwner := k.BalanceOf(ctx, erc20, contract, owner)
+	if balanceOwner == nil {
+		return errorsmod.Wrap(types.ErrUnsafeERC20, "failed to retrieve owner balance")
+	}
+
+	if balanceOwner.Sign() == 0 {
+		return errorsmod.Wrapf(
+			types.ErrUnsafeERC20,
+			"contract owner %s holds no tokens: a non-zero owner balance is required to verify that transfers have no fee",
+			owner,
+		)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+
+	balanceModule := k.BalanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	if balanceModule == nil {
+		return errorsmod.Wrap(types.ErrUnsafeERC20, "failed to retrieve module balance")
+	}
+
+	res, err := k.evmKeeper.CallEVM(cacheCtx, erc20, owner, contract, true, "transfer", types.ModuleAddress, balanceOwner)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrUnsafeERC20, "test transfer failed: %s", err.Error())
+	}
+
+	var unpackedRet types.ERC20BoolResponse
+	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil || !unpackedRet.Value {
+		return errorsmod.Wrap(types.ErrUnsafeERC20, "test transfer did not return true")
+	}
+
+	balanceOwnerAfter := k.BalanceOf(cacheCtx, erc20, contract, owner)
+	balanceModuleAfter := k.BalanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	if balanceOwnerAfter == nil || balanceModuleAfter == nil {
+		return errorsmod.Wrap(types.ErrUnsafeERC20, "failed to retrieve balances after test transfer")
+	}
+
+	expModule := new(big.Int).Add(balanceModule, balanceOwner)
+	if balanceOwnerAfter.Sign() != 0 || balanceModuleAfter.Cmp(expModule) != 0 {
+		return errorsmod.Wrapf(
+			types.ErrUnsafeERC20,
+			"transfer fee detected - expected received: %v, actual: %v",
+			balanceOwner, new(big.Int).Sub(balanceModuleAfter, balanceModule),
+		)
+	}
+
+	return nil
+}
+
+// GetRegistrationDeposit returns the deposit escrowed for the registration of
+// the given ERC20 contract.
+func (k Keeper) GetRegistrationDeposit(ctx sdk.Context, contract common.Address) (types.RegistrationDeposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.RegistrationDeposit{}, false
+	}
+
+	var deposit types.RegistrationDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// GetRegistrationDeposits returns all the escrowed registration deposits.
+func (k Keeper) GetRegistrationDeposits(ctx sdk.Context) []types.RegistrationDeposit {
+	deposits := []types.RegistrationDeposit{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.RegistrationDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// SetRegistrationDeposit stores a registration deposit.
+func (k Keeper) SetRegistrationDeposit(ctx sdk.Context, deposit types.RegistrationDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	bz := k.cdc.MustMarshal(&deposit)
+	store.Set(deposit.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteRegistrationDeposit removes the registration deposit of the given contract.
+func (k Keeper) DeleteRegistrationDeposit(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	store.Delete(contract.Bytes())
+}
+
+// RefundRegistrationDeposit returns the escrowed deposit of the given contract
+// to the depositor. It is a no-op if the token pair was registered without a
+// deposit.
+func (k Keeper) RefundRegistrationDeposit(ctx sdk.Context, contract common.Address) error {
+	deposit, found := k.GetRegistrationDeposit(ctx, contract)
+	if !found {
+		return nil
+	}
+
+	depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.ModuleName, depositor, sdk.Coins{deposit.Amount},
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to refund registration deposit")
+	}
+
+	k.DeleteRegistrationDeposit(ctx, contract)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundDeposit,
+			sdk.NewAttribute(types.AttributeKeyReceiver, deposit.Depositor),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, deposit.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyDeposit, deposit.Amount.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,536 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/evmos/evmos/v19/contracts"
+	"github.com/evmos/evmos/v19/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v19/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/erc20/keeper"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+	erc20mocks "github.com/evmos/evmos/v19/x/erc20/types/mocks"
+	"github.com/evmos/evmos/v19/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// fakeOwnableERC20 emulates the EVM calls performed against an Ownable ERC20
+// contract during a permissionless registration.
+type fakeOwnableERC20 struct {
+	owner       common.Address
+	decimals    uint8
+	transferFee *big.Int
+	balances    map[common.Address]*big.Int
+}
+
+func (f *fakeOwnableERC20) callEVM(
+	_ sdk.Context, _ abi.ABI, from, _ common.Address, _ bool, method string, args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	var (
+		ret []byte
+		err error
+	)
+	switch method {
+	case "owner":
+		ret, err = types.OwnableABI.Methods[method].Outputs.Pack(f.owner)
+	case "name", "symbol":
+		ret, err = erc20.Methods[method].Outputs.Pack(erc20Name)
+	case "decimals":
+		ret, err = erc20.Methods[method].Outputs.Pack(f.decimals)
+	case "balanceOf":
+		ret, err = erc20.Methods[method].Outputs.Pack(f.balanceOf(args[0].(common.Address)))
+	case "transfer":
+		to, amount := args[0].(common.Address), args[1].(*big.Int)
+		f.balances[from] = new(big.Int).Sub(f.balanceOf(from), amount)
+		f.balances[to] = new(big.Int).Add(f.balanceOf(to), new(big.Int).Sub(amount, f.transferFee))
+		ret, err = erc20.Methods[method].Outputs.Pack(true)
+	default:
+		return nil, fmt.Errorf("unexpected method %s", method)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &evmtypes.MsgEthereumTxResponse{Ret: ret}, nil
+}
+
+func (f *fakeOwnableERC20) balanceOf(addr common.Address) *big.Int {
+	if balance, ok := f.balances[addr]; ok {
+		return balance
+	}
+	return big.NewInt(0)
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20Permissionless() {
+	var (
+		token     *fakeOwnableERC20
+		account   *statedb.Account
+		sender    sdk.AccAddress
+		signature []byte
+		deposit   sdk.Coin
+	)
+
+	contract := utiltx.GenerateAddress()
+	ownerKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	ownerECDSA, err := ownerKey.ToECDSA()
+	suite.Require().NoError(err)
+	owner := crypto.PubkeyToAddress(ownerECDSA.PublicKey)
+
+	signAttestation := func(signer *ethsecp256k1.PrivKey) []byte {
+		key, err := signer.ToECDSA()
+		suite.Require().NoError(err)
+		attestation := types.RegistrationAttestation(suite.ctx.ChainID(), contract, sender)
+		sig, err := crypto.Sign(accounts.TextHash(attestation), key)
+		suite.Require().NoError(err)
+		return sig
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expPass    bool
+		expDeposit bool
+		expErr     error
+	}{
+		{
+			"ok - sender is the contract owner",
+			func() {},
+			true,
+			true,
+			nil,
+		},
+		{
+			"ok - sender authorized by the owner signature",
+			func() {
+				sender = suite.address.Bytes()
+				signature = signAttestation(ownerKey)
+			},
+			true,
+			true,
+			nil,
+		},
+		{
+			"ok - no deposit required",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.RegistrationDeposit = sdk.Coin{}
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			true,
+			false,
+			nil,
+		},
+		{
+			"fail - erc20 module disabled",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EnableErc20 = false
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			false,
+			false,
+			types.ErrERC20Disabled,
+		},
+		{
+			"fail - address is not a contract",
+			func() {
+				account = statedb.NewEmptyAccount()
+			},
+			false,
+			false,
+			nil,
+		},
+		{
+			"fail - ownership renounced",
+			func() {
+				token.owner = common.Address{}
+			},
+			false,
+			false,
+			types.ErrNotContractOwner,
+		},
+		{
+			"fail - sender is not the owner and no signature",
+			func() {
+				sender = suite.address.Bytes()
+			},
+			false,
+			false,
+			types.ErrNotContractOwner,
+		},
+		{
+			"fail - signature not from the owner",
+			func() {
+				sender = suite.address.Bytes()
+				otherKey, err := ethsecp256k1.GenerateKey()
+				suite.Require().NoError(err)
+				signature = signAttestation(otherKey)
+			},
+			false,
+			false,
+			types.ErrNotContractOwner,
+		},
+		{
+			"fail - decimals above maximum",
+			func() {
+				token.decimals = types.MaxRegistrationDecimals + 1
+			},
+			false,
+			false,
+			types.ErrUnsafeERC20,
+		},
+		{
+			"fail - owner holds no tokens",
+			func() {
+				token.balances = map[common.Address]*big.Int{}
+			},
+			false,
+			false,
+			types.ErrUnsafeERC20,
+		},
+		{
+			"fail - transfer fee",
+			func() {
+				token.transferFee = big.NewInt(1)
+			},
+			false,
+			false,
+			types.ErrUnsafeERC20,
+		},
+		{
+			"fail - insufficient funds for the deposit",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.RegistrationDeposit = sdk.NewCoin(utils.BaseDenom, math.NewIntWithDecimal(1, 30))
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			false,
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			token = &fakeOwnableERC20{
+				owner:       owner,
+				decimals:    erc20Decimals,
+				transferFee: big.NewInt(0),
+				balances:    map[common.Address]*big.Int{owner: big.NewInt(1000)},
+			}
+			account = &statedb.Account{CodeHash: crypto.Keccak256([]byte("code")), Balance: big.NewInt(0)}
+			sender = owner.Bytes()
+			signature = nil
+			deposit = sdk.NewCoin(utils.BaseDenom, math.NewInt(1000))
+
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			params.RegistrationDeposit = deposit
+			err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, owner.Bytes(), sdk.NewCoins(deposit))
+			suite.Require().NoError(err)
+
+			mockEVMKeeper := &erc20mocks.EVMKeeper{}
+			suite.app.Erc20Keeper = keeper.NewKeeper(
+				suite.app.GetKey("erc20"), suite.app.AppCodec(),
+				authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper,
+				suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper,
+				suite.app.AuthzKeeper, &suite.app.TransferKeeper,
+			)
+			mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(
+				func(sdk.Context, common.Address) *statedb.Account { return account },
+			)
+			// CallEVM is variadic, so register the owner/metadata, balanceOf and
+			// transfer call signatures separately
+			for _, nArgs := range []int{6, 7, 8} {
+				args := make([]interface{}, nArgs)
+				for i := range args {
+					args[i] = mock.Anything
+				}
+				mockEVMKeeper.On("CallEVM", args...).Return(token.callEVM, nil)
+			}
+
+			tc.malleate()
+
+			balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, sender, utils.BaseDenom)
+
+			msg := types.NewMsgRegisterERC20(sender, contract, signature)
+			res, err := suite.app.Erc20Keeper.RegisterERC20Permissionless(sdk.WrapSDKContext(suite.ctx), msg)
+
+			storedDeposit, found := suite.app.Erc20Keeper.GetRegistrationDeposit(suite.ctx, contract)
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				if tc.expErr != nil {
+					suite.Require().ErrorIs(err, tc.expErr)
+				}
+				suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contract))
+				suite.Require().False(found)
+				return
+			}
+
+			suite.Require().NoError(err, tc.name)
+			suite.Require().True(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contract))
+			suite.Require().Equal(contract.String(), res.TokenPair.Erc20Address)
+			suite.Require().Equal(types.OWNER_EXTERNAL, res.TokenPair.ContractOwner)
+
+			balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, sender, utils.BaseDenom)
+			if tc.expDeposit {
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.AccAddress(sender).String(), storedDeposit.Depositor)
+				suite.Require().True(deposit.IsEqual(storedDeposit.Amount))
+				suite.Require().True(balanceBefore.Sub(deposit).IsEqual(balanceAfter))
+			} else {
+				suite.Require().False(found)
+				suite.Require().True(balanceBefore.IsEqual(balanceAfter))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundRegistrationDeposit() {
+	var contract common.Address
+
+	depositor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	deposit := sdk.NewCoin(utils.BaseDenom, math.NewInt(1000))
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expRefund bool
+	}{
+		{
+			"no deposit - no-op",
+			func() {},
+			false,
+		},
+		{
+			"deposit refunded",
+			func() {
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, sdk.NewCoins(deposit))
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetRegistrationDeposit(suite.ctx, types.NewRegistrationDeposit(contract, depositor, deposit))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			contract = utiltx.GenerateAddress()
+
+			tc.malleate()
+
+			err := suite.app.Erc20Keeper.RefundRegistrationDeposit(suite.ctx, contract)
+			suite.Require().NoError(err)
+
+			_, found := suite.app.Erc20Keeper.GetRegistrationDeposit(suite.ctx, contract)
+			suite.Require().False(found)
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, depositor, utils.BaseDenom)
+			if tc.expRefund {
+				suite.Require().True(deposit.IsEqual(balance))
+			} else {
+				suite.Require().True(balance.IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeregisterERC20() {
+	var (
+		contract common.Address
+		sender   sdk.AccAddress
+	)
+
+	owner := utiltx.GenerateAddress()
+	depositor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	deposit := sdk.NewCoin(utils.BaseDenom, math.NewInt(1000))
+
+	registerPair := func(withDeposit bool) types.TokenPair {
+		pair := types.NewTokenPair(contract, types.CreateDenom(contract.String()), types.OWNER_EXTERNAL)
+		suite.app.Erc20Keeper.SetToken(suite.ctx, pair)
+		if withDeposit {
+			err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, sdk.NewCoins(deposit))
+			suite.Require().NoError(err)
+			suite.app.Erc20Keeper.SetRegistrationDeposit(suite.ctx, types.NewRegistrationDeposit(contract, depositor, deposit))
+		}
+		return pair
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"fail - token pair not registered",
+			func() {
+				sender = depositor
+			},
+			types.ErrTokenPairNotFound,
+		},
+		{
+			"fail - token pair registered without deposit",
+			func() {
+				registerPair(false)
+				sender = depositor
+			},
+			types.ErrDepositNotFound,
+		},
+		{
+			"fail - sender is neither the depositor nor the contract owner",
+			func() {
+				registerPair(true)
+				sender = utiltx.GenerateAddress().Bytes()
+			},
+			types.ErrNotContractOwner,
+		},
+		{
+			"fail - converted coins in circulation",
+			func() {
+				pair := registerPair(true)
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, utiltx.GenerateAddress().Bytes(),
+					sdk.NewCoins(sdk.NewCoin(pair.Denom, math.NewInt(1))),
+				)
+				suite.Require().NoError(err)
+				sender = depositor
+			},
+			types.ErrOutstandingSupply,
+		},
+		{
+			"pass - sender is the depositor",
+			func() {
+				registerPair(true)
+				sender = depositor
+			},
+			nil,
+		},
+		{
+			"pass - sender is the contract owner",
+			func() {
+				registerPair(true)
+				sender = owner.Bytes()
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			contract = utiltx.GenerateAddress()
+			token := &fakeOwnableERC20{owner: owner}
+
+			mockEVMKeeper := &erc20mocks.EVMKeeper{}
+			suite.app.Erc20Keeper = keeper.NewKeeper(
+				suite.app.GetKey("erc20"), suite.app.AppCodec(),
+				authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper,
+				suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper,
+				suite.app.AuthzKeeper, &suite.app.TransferKeeper,
+			)
+			mockEVMKeeper.On("CallEVM", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(token.callEVM, nil)
+
+			tc.malleate()
+
+			msg := types.NewMsgDeregisterERC20(sender, contract)
+			_, err := suite.app.Erc20Keeper.DeregisterERC20(sdk.WrapSDKContext(suite.ctx), msg)
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, depositor, utils.BaseDenom)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().True(balance.IsZero())
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contract))
+			suite.Require().False(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, types.CreateDenom(contract.String())))
+
+			_, found := suite.app.Erc20Keeper.GetRegistrationDeposit(suite.ctx, contract)
+			suite.Require().False(found)
+			suite.Require().True(deposit.IsEqual(balance))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRegistrationDeposit() {
+	var (
+		req    *types.QueryRegistrationDepositRequest
+		expRes *types.QueryRegistrationDepositResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid contract address",
+			func() {
+				req = &types.QueryRegistrationDepositRequest{ContractAddress: "0xinvalid"}
+			},
+			false,
+		},
+		{
+			"deposit not found",
+			func() {
+				req = &types.QueryRegistrationDepositRequest{ContractAddress: utiltx.GenerateAddress().String()}
+			},
+			false,
+		},
+		{
+			"deposit found",
+			func() {
+				deposit := types.NewRegistrationDeposit(
+					utiltx.GenerateAddress(),
+					sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+					sdk.NewCoin(utils.BaseDenom, math.NewInt(1000)),
+				)
+				suite.app.Erc20Keeper.SetRegistrationDeposit(suite.ctx, deposit)
+
+				req = &types.QueryRegistrationDepositRequest{ContractAddress: deposit.Erc20Address}
+				expRes = &types.QueryRegistrationDepositResponse{Deposit: deposit}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			res, err := suite.queryClient.RegistrationDeposit(suite.ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
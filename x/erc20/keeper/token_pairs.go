@@ -93,12 +93,18 @@ func (k Keeper) SetTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	store.Set(key, bz)
 }
 
-// DeleteTokenPair removes a token pair.
-func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
+// DeleteTokenPair removes a token pair and refunds the deposit escrowed for its
+// registration, so that no deposit is left in escrow once the pair is removed.
+func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) error {
+	if err := k.RefundRegistrationDeposit(ctx, tokenPair.GetERC20Contract()); err != nil {
+		return err
+	}
+
 	id := tokenPair.GetID()
 	k.deleteTokenPair(ctx, id)
 	k.deleteERC20Map(ctx, tokenPair.GetERC20Contract())
 	k.deleteDenomMap(ctx, tokenPair.Denom)
+	return nil
 }
 
 // deleteTokenPair deletes the token pair for the given id.
@@ -3,8 +3,12 @@ package keeper_test
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/testutil"
 	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/utils"
 	"github.com/evmos/evmos/v19/x/erc20/types"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
 )
@@ -119,7 +123,8 @@ func (suite *KeeperTestSuite) TestDeleteTokenPair() {
 			"delete tokenpair",
 			id,
 			func() {
-				suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, pair)
+				err := suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, pair)
+				suite.Require().NoError(err)
 			},
 			false,
 		},
@@ -136,6 +141,79 @@ func (suite *KeeperTestSuite) TestDeleteTokenPair() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestDeleteTokenPairRefundsDeposit() {
+	var pair types.TokenPair
+
+	depositor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	deposit := sdk.NewCoin(utils.BaseDenom, math.NewInt(1000))
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPass   bool
+		expRefund bool
+	}{
+		{
+			"pass - registered without deposit",
+			func() {},
+			true,
+			false,
+		},
+		{
+			"pass - deposit refunded",
+			func() {
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, sdk.NewCoins(deposit))
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetRegistrationDeposit(suite.ctx, types.NewRegistrationDeposit(pair.GetERC20Contract(), depositor, deposit))
+			},
+			true,
+			true,
+		},
+		{
+			"fail - deposit not escrowed in the module account",
+			func() {
+				suite.app.Erc20Keeper.SetRegistrationDeposit(suite.ctx, types.NewRegistrationDeposit(pair.GetERC20Contract(), depositor, deposit))
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			pair = types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_EXTERNAL)
+			id := pair.GetID()
+			suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+			suite.app.Erc20Keeper.SetERC20Map(suite.ctx, pair.GetERC20Contract(), id)
+			suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, id)
+
+			tc.malleate()
+
+			err := suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, pair)
+			_, pairFound := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			_, depositFound := suite.app.Erc20Keeper.GetRegistrationDeposit(suite.ctx, pair.GetERC20Contract())
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(pairFound)
+				suite.Require().False(depositFound)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(pairFound, "token pair should not be deleted")
+				suite.Require().True(depositFound, "deposit should not be deleted")
+			}
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, depositor, utils.BaseDenom)
+			if tc.expRefund {
+				suite.Require().True(deposit.IsEqual(balance))
+			} else {
+				suite.Require().True(balance.IsZero())
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestIsTokenPairRegistered() {
 	pair := types.NewTokenPair(utiltx.GenerateAddress(), evmtypes.DefaultEVMDenom, types.OWNER_MODULE)
 	suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
@@ -177,7 +255,8 @@ func (suite *KeeperTestSuite) TestIsERC20Registered() {
 			"deleted erc20 map",
 			pair.GetERC20Contract(),
 			func() {
-				suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, pair)
+				err := suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, pair)
+				suite.Require().NoError(err)
 			},
 			false,
 		},
@@ -214,7 +293,8 @@ func (suite *KeeperTestSuite) TestIsDenomRegistered() {
 			"deleted denom map",
 			pair.GetDenom(),
 			func() {
-				suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, pair)
+				err := suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, pair)
+				suite.Require().NoError(err)
 			},
 			false,
 		},
@@ -71,5 +71,7 @@ func TestMigrate(t *testing.T) {
 
 	params := types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
 	defaultParams := types.DefaultParams()
+	// the registration deposit was introduced after the v4 migration
+	defaultParams.RegistrationDeposit = sdk.Coin{}
 	require.Equal(t, params, defaultParams)
 }
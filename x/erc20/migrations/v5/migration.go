@@ -0,0 +1,35 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+)
+
+// MigrateStore migrates the x/erc20 module state from the consensus version 4 to
+// version 5. Specifically, it sets the RegistrationDeposit parameter to its
+// default value, as the parameter reads as zero on chains created before it was
+// added, which would allow permissionless registrations without a deposit.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	if store.Has(types.ParamStoreKeyRegistrationDeposit) {
+		return nil
+	}
+
+	deposit := types.DefaultRegistrationDeposit
+	if err := types.ValidateRegistrationDeposit(deposit); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamStoreKeyRegistrationDeposit, cdc.MustMarshal(&deposit))
+	return nil
+}
@@ -0,0 +1,59 @@
+package v5_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/encoding"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/stretchr/testify/require"
+
+	v5 "github.com/evmos/evmos/v19/x/erc20/migrations/v5"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+
+	testCases := []struct {
+		name       string
+		malleate   func(store sdk.KVStore)
+		expDeposit sdk.Coin
+	}{
+		{
+			"unset deposit - set to default",
+			func(sdk.KVStore) {},
+			types.DefaultRegistrationDeposit,
+		},
+		{
+			"existing deposit - unchanged",
+			func(store sdk.KVStore) {
+				deposit := sdk.NewCoin(utils.BaseDenom, math.NewInt(1000))
+				store.Set(types.ParamStoreKeyRegistrationDeposit, cdc.MustMarshal(&deposit))
+			},
+			sdk.NewCoin(utils.BaseDenom, math.NewInt(1000)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testutil.DefaultContext(storeKey, tKey)
+			store := ctx.KVStore(storeKey)
+
+			tc.malleate(store)
+
+			require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+			var deposit sdk.Coin
+			cdc.MustUnmarshal(store.Get(types.ParamStoreKeyRegistrationDeposit), &deposit)
+			require.True(t, tc.expDeposit.IsEqual(deposit))
+		})
+	}
+}
@@ -30,6 +30,8 @@ const (
 	convertERC20Name = "evmos/MsgConvertERC20"
 	convertCoinName  = "evmos/MsgConvertCoin" // keep it for backwards compatibility when querying txs
 	updateParams     = "evmos/erc20/MsgUpdateParams"
+	registerERC20    = "evmos/erc20/MsgRegisterERC20"
+	deregisterERC20  = "evmos/erc20/MsgDeregisterERC20"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -45,6 +47,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgConvertCoin{}, // keep it for backwards compatibility when querying txs
 		&MsgConvertERC20{},
 		&MsgUpdateParams{},
+		&MsgRegisterERC20{},
+		&MsgDeregisterERC20{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -63,4 +67,6 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParams, nil)
 	cdc.RegisterConcrete(&MsgConvertERC20{}, convertERC20Name, nil)
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
+	cdc.RegisterConcrete(&MsgRegisterERC20{}, registerERC20, nil)
+	cdc.RegisterConcrete(&MsgDeregisterERC20{}, deregisterERC20, nil)
 }
@@ -5,7 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/x/bank/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -130,6 +131,71 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+// RegistrationDeposit defines the deposit escrowed on the erc20 module account
+// for a token pair that was registered without governance.
+type RegistrationDeposit struct {
+	// erc20_address is the hex address of the registered ERC20 contract
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// depositor is the bech32 address that paid the deposit and receives the refund
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount is the escrowed deposit
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *RegistrationDeposit) Reset()         { *m = RegistrationDeposit{} }
+func (m *RegistrationDeposit) String() string { return proto.CompactTextString(m) }
+func (*RegistrationDeposit) ProtoMessage()    {}
+func (*RegistrationDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+func (m *RegistrationDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistrationDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationDeposit.Merge(m, src)
+}
+func (m *RegistrationDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationDeposit proto.InternalMessageInfo
+
+func (m *RegistrationDeposit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *RegistrationDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *RegistrationDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 // Deprecated: RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin. We're keeping it to remove the existing proposals from
 // store. After that, remove this message.
@@ -139,14 +205,14 @@ type RegisterCoinProposal struct {
 	// description of the proposal
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// metadata slice of the native Cosmos coins
-	Metadata []types.Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
+	Metadata []types1.Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{1}
+	return fileDescriptor_668d5dc537f45142, []int{2}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +255,7 @@ func (m *RegisterCoinProposal) GetDescription() string {
 	return ""
 }
 
-func (m *RegisterCoinProposal) GetMetadata() []types.Metadata {
+func (m *RegisterCoinProposal) GetMetadata() []types1.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
@@ -201,14 +267,14 @@ func (m *RegisterCoinProposal) GetMetadata() []types.Metadata {
 // store. After that, remove this message.
 type ProposalMetadata struct {
 	// metadata slice of the native Cosmos coins
-	Metadata []types.Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
+	Metadata []types1.Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{2}
+	return fileDescriptor_668d5dc537f45142, []int{3}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -237,7 +303,7 @@ func (m *ProposalMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProposalMetadata proto.InternalMessageInfo
 
-func (m *ProposalMetadata) GetMetadata() []types.Metadata {
+func (m *ProposalMetadata) GetMetadata() []types1.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
@@ -259,7 +325,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{3}
+	return fileDescriptor_668d5dc537f45142, []int{4}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +391,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{4}
+	return fileDescriptor_668d5dc537f45142, []int{5}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,6 +444,7 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 func init() {
 	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
 	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
+	proto.RegisterType((*RegistrationDeposit)(nil), "evmos.erc20.v1.RegistrationDeposit")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*ProposalMetadata)(nil), "evmos.erc20.v1.ProposalMetadata")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
@@ -387,39 +454,43 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
 
 var fileDescriptor_668d5dc537f45142 = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xce, 0x74, 0xb7, 0xda, 0x9d, 0xb6, 0x21, 0x0e, 0xbb, 0x10, 0x16, 0x9a, 0x86, 0x15, 0x24,
-	0x78, 0x48, 0x9a, 0xf5, 0xa4, 0x08, 0xd2, 0xdd, 0x46, 0xa8, 0xb4, 0xbb, 0x4b, 0xba, 0x45, 0xf1,
-	0xb2, 0x4c, 0x92, 0x21, 0x86, 0xee, 0x66, 0x96, 0x99, 0x31, 0xea, 0xc1, 0xbb, 0x47, 0x2f, 0xde,
-	0x05, 0xfd, 0x63, 0x7a, 0xec, 0xd1, 0x93, 0xc8, 0xee, 0xc5, 0x3f, 0x43, 0x32, 0x93, 0x88, 0xf5,
-	0xd8, 0x5e, 0x86, 0xf7, 0x7d, 0xef, 0x07, 0xef, 0x7d, 0x6f, 0x1e, 0xec, 0x92, 0x62, 0x41, 0xb9,
-	0x47, 0x58, 0xdc, 0x3f, 0xf0, 0x0a, 0x5f, 0x19, 0xee, 0x92, 0x51, 0x41, 0x91, 0x2e, 0x7d, 0xae,
-	0xa2, 0x0a, 0xbf, 0x6b, 0xc5, 0x94, 0x97, 0xc1, 0x11, 0xce, 0x2f, 0xbc, 0xc2, 0x8f, 0x88, 0xc0,
-	0xbe, 0x04, 0x2a, 0xbe, 0xdb, 0x4e, 0x69, 0x4a, 0xa5, 0xe9, 0x95, 0x96, 0x62, 0x7b, 0xdf, 0x01,
-	0x6c, 0x4d, 0xe9, 0x05, 0xc9, 0x27, 0x38, 0x63, 0xe8, 0x3e, 0xdc, 0x95, 0xf5, 0x66, 0x38, 0x49,
-	0x18, 0xe1, 0xdc, 0x04, 0x36, 0x70, 0x5a, 0xe1, 0x8e, 0x24, 0x0f, 0x15, 0x87, 0xda, 0x70, 0x33,
-	0x21, 0x39, 0x5d, 0x98, 0x1b, 0xd2, 0xa9, 0x00, 0x32, 0xe1, 0x5d, 0x92, 0xe3, 0x68, 0x4e, 0x12,
-	0xb3, 0x61, 0x03, 0x67, 0x2b, 0xac, 0x21, 0x7a, 0x0a, 0xf5, 0x98, 0xe6, 0x82, 0xe1, 0x58, 0xcc,
-	0xe8, 0xbb, 0x9c, 0x30, 0xb3, 0x69, 0x03, 0x47, 0xef, 0x77, 0xdc, 0xeb, 0x13, 0xb8, 0xe3, 0xd2,
-	0x19, 0xee, 0xd6, 0xc1, 0x12, 0x3e, 0x69, 0xfe, 0xfe, 0xba, 0x0f, 0x7a, 0x5f, 0x00, 0x6c, 0x87,
-	0x24, 0xcd, 0xb8, 0x20, 0x6c, 0x48, 0xb3, 0x7c, 0xc2, 0xe8, 0x92, 0x72, 0x3c, 0x2f, 0x9b, 0x11,
-	0x99, 0x98, 0x93, 0xaa, 0x53, 0x05, 0x90, 0x0d, 0xb7, 0x13, 0xc2, 0x63, 0x96, 0x2d, 0x45, 0x46,
-	0xf3, 0xaa, 0xd1, 0x7f, 0x29, 0xf4, 0x0c, 0x6e, 0x2d, 0x88, 0xc0, 0x09, 0x16, 0xd8, 0x6c, 0xd8,
-	0x0d, 0x67, 0xbb, 0xbf, 0xe7, 0x2a, 0x01, 0x5d, 0xa9, 0x59, 0x25, 0xa0, 0x7b, 0x5a, 0x05, 0x0d,
-	0x9a, 0x97, 0x3f, 0xf7, 0xb5, 0xf0, 0x6f, 0x92, 0xec, 0x4b, 0xeb, 0x9d, 0x41, 0xa3, 0x6e, 0xa5,
-	0x8e, 0xbc, 0x56, 0x1a, 0xdc, 0xa0, 0x74, 0xef, 0x23, 0xec, 0xd4, 0xb3, 0x06, 0xe1, 0xb0, 0x7f,
-	0x70, 0xeb, 0x61, 0x1f, 0x40, 0x5d, 0x8a, 0x5c, 0x6d, 0x95, 0x70, 0x39, 0x72, 0x2b, 0xfc, 0x8f,
-	0xad, 0x66, 0xe2, 0x70, 0x6f, 0x4a, 0xd3, 0x74, 0x4e, 0xe4, 0xbf, 0x18, 0xd2, 0xbc, 0x20, 0x8c,
-	0x67, 0xf4, 0xf6, 0x9a, 0x97, 0x79, 0x65, 0x49, 0xf9, 0x41, 0xca, 0xbc, 0x12, 0xa8, 0x05, 0x3f,
-	0x7c, 0x01, 0x37, 0xe5, 0xbe, 0x51, 0x07, 0xde, 0x1b, 0xbf, 0x1c, 0x05, 0xe1, 0xec, 0x7c, 0x74,
-	0x36, 0x09, 0x86, 0xc7, 0xcf, 0x8f, 0x83, 0x23, 0x43, 0x43, 0x06, 0xdc, 0x51, 0xf4, 0xe9, 0xf8,
-	0xe8, 0xfc, 0x24, 0x30, 0x00, 0x42, 0x50, 0x57, 0x4c, 0xf0, 0x6a, 0x1a, 0x84, 0xa3, 0xc3, 0x13,
-	0x63, 0xa3, 0xdb, 0xfc, 0xf4, 0xcd, 0xd2, 0x06, 0x83, 0xcb, 0x95, 0x05, 0xae, 0x56, 0x16, 0xf8,
-	0xb5, 0xb2, 0xc0, 0xe7, 0xb5, 0xa5, 0x5d, 0xad, 0x2d, 0xed, 0xc7, 0xda, 0xd2, 0x5e, 0x3b, 0x69,
-	0x26, 0xde, 0xbc, 0x8d, 0xdc, 0x98, 0x2e, 0xbc, 0xea, 0xb4, 0xe4, 0x5b, 0xf8, 0x8f, 0xbd, 0xf7,
-	0xd5, 0x99, 0x89, 0x0f, 0x4b, 0xc2, 0xa3, 0x3b, 0xf2, 0x3c, 0x1e, 0xfd, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0xcd, 0xfb, 0x88, 0xaa, 0x82, 0x03, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xb1, 0x6f, 0x13, 0x3f,
+	0x18, 0x3d, 0x37, 0x69, 0x7f, 0x8d, 0xdb, 0x46, 0xf9, 0x99, 0x56, 0x3a, 0x22, 0x7a, 0x8d, 0x82,
+	0x84, 0x22, 0x86, 0xbb, 0x26, 0x0c, 0x08, 0x84, 0x84, 0x9a, 0xe4, 0x90, 0x8a, 0xda, 0x24, 0xba,
+	0xa6, 0x02, 0xb1, 0x44, 0xce, 0x9d, 0x75, 0x9c, 0x92, 0xd8, 0x91, 0xed, 0x1e, 0x30, 0xb0, 0x33,
+	0xc2, 0xc0, 0x8e, 0x04, 0x7f, 0x4c, 0xc7, 0x8e, 0x4c, 0x08, 0x25, 0x0b, 0x7f, 0x06, 0x3a, 0xdb,
+	0x49, 0x5b, 0x26, 0x44, 0x97, 0xc8, 0xdf, 0x7b, 0xcf, 0xce, 0xfb, 0x9e, 0xbf, 0x33, 0x2c, 0x93,
+	0x74, 0xc2, 0x84, 0x47, 0x78, 0xd8, 0xd8, 0xf7, 0xd2, 0xba, 0x5e, 0xb8, 0x53, 0xce, 0x24, 0x43,
+	0x45, 0xc5, 0xb9, 0x1a, 0x4a, 0xeb, 0x65, 0x27, 0x64, 0x22, 0x13, 0x0f, 0x31, 0x1d, 0x79, 0x69,
+	0x7d, 0x48, 0x24, 0xae, 0xab, 0x42, 0xeb, 0xaf, 0xf0, 0x82, 0x2c, 0xf9, 0x90, 0x25, 0xd4, 0xf0,
+	0xdb, 0x31, 0x8b, 0x99, 0x5a, 0x7a, 0xd9, 0x4a, 0xa3, 0xd5, 0x6f, 0x00, 0x16, 0xfa, 0x6c, 0x44,
+	0x68, 0x0f, 0x27, 0x1c, 0xdd, 0x85, 0x5b, 0xea, 0xff, 0x06, 0x38, 0x8a, 0x38, 0x11, 0xc2, 0x06,
+	0x15, 0x50, 0x2b, 0x04, 0x9b, 0x0a, 0x3c, 0xd0, 0x18, 0xda, 0x86, 0xab, 0x11, 0xa1, 0x6c, 0x62,
+	0xaf, 0x28, 0x52, 0x17, 0xc8, 0x86, 0xff, 0x11, 0x8a, 0x87, 0x63, 0x12, 0xd9, 0xb9, 0x0a, 0xa8,
+	0xad, 0x07, 0x8b, 0x12, 0x3d, 0x81, 0xc5, 0x90, 0x51, 0xc9, 0x71, 0x28, 0x07, 0xec, 0x0d, 0x25,
+	0xdc, 0xce, 0x57, 0x40, 0xad, 0xd8, 0xd8, 0x71, 0xaf, 0x77, 0xe8, 0x76, 0x33, 0x32, 0xd8, 0x5a,
+	0x88, 0x55, 0xf9, 0x38, 0xff, 0xeb, 0xcb, 0x1e, 0xa8, 0x7e, 0x02, 0xf0, 0x56, 0x40, 0xe2, 0x44,
+	0x48, 0x8e, 0x65, 0xc2, 0x68, 0x9b, 0x4c, 0x99, 0x48, 0xe4, 0xdf, 0x19, 0xbe, 0x03, 0x0b, 0x91,
+	0xd6, 0x33, 0x6e, 0x4c, 0x5f, 0x02, 0xe8, 0x21, 0x5c, 0xc3, 0x13, 0x76, 0x46, 0xa5, 0xf2, 0xbd,
+	0xd1, 0xb8, 0xed, 0xea, 0x20, 0xdd, 0x2c, 0x48, 0xd7, 0x04, 0xe9, 0xb6, 0x58, 0x42, 0x9b, 0xf9,
+	0xf3, 0x1f, 0x7b, 0x56, 0x60, 0xe4, 0xd5, 0xcf, 0x00, 0x6e, 0x6b, 0x4f, 0x84, 0x67, 0x74, 0x8f,
+	0xb3, 0x29, 0x13, 0x78, 0x9c, 0x05, 0x24, 0x13, 0x39, 0x26, 0xc6, 0x8c, 0x2e, 0x50, 0x05, 0x6e,
+	0x44, 0x44, 0x84, 0x3c, 0x99, 0x66, 0x0d, 0x18, 0x1f, 0x57, 0x21, 0xf4, 0x14, 0xae, 0x4f, 0x88,
+	0xc4, 0x11, 0x96, 0xd8, 0xce, 0x55, 0x72, 0xb5, 0x8d, 0xc6, 0xee, 0xa5, 0x17, 0x3a, 0x5a, 0x7a,
+	0x39, 0x36, 0x22, 0xe3, 0x67, 0xb9, 0x49, 0x65, 0x65, 0x55, 0x4f, 0x60, 0x69, 0x61, 0x65, 0xa1,
+	0xbc, 0x76, 0x34, 0xf8, 0x87, 0xa3, 0xab, 0xef, 0xe1, 0xce, 0xa2, 0x57, 0x3f, 0x68, 0x35, 0xf6,
+	0x6f, 0xdc, 0xec, 0x3d, 0x58, 0x54, 0x97, 0x64, 0x2e, 0x8e, 0x08, 0xd5, 0x72, 0x21, 0xf8, 0x03,
+	0x35, 0x3d, 0x09, 0xb8, 0xdb, 0x67, 0x71, 0x3c, 0x26, 0x6a, 0x56, 0x5b, 0x8c, 0xa6, 0x84, 0x8b,
+	0x84, 0xdd, 0x3c, 0xf3, 0x6c, 0x5f, 0x76, 0xa4, 0x9d, 0x33, 0xfb, 0xb2, 0x42, 0x0f, 0xdd, 0xfd,
+	0xe7, 0x70, 0x55, 0xcd, 0x20, 0xda, 0x81, 0xff, 0x77, 0x5f, 0x74, 0xfc, 0x60, 0x70, 0xda, 0x39,
+	0xe9, 0xf9, 0xad, 0xc3, 0x67, 0x87, 0x7e, 0xbb, 0x64, 0xa1, 0x12, 0xdc, 0xd4, 0xf0, 0x71, 0xb7,
+	0x7d, 0x7a, 0xe4, 0x97, 0x00, 0x42, 0xb0, 0xa8, 0x11, 0xff, 0x65, 0xdf, 0x0f, 0x3a, 0x07, 0x47,
+	0xa5, 0x95, 0x72, 0xfe, 0xc3, 0x57, 0xc7, 0x6a, 0x36, 0xcf, 0x67, 0x0e, 0xb8, 0x98, 0x39, 0xe0,
+	0xe7, 0xcc, 0x01, 0x1f, 0xe7, 0x8e, 0x75, 0x31, 0x77, 0xac, 0xef, 0x73, 0xc7, 0x7a, 0x55, 0x8b,
+	0x13, 0xf9, 0xfa, 0x6c, 0xe8, 0x86, 0x6c, 0xe2, 0x99, 0xe7, 0x40, 0xfd, 0xa6, 0xf5, 0x47, 0xde,
+	0x5b, 0xf3, 0x34, 0xc8, 0x77, 0x53, 0x22, 0x86, 0x6b, 0xea, 0x93, 0x7d, 0xf0, 0x7b, 0x00, 0x13,
+	0xb7, 0xec, 0x71, 0x36, 0x04, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -537,6 +608,53 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RegistrationDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistrationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -749,6 +867,25 @@ func (m *TokenPair) Size() (n int) {
 	return n
 }
 
+func (m *RegistrationDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
 func (m *RegisterCoinProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -990,6 +1127,153 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RegistrationDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1112,7 +1396,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, types.Metadata{})
+			m.Metadata = append(m.Metadata, types1.Metadata{})
 			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1196,7 +1480,7 @@ func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, types.Metadata{})
+			m.Metadata = append(m.Metadata, types1.Metadata{})
 			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
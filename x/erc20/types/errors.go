@@ -24,4 +24,8 @@ var (
 	ErrInvalidIBC               = errorsmod.Register(ModuleName, 14, "invalid IBC transaction")
 	ErrTokenPairOwnedByModule   = errorsmod.Register(ModuleName, 15, "token pair owned by module")
 	ErrNativeConversionDisabled = errorsmod.Register(ModuleName, 16, "native coins manual conversion is disabled")
+	ErrNotContractOwner         = errorsmod.Register(ModuleName, 17, "sender is not authorized by the contract owner")
+	ErrUnsafeERC20              = errorsmod.Register(ModuleName, 18, "erc20 contract failed registration safety checks")
+	ErrDepositNotFound          = errorsmod.Register(ModuleName, 19, "registration deposit not found")
+	ErrOutstandingSupply        = errorsmod.Register(ModuleName, 20, "token pair has converted coins in circulation")
 )
@@ -15,11 +15,14 @@ const (
 	EventTypeRegisterERC20          = "register_erc20"
 	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
 	EventTypeRegisterERC20Extension = "register_erc20_extension"
+	EventTypeRefundDeposit          = "refund_registration_deposit"
+	EventTypeDeregisterERC20        = "deregister_erc20"
 
 	AttributeCoinSourceChannel = "source_channel"
 	AttributeKeyCosmosCoin     = "cosmos_coin"
 	AttributeKeyERC20Token     = "erc20_token" // #nosec
 	AttributeKeyReceiver       = "receiver"
+	AttributeKeyDeposit        = "deposit"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
@@ -58,6 +58,23 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	seenDeposit := make(map[string]bool)
+	for _, d := range gs.RegistrationDeposits {
+		if seenDeposit[d.Erc20Address] {
+			return fmt.Errorf("registration deposit duplicated on genesis '%s'", d.Erc20Address)
+		}
+
+		if err := d.Validate(); err != nil {
+			return err
+		}
+
+		if !seenErc20[d.Erc20Address] {
+			return fmt.Errorf("registration deposit for unregistered token pair '%s'", d.Erc20Address)
+		}
+
+		seenDeposit[d.Erc20Address] = true
+	}
+
 	// Check if params are valid
 	if err := gs.Params.Validate(); err != nil {
 		return fmt.Errorf("invalid params on genesis: %w", err)
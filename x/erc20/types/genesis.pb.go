@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -29,6 +30,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// registration_deposits is a slice of the deposits escrowed for permissionless
+	// token pair registrations at genesis
+	RegistrationDeposits []RegistrationDeposit `protobuf:"bytes,3,rep,name=registration_deposits,json=registrationDeposits,proto3" json:"registration_deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +82,13 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetRegistrationDeposits() []RegistrationDeposit {
+	if m != nil {
+		return m.RegistrationDeposits
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -88,6 +99,9 @@ type Params struct {
 	// dynamic_precompiles defines the slice of hex addresses of the
 	// active precompiles that are used to interact with Bank coins as ERC20s
 	DynamicPrecompiles []string `protobuf:"bytes,4,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// registration_deposit defines the deposit that has to be escrowed to register
+	// an ERC20 token pair without governance. A zero deposit disables the escrow.
+	RegistrationDeposit types.Coin `protobuf:"bytes,5,opt,name=registration_deposit,json=registrationDeposit,proto3" json:"registration_deposit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -144,6 +158,13 @@ func (m *Params) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetRegistrationDeposit() types.Coin {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
@@ -152,27 +173,33 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x14, 0xc7, 0x9b, 0x75, 0x8c, 0x99, 0x0e, 0xd1, 0x28, 0x32, 0x87, 0xd4, 0xb9, 0x53, 0x2f, 0x36,
-	0x6e, 0x7a, 0xf1, 0x26, 0x03, 0x11, 0x3c, 0x8d, 0xe9, 0xc9, 0xcb, 0xc8, 0xea, 0xa3, 0x06, 0xd7,
-	0x26, 0x24, 0xb1, 0xb8, 0x2f, 0xe0, 0x59, 0xbf, 0xd5, 0x8e, 0x3b, 0x7a, 0x12, 0xd9, 0xbe, 0x88,
-	0x2c, 0xa9, 0xe8, 0x76, 0x29, 0x8f, 0xff, 0xef, 0xf7, 0x7f, 0x0d, 0x0f, 0x1f, 0x41, 0x91, 0x09,
-	0x4d, 0x41, 0x25, 0xbd, 0x33, 0x5a, 0x74, 0x69, 0x0a, 0x39, 0x68, 0xae, 0x63, 0xa9, 0x84, 0x11,
-	0x64, 0xdb, 0xd2, 0xd8, 0xd2, 0xb8, 0xe8, 0xb6, 0x5a, 0x1b, 0xb6, 0x03, 0xd6, 0x6d, 0xed, 0xa7,
-	0x22, 0x15, 0x76, 0xa4, 0xab, 0xc9, 0xa5, 0x9d, 0x37, 0x84, 0x1b, 0x37, 0x6e, 0xe7, 0x9d, 0x61,
-	0x06, 0xc8, 0x05, 0xae, 0x49, 0xa6, 0x58, 0xa6, 0x9b, 0xa8, 0x8d, 0xa2, 0xa0, 0x77, 0x10, 0xaf,
-	0xff, 0x23, 0x1e, 0x58, 0xda, 0xaf, 0xce, 0xbe, 0x8e, 0xbd, 0x61, 0xe9, 0x92, 0x2b, 0x1c, 0x18,
-	0xf1, 0x0c, 0xf9, 0x48, 0x32, 0xae, 0x74, 0xb3, 0xd2, 0xf6, 0xa3, 0xa0, 0x77, 0xb8, 0x59, 0xbd,
-	0x5f, 0x29, 0x03, 0xc6, 0x55, 0xd9, 0xc6, 0xe6, 0x37, 0xd0, 0x9d, 0x0f, 0x84, 0x6b, 0x6e, 0x35,
-	0x39, 0xc1, 0x0d, 0xc8, 0xd9, 0x78, 0x02, 0x23, 0xdb, 0xb4, 0x0f, 0xa9, 0x0f, 0x03, 0x97, 0x5d,
-	0xaf, 0x22, 0x72, 0x8a, 0x49, 0xce, 0x0c, 0x2f, 0x60, 0x24, 0x15, 0x24, 0x22, 0x93, 0x7c, 0x02,
-	0xba, 0xe9, 0xb7, 0xfd, 0x68, 0x6b, 0xb8, 0xeb, 0xc8, 0xe0, 0x0f, 0x10, 0x8a, 0xf7, 0x1e, 0xa7,
-	0x39, 0xcb, 0x78, 0xb2, 0xe6, 0x57, 0xad, 0x4f, 0x4a, 0xf4, 0xaf, 0x70, 0x5b, 0xad, 0x57, 0x76,
-	0xfc, 0x7e, 0x7f, 0xb6, 0x08, 0xd1, 0x7c, 0x11, 0xa2, 0xef, 0x45, 0x88, 0xde, 0x97, 0xa1, 0x37,
-	0x5f, 0x86, 0xde, 0xe7, 0x32, 0xf4, 0x1e, 0xa2, 0x94, 0x9b, 0xa7, 0x97, 0x71, 0x9c, 0x88, 0x8c,
-	0x96, 0x37, 0xb7, 0xdf, 0xa2, 0x7b, 0x49, 0x5f, 0xcb, 0xfb, 0x9b, 0xa9, 0x04, 0x3d, 0xae, 0xd9,
-	0x3b, 0x9f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x49, 0x9a, 0x0a, 0xc9, 0x01, 0x00, 0x00,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x86, 0x93, 0x6d, 0xa9, 0x16, 0x67, 0x85, 0xc0, 0x5b, 0x50, 0xb6, 0x42, 0x61, 0x29, 0x97,
+	0x5e, 0xb0, 0x49, 0xe1, 0xc2, 0x0d, 0x15, 0x10, 0x12, 0xa7, 0x2a, 0x70, 0xe2, 0x40, 0xe4, 0xa4,
+	0xa3, 0x60, 0xd1, 0xd8, 0x91, 0x6d, 0x22, 0xfa, 0x16, 0x3c, 0x56, 0x8f, 0x3d, 0x72, 0x42, 0xa8,
+	0x15, 0x3c, 0x07, 0x8a, 0x6d, 0x44, 0x5b, 0x7a, 0x89, 0x46, 0xf3, 0x7f, 0xf3, 0x67, 0xc6, 0x33,
+	0xe8, 0x3e, 0xb4, 0xb5, 0xd4, 0x14, 0x54, 0x39, 0x7d, 0x42, 0xdb, 0x94, 0x56, 0x20, 0x40, 0x73,
+	0x4d, 0x1a, 0x25, 0x8d, 0xc4, 0xb7, 0xac, 0x4a, 0xac, 0x4a, 0xda, 0x74, 0x94, 0x94, 0x52, 0x77,
+	0x78, 0xc1, 0x34, 0xd0, 0x36, 0x2d, 0xc0, 0xb0, 0x94, 0x96, 0x92, 0x0b, 0xc7, 0x8f, 0x46, 0x47,
+	0x6e, 0xae, 0xd0, 0x69, 0xc3, 0x4a, 0x56, 0xd2, 0x86, 0xb4, 0x8b, 0x5c, 0x76, 0xfc, 0x3b, 0x44,
+	0x17, 0x6f, 0xdc, 0x3f, 0xdf, 0x19, 0x66, 0x00, 0x3f, 0x43, 0x83, 0x86, 0x29, 0x56, 0xeb, 0x38,
+	0xbc, 0x0e, 0x27, 0xd1, 0xf4, 0x1e, 0x39, 0xec, 0x81, 0xcc, 0xad, 0x3a, 0xeb, 0xaf, 0x7f, 0x3c,
+	0x08, 0x32, 0xcf, 0xe2, 0x17, 0x28, 0x32, 0xf2, 0x33, 0x88, 0xbc, 0x61, 0x5c, 0xe9, 0xf8, 0xec,
+	0xba, 0x37, 0x89, 0xa6, 0x57, 0xc7, 0xa5, 0xef, 0x3b, 0x64, 0xce, 0xb8, 0xf2, 0xd5, 0xc8, 0xfc,
+	0x4d, 0x68, 0xfc, 0x11, 0xdd, 0x55, 0x50, 0x71, 0x6d, 0x14, 0x33, 0x5c, 0x8a, 0x7c, 0x01, 0x8d,
+	0xd4, 0xdc, 0xe8, 0xb8, 0x67, 0xbd, 0x1e, 0x1d, 0x7b, 0x65, 0x7b, 0xf0, 0x2b, 0xc7, 0x7a, 0xd7,
+	0xa1, 0xfa, 0x5f, 0xd2, 0xe3, 0x5f, 0x21, 0x1a, 0xb8, 0xd6, 0xf1, 0x43, 0x74, 0x01, 0x82, 0x15,
+	0x4b, 0xc8, 0xad, 0x9b, 0x1d, 0xf4, 0x3c, 0x8b, 0x5c, 0xee, 0x75, 0x97, 0xc2, 0x8f, 0x11, 0x16,
+	0xcc, 0xf0, 0x16, 0xf2, 0x46, 0x41, 0x29, 0xeb, 0x86, 0x2f, 0xc1, 0xb5, 0x72, 0x33, 0xbb, 0xe3,
+	0x94, 0xf9, 0x3f, 0x01, 0x53, 0x74, 0xb9, 0x58, 0x09, 0x56, 0xf3, 0xf2, 0x80, 0xef, 0x5b, 0x1e,
+	0x7b, 0x69, 0xbf, 0x20, 0x43, 0xc3, 0x53, 0xd3, 0xc6, 0x37, 0xec, 0x9b, 0x5f, 0x11, 0xb7, 0x67,
+	0xd2, 0xed, 0x99, 0xf8, 0x3d, 0x93, 0x97, 0x92, 0x0b, 0x3f, 0xe2, 0xe5, 0x89, 0x11, 0xdf, 0xf6,
+	0xcf, 0xcf, 0x6e, 0xf7, 0x66, 0xb3, 0xf5, 0x36, 0x09, 0x37, 0xdb, 0x24, 0xfc, 0xb9, 0x4d, 0xc2,
+	0x6f, 0xbb, 0x24, 0xd8, 0xec, 0x92, 0xe0, 0xfb, 0x2e, 0x09, 0x3e, 0x4c, 0x2a, 0x6e, 0x3e, 0x7d,
+	0x29, 0x48, 0x29, 0x6b, 0xea, 0xef, 0xc4, 0x7e, 0xdb, 0xf4, 0x39, 0xfd, 0xea, 0x6f, 0xc6, 0xac,
+	0x1a, 0xd0, 0xc5, 0xc0, 0xde, 0xc6, 0xd3, 0x3f, 0x03, 0x00, 0x21, 0xdb, 0xaa, 0xf1, 0x9d, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +222,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RegistrationDeposits) > 0 {
+		for iNdEx := len(m.RegistrationDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -242,6 +283,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.RegistrationDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -298,6 +349,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RegistrationDeposits) > 0 {
+		for _, e := range m.RegistrationDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -322,6 +379,8 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.RegistrationDeposit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -427,6 +486,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposits = append(m.RegistrationDeposits, RegistrationDeposit{})
+			if err := m.RegistrationDeposits[len(m.RegistrationDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -561,6 +654,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistrationDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
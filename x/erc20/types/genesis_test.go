@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
 	"github.com/evmos/evmos/v19/utils"
 	"github.com/evmos/evmos/v19/x/erc20/types"
 	"github.com/stretchr/testify/suite"
@@ -20,6 +22,8 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	depositor := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+
 	newGen := types.NewGenesisState(types.DefaultParams(), types.DefaultTokenPairs)
 
 	testCases := []struct {
@@ -169,6 +173,71 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with registration deposit",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Depositor:    depositor,
+						Amount:       types.DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - registration deposit for unregistered token pair",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    depositor,
+						Amount:       types.DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated registration deposit",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Depositor:    depositor,
+						Amount:       types.DefaultRegistrationDeposit,
+					},
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Depositor:    depositor,
+						Amount:       types.DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid registration deposit depositor",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Depositor:    "invalid",
+						Amount:       types.DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
@@ -33,12 +33,14 @@ const (
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
 	prefixSTRv2Addresses
+	prefixRegistrationDeposit
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixTokenPair        = []byte{prefixTokenPair}
-	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
-	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
-	KeyPrefixSTRv2Addresses   = []byte{prefixSTRv2Addresses}
+	KeyPrefixTokenPair           = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20    = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom    = []byte{prefixTokenPairByDenom}
+	KeyPrefixSTRv2Addresses      = []byte{prefixSTRv2Addresses}
+	KeyPrefixRegistrationDeposit = []byte{prefixRegistrationDeposit}
 )
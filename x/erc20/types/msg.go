@@ -11,16 +11,23 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var (
 	_ sdk.Msg = &MsgConvertERC20{}
 	_ sdk.Msg = &MsgConvertCoin{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRegisterERC20{}
+	_ sdk.Msg = &MsgDeregisterERC20{}
 )
 
 const (
-	TypeMsgConvertERC20 = "convert_ERC20"
+	TypeMsgConvertERC20    = "convert_ERC20"
+	TypeMsgConvertCoin     = "convert_coin"
+	TypeMsgRegisterERC20   = "register_ERC20"
+	TypeMsgDeregisterERC20 = "deregister_ERC20"
+	TypeMsgUpdateParams    = "update_params"
 )
 
 // NewMsgConvertERC20 creates a new instance of MsgConvertERC20
@@ -68,6 +75,12 @@ func (msg MsgConvertERC20) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr.Bytes()}
 }
 
+// Route returns the name of the module
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.Authority)
@@ -88,6 +101,12 @@ func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
 
+// Route should return the name of the module
+func (msg MsgConvertCoin) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertCoin) Type() string { return TypeMsgConvertCoin }
+
 // ValidateBasic runs stateless checks on the message
 func (msg MsgConvertCoin) ValidateBasic() error {
 	if err := ValidateErc20Denom(msg.Coin.Denom); err != nil {
@@ -119,3 +138,82 @@ func (msg MsgConvertCoin) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(msg.Sender)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgRegisterERC20 creates a new instance of MsgRegisterERC20
+func NewMsgRegisterERC20(sender sdk.AccAddress, contract common.Address, ownerSignature []byte) *MsgRegisterERC20 { //nolint: interfacer
+	return &MsgRegisterERC20{
+		Sender:          sender.String(),
+		ContractAddress: contract.String(),
+		OwnerSignature:  ownerSignature,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterERC20) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterERC20) Type() string { return TypeMsgRegisterERC20 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterERC20) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if len(msg.OwnerSignature) != 0 && len(msg.OwnerSignature) != crypto.SignatureLength {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest, "invalid owner signature length, expected %d, got %d",
+			crypto.SignatureLength, len(msg.OwnerSignature),
+		)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterERC20) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgDeregisterERC20 creates a new instance of MsgDeregisterERC20
+func NewMsgDeregisterERC20(sender sdk.AccAddress, contract common.Address) *MsgDeregisterERC20 { //nolint: interfacer
+	return &MsgDeregisterERC20{
+		Sender:          sender.String(),
+		ContractAddress: contract.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDeregisterERC20) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDeregisterERC20) Type() string { return TypeMsgDeregisterERC20 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDeregisterERC20) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeregisterERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeregisterERC20) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
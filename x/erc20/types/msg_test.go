@@ -167,3 +167,124 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20Getters() {
+	msgInvalid := types.MsgRegisterERC20{}
+	msg := types.NewMsgRegisterERC20(
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		utiltx.GenerateAddress(),
+		nil,
+	)
+	suite.Require().Equal(types.RouterKey, msg.Route())
+	suite.Require().Equal(types.TypeMsgRegisterERC20, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20() {
+	testCases := []struct {
+		msg        string
+		sender     string
+		contract   string
+		signature  []byte
+		expectPass bool
+	}{
+		{
+			"msg register erc20 - invalid sender",
+			"invalid",
+			utiltx.GenerateAddress().String(),
+			nil,
+			false,
+		},
+		{
+			"msg register erc20 - invalid contract",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			"0xinvalid",
+			nil,
+			false,
+		},
+		{
+			"msg register erc20 - invalid signature length",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			utiltx.GenerateAddress().String(),
+			[]byte{0x01, 0x02},
+			false,
+		},
+		{
+			"msg register erc20 - pass without signature",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			utiltx.GenerateAddress().String(),
+			nil,
+			true,
+		},
+		{
+			"msg register erc20 - pass with signature",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			utiltx.GenerateAddress().String(),
+			make([]byte, 65),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := types.MsgRegisterERC20{tc.sender, tc.contract, tc.signature}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDeregisterERC20Getters() {
+	msgInvalid := types.MsgDeregisterERC20{}
+	msg := types.NewMsgDeregisterERC20(
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		utiltx.GenerateAddress(),
+	)
+	suite.Require().Equal(types.RouterKey, msg.Route())
+	suite.Require().Equal(types.TypeMsgDeregisterERC20, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgDeregisterERC20() {
+	testCases := []struct {
+		msg        string
+		sender     string
+		contract   string
+		expectPass bool
+	}{
+		{
+			"msg deregister erc20 - invalid sender",
+			"invalid",
+			utiltx.GenerateAddress().String(),
+			false,
+		},
+		{
+			"msg deregister erc20 - invalid contract",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			"0xinvalid",
+			false,
+		},
+		{
+			"msg deregister erc20 - pass",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			utiltx.GenerateAddress().String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := types.MsgDeregisterERC20{tc.sender, tc.contract}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
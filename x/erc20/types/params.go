@@ -8,8 +8,11 @@ import (
 	"fmt"
 	"slices"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v19/types"
+	"github.com/evmos/evmos/v19/utils"
 )
 
 const (
@@ -21,14 +24,18 @@ const (
 
 // Parameter store key
 var (
-	ParamStoreKeyEnableErc20        = []byte("EnableErc20")
-	ParamStoreKeyDynamicPrecompiles = []byte("DynamicPrecompiles")
-	ParamStoreKeyNativePrecompiles  = []byte("NativePrecompiles")
+	ParamStoreKeyEnableErc20         = []byte("EnableErc20")
+	ParamStoreKeyDynamicPrecompiles  = []byte("DynamicPrecompiles")
+	ParamStoreKeyNativePrecompiles   = []byte("NativePrecompiles")
+	ParamStoreKeyRegistrationDeposit = []byte("RegistrationDeposit")
 	// DefaultNativePrecompiles defines the default precompiles for the wrapped native coin
 	// NOTE: If you modify this, make sure you modify it on the local_node genesis script as well
 	DefaultNativePrecompiles = []string{WEVMOSContractMainnet}
 	// DefaultDynamicPrecompiles defines the default active dynamic precompiles
 	DefaultDynamicPrecompiles []string
+	// DefaultRegistrationDeposit defines the default deposit for permissionless
+	// token pair registrations (100 NXQ)
+	DefaultRegistrationDeposit = sdk.NewCoin(utils.BaseDenom, math.NewIntWithDecimal(100, 18))
 )
 
 // NewParams creates a new Params object
@@ -48,9 +55,10 @@ func NewParams(
 
 func DefaultParams() Params {
 	return Params{
-		EnableErc20:        true,
-		NativePrecompiles:  DefaultNativePrecompiles,
-		DynamicPrecompiles: DefaultDynamicPrecompiles,
+		EnableErc20:         true,
+		NativePrecompiles:   DefaultNativePrecompiles,
+		DynamicPrecompiles:  DefaultDynamicPrecompiles,
+		RegistrationDeposit: DefaultRegistrationDeposit,
 	}
 }
 
@@ -80,7 +88,35 @@ func (p Params) Validate() error {
 
 	combined := dpAddrs
 	combined = append(combined, npAddrs...)
-	return validatePrecompilesUniqueness(combined)
+	if err := validatePrecompilesUniqueness(combined); err != nil {
+		return err
+	}
+
+	return ValidateRegistrationDeposit(p.RegistrationDeposit)
+}
+
+// ValidateRegistrationDeposit checks that the registration deposit is either
+// empty, which disables the escrow, or a valid coin.
+func ValidateRegistrationDeposit(i interface{}) error {
+	deposit, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid registration deposit type: %T", i)
+	}
+
+	if deposit.Denom == "" && (deposit.Amount.IsNil() || deposit.Amount.IsZero()) {
+		return nil
+	}
+
+	if err := deposit.Validate(); err != nil {
+		return fmt.Errorf("invalid registration deposit: %w", err)
+	}
+	return nil
+}
+
+// HasRegistrationDeposit returns true if permissionless registrations have to
+// escrow a deposit.
+func (p Params) HasRegistrationDeposit() bool {
+	return !p.RegistrationDeposit.Amount.IsNil() && p.RegistrationDeposit.IsPositive()
 }
 
 // ValidatePrecompiles checks if the precompile addresses are valid and unique.
@@ -4,6 +4,8 @@ import (
 	"slices"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v19/x/erc20/types"
 	"github.com/stretchr/testify/require"
@@ -121,6 +123,36 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			true,
 			"duplicate precompile",
 		},
+		{
+			"empty registration deposit",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDeposit = sdk.Coin{}
+				return params
+			},
+			false,
+			"",
+		},
+		{
+			"invalid registration deposit denom",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDeposit = sdk.Coin{Denom: "1", Amount: math.NewInt(1)}
+				return params
+			},
+			true,
+			"invalid registration deposit",
+		},
+		{
+			"negative registration deposit",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDeposit = sdk.Coin{Denom: "unxq", Amount: math.NewInt(-1)}
+				return params
+			},
+			true,
+			"invalid registration deposit",
+		},
 		{
 			"unsorted addresses",
 			func() types.Params {
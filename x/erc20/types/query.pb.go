@@ -311,6 +311,100 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryRegistrationDepositRequest is the request type for the
+// Query/RegistrationDeposit RPC method.
+type QueryRegistrationDepositRequest struct {
+	// contract_address is the hex address of the registered ERC20 contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *QueryRegistrationDepositRequest) Reset()         { *m = QueryRegistrationDepositRequest{} }
+func (m *QueryRegistrationDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegistrationDepositRequest) ProtoMessage()    {}
+func (*QueryRegistrationDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{6}
+}
+func (m *QueryRegistrationDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegistrationDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegistrationDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegistrationDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegistrationDepositRequest.Merge(m, src)
+}
+func (m *QueryRegistrationDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegistrationDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegistrationDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegistrationDepositRequest proto.InternalMessageInfo
+
+func (m *QueryRegistrationDepositRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// QueryRegistrationDepositResponse is the response type for the
+// Query/RegistrationDeposit RPC method.
+type QueryRegistrationDepositResponse struct {
+	// deposit is the deposit escrowed for the registration
+	Deposit RegistrationDeposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit"`
+}
+
+func (m *QueryRegistrationDepositResponse) Reset()         { *m = QueryRegistrationDepositResponse{} }
+func (m *QueryRegistrationDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegistrationDepositResponse) ProtoMessage()    {}
+func (*QueryRegistrationDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{7}
+}
+func (m *QueryRegistrationDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegistrationDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegistrationDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegistrationDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegistrationDepositResponse.Merge(m, src)
+}
+func (m *QueryRegistrationDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegistrationDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegistrationDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegistrationDepositResponse proto.InternalMessageInfo
+
+func (m *QueryRegistrationDepositResponse) GetDeposit() RegistrationDeposit {
+	if m != nil {
+		return m.Deposit
+	}
+	return RegistrationDeposit{}
+}
+
 func init() {
 	proto.RegisterType((*QueryTokenPairsRequest)(nil), "evmos.erc20.v1.QueryTokenPairsRequest")
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "evmos.erc20.v1.QueryTokenPairsResponse")
@@ -318,44 +412,53 @@ func init() {
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "evmos.erc20.v1.QueryTokenPairResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.erc20.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRegistrationDepositRequest)(nil), "evmos.erc20.v1.QueryRegistrationDepositRequest")
+	proto.RegisterType((*QueryRegistrationDepositResponse)(nil), "evmos.erc20.v1.QueryRegistrationDepositResponse")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/query.proto", fileDescriptor_fba814bce17cabdf) }
 
 var fileDescriptor_fba814bce17cabdf = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xef, 0xec, 0xba, 0x85, 0xbe, 0x82, 0x87, 0xb1, 0xd6, 0x1a, 0x35, 0x2e, 0x29, 0xdb, 0x2d,
-	0x8a, 0x33, 0xa6, 0x7a, 0xf1, 0x22, 0xd2, 0x83, 0x1e, 0xbc, 0xd4, 0xe2, 0x41, 0xbc, 0xe8, 0xb4,
-	0x0c, 0x31, 0x68, 0x33, 0x69, 0x66, 0x1a, 0x5c, 0xc4, 0xcb, 0x5e, 0xc4, 0x9b, 0xe0, 0x57, 0xf0,
-	0xc3, 0xec, 0x71, 0xc1, 0x8b, 0x27, 0x91, 0xd6, 0x0f, 0x22, 0x99, 0x99, 0xa4, 0x9b, 0x58, 0xda,
-	0x4b, 0x49, 0xde, 0x7b, 0xbf, 0x7f, 0xef, 0xa5, 0xe0, 0xf0, 0x74, 0x26, 0x24, 0xe5, 0xc9, 0x74,
-	0x70, 0x9f, 0xa6, 0x3e, 0x9d, 0x2f, 0x78, 0x72, 0x42, 0xe2, 0x44, 0x28, 0x81, 0x2f, 0xeb, 0x1e,
-	0xd1, 0x3d, 0x92, 0xfa, 0xce, 0x9d, 0xa9, 0x90, 0xd9, 0xf0, 0x84, 0x49, 0x6e, 0x06, 0x69, 0xea,
-	0x4f, 0xb8, 0x62, 0x3e, 0x8d, 0x59, 0x10, 0x46, 0x4c, 0x85, 0x22, 0x32, 0x58, 0xa7, 0xca, 0x6b,
-	0x48, 0x4c, 0xef, 0x66, 0xa5, 0x17, 0xf0, 0x88, 0xcb, 0x50, 0xda, 0x6e, 0x2b, 0x10, 0x81, 0xd0,
-	0x8f, 0x34, 0x7b, 0xca, 0x31, 0x81, 0x10, 0xc1, 0x07, 0x4e, 0x59, 0x1c, 0x52, 0x16, 0x45, 0x42,
-	0x69, 0x31, 0x8b, 0xf1, 0xde, 0x42, 0xfb, 0x45, 0xe6, 0xe7, 0xa5, 0x78, 0xcf, 0xa3, 0x11, 0x0b,
-	0x13, 0x39, 0xe6, 0xf3, 0x05, 0x97, 0x0a, 0x3f, 0x05, 0x58, 0x7b, 0xeb, 0xa0, 0x43, 0xd4, 0x6f,
-	0x0e, 0x7a, 0xc4, 0x04, 0x21, 0x59, 0x10, 0x62, 0x12, 0xdb, 0x20, 0x64, 0xc4, 0x02, 0x6e, 0xb1,
-	0xe3, 0x0b, 0x48, 0xef, 0x07, 0x82, 0x6b, 0xff, 0x49, 0xc8, 0x58, 0x44, 0x92, 0xe3, 0x27, 0xd0,
-	0x54, 0x59, 0xf5, 0x4d, 0x9c, 0x95, 0x3b, 0xe8, 0x70, 0xbf, 0xdf, 0x1c, 0x5c, 0x27, 0xe5, 0xed,
-	0x91, 0x02, 0x38, 0xbc, 0x74, 0xf6, 0xfb, 0x76, 0x6d, 0x0c, 0xaa, 0x60, 0xc2, 0xcf, 0x4a, 0x2e,
-	0xf7, 0xb4, 0xcb, 0xe3, 0x9d, 0x2e, 0x8d, 0x7c, 0xc9, 0xe6, 0x3d, 0xb8, 0x5a, 0x76, 0x99, 0xef,
-	0xa1, 0x05, 0x07, 0x5a, 0x4f, 0xaf, 0xa0, 0x31, 0x36, 0x2f, 0xde, 0xab, 0xea, 0xde, 0x8a, 0x4c,
-	0x8f, 0x01, 0xd6, 0x99, 0xec, 0xde, 0x76, 0x46, 0x6a, 0x14, 0x91, 0xbc, 0x16, 0x60, 0xcd, 0x3c,
-	0x62, 0x09, 0x9b, 0xe5, 0xd7, 0xf0, 0x9e, 0xc3, 0x95, 0x52, 0xd5, 0x8a, 0x3d, 0x84, 0x7a, 0xac,
-	0x2b, 0x56, 0xa8, 0x5d, 0x15, 0x32, 0xf3, 0x56, 0xc5, 0xce, 0x0e, 0xbe, 0xee, 0xc3, 0x81, 0x66,
-	0xc3, 0xa7, 0x08, 0x60, 0x7d, 0x17, 0xdc, 0xab, 0xc2, 0x37, 0x7f, 0x1b, 0xce, 0xf1, 0xce, 0x39,
-	0xe3, 0xcf, 0xeb, 0x9e, 0xfe, 0xfc, 0xfb, 0x7d, 0xef, 0x16, 0xbe, 0x41, 0x2b, 0x5f, 0xee, 0x85,
-	0xb3, 0xe3, 0x2f, 0x08, 0x1a, 0x05, 0x16, 0x1f, 0x6d, 0xe7, 0xce, 0x2d, 0xf4, 0x76, 0x8d, 0x59,
-	0x07, 0x77, 0xb5, 0x83, 0x23, 0xdc, 0xdd, 0xe2, 0x80, 0x7e, 0xd2, 0x2f, 0x9f, 0xf1, 0x1c, 0xea,
-	0x66, 0x61, 0xd8, 0xdb, 0x48, 0x5f, 0xba, 0x89, 0xd3, 0xdd, 0x3a, 0x63, 0xf5, 0x5d, 0xad, 0xdf,
-	0xc1, 0xed, 0xaa, 0xbe, 0xb9, 0xc5, 0x70, 0x78, 0xb6, 0x74, 0xd1, 0xf9, 0xd2, 0x45, 0x7f, 0x96,
-	0x2e, 0xfa, 0xb6, 0x72, 0x6b, 0xe7, 0x2b, 0xb7, 0xf6, 0x6b, 0xe5, 0xd6, 0x5e, 0xf7, 0x83, 0x50,
-	0xbd, 0x5b, 0x4c, 0xc8, 0x54, 0xcc, 0x72, 0xac, 0xfe, 0x4d, 0xfd, 0x47, 0xf4, 0xa3, 0xe5, 0x51,
-	0x27, 0x31, 0x97, 0x93, 0xba, 0xfe, 0x2f, 0x3f, 0xf8, 0x17, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x2c,
-	0x7c, 0xc0, 0x93, 0x04, 0x00, 0x00,
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0x12, 0x4d,
+	0x18, 0x67, 0xfb, 0xb6, 0x7d, 0xc3, 0x43, 0xa2, 0x66, 0x8a, 0x88, 0xab, 0x6e, 0xc9, 0x92, 0x52,
+	0xd4, 0xb8, 0x53, 0xd0, 0x83, 0x7a, 0x30, 0x15, 0x8d, 0x1e, 0xf4, 0x80, 0xc4, 0x83, 0xf1, 0x82,
+	0x03, 0x4c, 0xd6, 0x8d, 0xb2, 0xb3, 0xec, 0x0c, 0xc4, 0xa6, 0xe9, 0xa5, 0x17, 0xaf, 0x26, 0x7e,
+	0x05, 0x3f, 0x85, 0x1f, 0xc0, 0xf4, 0xd8, 0xc4, 0x8b, 0x27, 0x63, 0xc0, 0x0f, 0x62, 0x76, 0x66,
+	0x16, 0xd8, 0x95, 0x82, 0x17, 0xb2, 0xfb, 0xcc, 0xf3, 0xfb, 0xf7, 0xcc, 0xc3, 0x82, 0x49, 0x47,
+	0x7d, 0xc6, 0x31, 0x0d, 0xbb, 0xf5, 0x3d, 0x3c, 0xaa, 0xe1, 0xc1, 0x90, 0x86, 0x07, 0x4e, 0x10,
+	0x32, 0xc1, 0xd0, 0x39, 0x79, 0xe6, 0xc8, 0x33, 0x67, 0x54, 0x33, 0x6f, 0x74, 0x19, 0x8f, 0x9a,
+	0x3b, 0x84, 0x53, 0xd5, 0x88, 0x47, 0xb5, 0x0e, 0x15, 0xa4, 0x86, 0x03, 0xe2, 0x7a, 0x3e, 0x11,
+	0x1e, 0xf3, 0x15, 0xd6, 0x4c, 0xf3, 0x2a, 0x12, 0x75, 0x76, 0x35, 0x75, 0xe6, 0x52, 0x9f, 0x72,
+	0x8f, 0xeb, 0xd3, 0xbc, 0xcb, 0x5c, 0x26, 0x1f, 0x71, 0xf4, 0x14, 0x63, 0x5c, 0xc6, 0xdc, 0xf7,
+	0x14, 0x93, 0xc0, 0xc3, 0xc4, 0xf7, 0x99, 0x90, 0x62, 0x1a, 0x63, 0xbf, 0x81, 0xc2, 0x8b, 0xc8,
+	0xcf, 0x4b, 0xf6, 0x8e, 0xfa, 0x4d, 0xe2, 0x85, 0xbc, 0x45, 0x07, 0x43, 0xca, 0x05, 0x7a, 0x02,
+	0x30, 0xf3, 0x56, 0x34, 0x4a, 0x46, 0x35, 0x57, 0xaf, 0x38, 0x2a, 0x88, 0x13, 0x05, 0x71, 0x54,
+	0x62, 0x1d, 0xc4, 0x69, 0x12, 0x97, 0x6a, 0x6c, 0x6b, 0x0e, 0x69, 0x7f, 0x31, 0xe0, 0xd2, 0x5f,
+	0x12, 0x3c, 0x60, 0x3e, 0xa7, 0x68, 0x1f, 0x72, 0x22, 0xaa, 0xb6, 0x83, 0xa8, 0x5c, 0x34, 0x4a,
+	0xff, 0x55, 0x73, 0xf5, 0xcb, 0x4e, 0x72, 0x7a, 0xce, 0x14, 0xd8, 0x58, 0x3f, 0xf9, 0xb9, 0x9d,
+	0x69, 0x81, 0x98, 0x32, 0xa1, 0xa7, 0x09, 0x97, 0x6b, 0xd2, 0xe5, 0xee, 0x4a, 0x97, 0x4a, 0x3e,
+	0x61, 0xf3, 0x16, 0x5c, 0x4c, 0xba, 0x8c, 0xe7, 0x90, 0x87, 0x0d, 0xa9, 0x27, 0x47, 0x90, 0x6d,
+	0xa9, 0x17, 0xfb, 0x55, 0x7a, 0x6e, 0xd3, 0x4c, 0x0f, 0x00, 0x66, 0x99, 0xf4, 0xdc, 0x56, 0x46,
+	0xca, 0x4e, 0x23, 0xd9, 0x79, 0x40, 0x92, 0xb9, 0x49, 0x42, 0xd2, 0x8f, 0x6f, 0xc3, 0x7e, 0x06,
+	0x5b, 0x89, 0xaa, 0x16, 0xbb, 0x03, 0x9b, 0x81, 0xac, 0x68, 0xa1, 0x42, 0x5a, 0x48, 0xf5, 0x6b,
+	0x15, 0xdd, 0x6b, 0x3f, 0x87, 0x6d, 0x49, 0xd6, 0xa2, 0xae, 0xc7, 0x45, 0x28, 0x07, 0xf0, 0x98,
+	0x06, 0x8c, 0x7b, 0x22, 0x4e, 0x7d, 0x1d, 0x2e, 0x74, 0x99, 0x2f, 0x42, 0xd2, 0x15, 0x6d, 0xd2,
+	0xeb, 0x85, 0x94, 0x73, 0x3d, 0x80, 0xf3, 0x71, 0xfd, 0xa1, 0x2a, 0xdb, 0x2e, 0x94, 0xce, 0x66,
+	0xd3, 0x3e, 0x1f, 0xc1, 0xff, 0x3d, 0x55, 0xd2, 0x46, 0xcb, 0x69, 0xa3, 0x0b, 0xd0, 0xda, 0x75,
+	0x8c, 0xac, 0x7f, 0x5b, 0x87, 0x0d, 0xa9, 0x84, 0x8e, 0x0d, 0x80, 0xd9, 0x3a, 0xa1, 0x4a, 0x9a,
+	0x6c, 0xf1, 0x4a, 0x9b, 0xbb, 0x2b, 0xfb, 0x94, 0x5d, 0xbb, 0x7c, 0xfc, 0xfd, 0xf7, 0xe7, 0xb5,
+	0x6b, 0xe8, 0x0a, 0x4e, 0xfd, 0xe1, 0xe6, 0xb6, 0x15, 0x7d, 0x34, 0x20, 0x3b, 0xc5, 0xa2, 0x9d,
+	0xe5, 0xdc, 0xb1, 0x85, 0xca, 0xaa, 0x36, 0xed, 0xe0, 0xa6, 0x74, 0xb0, 0x83, 0xca, 0x4b, 0x1c,
+	0xe0, 0x43, 0xf9, 0x72, 0x84, 0x06, 0xb0, 0xa9, 0xee, 0x19, 0xd9, 0x0b, 0xe9, 0x13, 0xab, 0x64,
+	0x96, 0x97, 0xf6, 0x68, 0x7d, 0x4b, 0xea, 0x17, 0x51, 0x21, 0xad, 0xaf, 0x56, 0x08, 0x7d, 0x35,
+	0x60, 0x6b, 0xc1, 0x95, 0x21, 0xbc, 0x90, 0xfc, 0xec, 0x45, 0x33, 0xf7, 0xfe, 0x1d, 0xa0, 0xad,
+	0xed, 0x4b, 0x6b, 0xf7, 0xd1, 0xdd, 0xb4, 0xb5, 0x70, 0x0e, 0xd4, 0xd6, 0x4b, 0xc3, 0xf1, 0x61,
+	0x7a, 0x8f, 0x8f, 0x1a, 0x8d, 0x93, 0xb1, 0x65, 0x9c, 0x8e, 0x2d, 0xe3, 0xd7, 0xd8, 0x32, 0x3e,
+	0x4d, 0xac, 0xcc, 0xe9, 0xc4, 0xca, 0xfc, 0x98, 0x58, 0x99, 0xd7, 0x55, 0xd7, 0x13, 0x6f, 0x87,
+	0x1d, 0xa7, 0xcb, 0xfa, 0x31, 0xbb, 0xfc, 0x1d, 0xd5, 0xee, 0xe1, 0x0f, 0x5a, 0x49, 0x1c, 0x04,
+	0x94, 0x77, 0x36, 0xe5, 0xf7, 0xf3, 0xf6, 0x9f, 0x01, 0x00, 0x40, 0xec, 0xe9, 0xc6, 0x07, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -376,6 +479,9 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RegistrationDeposit retrieves the deposit escrowed for a permissionless
+	// token pair registration
+	RegistrationDeposit(ctx context.Context, in *QueryRegistrationDepositRequest, opts ...grpc.CallOption) (*QueryRegistrationDepositResponse, error)
 }
 
 type queryClient struct {
@@ -413,6 +519,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RegistrationDeposit(ctx context.Context, in *QueryRegistrationDepositRequest, opts ...grpc.CallOption) (*QueryRegistrationDepositResponse, error) {
+	out := new(QueryRegistrationDepositResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/RegistrationDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TokenPairs retrieves registered token pairs
@@ -421,6 +536,9 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RegistrationDeposit retrieves the deposit escrowed for a permissionless
+	// token pair registration
+	RegistrationDeposit(context.Context, *QueryRegistrationDepositRequest) (*QueryRegistrationDepositResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -436,6 +554,9 @@ func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenP
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RegistrationDeposit(ctx context.Context, req *QueryRegistrationDepositRequest) (*QueryRegistrationDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistrationDeposit not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -495,6 +616,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RegistrationDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegistrationDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegistrationDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/RegistrationDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegistrationDeposit(ctx, req.(*QueryRegistrationDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.erc20.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -511,6 +650,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RegistrationDeposit",
+			Handler:    _Query_RegistrationDeposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/erc20/v1/query.proto",
@@ -719,6 +862,69 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRegistrationDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegistrationDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegistrationDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegistrationDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegistrationDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegistrationDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -806,6 +1012,30 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRegistrationDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegistrationDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1316,6 +1546,171 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRegistrationDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegistrationDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegistrationDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegistrationDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegistrationDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegistrationDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,95 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MaxRegistrationDecimals is the maximum number of decimals an ERC20 token can
+// have to be registered without governance.
+const MaxRegistrationDecimals = 18
+
+// ownableABIJSON is the minimal ABI of the OpenZeppelin Ownable owner() getter
+const ownableABIJSON = `[{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"}]`
+
+// OwnableABI is the parsed ABI used to query the owner of an ERC20 contract
+var OwnableABI abi.ABI
+
+func init() {
+	var err error
+	if OwnableABI, err = abi.JSON(strings.NewReader(ownableABIJSON)); err != nil {
+		panic(err)
+	}
+}
+
+// NewRegistrationDeposit returns an instance of RegistrationDeposit
+func NewRegistrationDeposit(erc20Address common.Address, depositor sdk.AccAddress, amount sdk.Coin) RegistrationDeposit {
+	return RegistrationDeposit{
+		Erc20Address: erc20Address.String(),
+		Depositor:    depositor.String(),
+		Amount:       amount,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (rd RegistrationDeposit) GetERC20Contract() common.Address {
+	return common.HexToAddress(rd.Erc20Address)
+}
+
+// Validate performs a stateless validation of a RegistrationDeposit
+func (rd RegistrationDeposit) Validate() error {
+	if !common.IsHexAddress(rd.Erc20Address) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", rd.Erc20Address)
+	}
+	if _, err := sdk.AccAddressFromBech32(rd.Depositor); err != nil {
+		return errorsmod.Wrap(err, "invalid depositor address")
+	}
+	if err := rd.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+// RegistrationAttestation returns the message the owner of an ERC20 contract
+// signs to authorize a permissionless registration submitted by the sender.
+func RegistrationAttestation(chainID string, contract common.Address, sender sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf(
+		"Register ERC20 contract %s on chain %s with deposit from %s",
+		contract.Hex(), chainID, sender.String(),
+	))
+}
+
+// RecoverAttestationSigner returns the address that signed the registration
+// attestation following EIP-191 (personal_sign).
+func RecoverAttestationSigner(attestation, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest, "invalid signature length, expected %d, got %d", crypto.SignatureLength, len(signature),
+		)
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	// normalize the recovery id from the {27, 28} legacy range
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(accounts.TextHash(attestation), sig)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/erc20/types"
+)
+
+func TestRecoverAttestationSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+
+	attestation := types.RegistrationAttestation(
+		"evmos_9000-1", utiltx.GenerateAddress(), sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+	)
+	sig, err := crypto.Sign(accounts.TextHash(attestation), key)
+	require.NoError(t, err)
+
+	legacySig := make([]byte, len(sig))
+	copy(legacySig, sig)
+	legacySig[crypto.RecoveryIDOffset] += 27
+
+	testCases := []struct {
+		name      string
+		message   []byte
+		signature []byte
+		expPass   bool
+		expSigner bool
+	}{
+		{"pass - signature", attestation, sig, true, true},
+		{"pass - legacy recovery id", attestation, legacySig, true, true},
+		{"pass - different message recovers another signer", []byte("other message"), sig, true, false},
+		{"fail - invalid signature length", attestation, sig[:64], false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recovered, err := types.RecoverAttestationSigner(tc.message, tc.signature)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expSigner, recovered == signer)
+		})
+	}
+}
@@ -335,6 +335,213 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRegisterERC20 defines a Msg to register a token pair for an ERC20 contract
+// without governance. Ownership is proven either by sending the message from the
+// address returned by the contract's owner() method or by including an
+// owner_signature that authorizes the sender.
+type MsgRegisterERC20 struct {
+	// sender is the bech32 address that submits the registration and pays the deposit
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_address is the hex address of the ERC20 token contract to register
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// owner_signature is an optional EIP-191 signature by the contract owner over
+	// the registration attestation. It is required when the sender is not the owner.
+	OwnerSignature []byte `protobuf:"bytes,3,opt,name=owner_signature,json=ownerSignature,proto3" json:"owner_signature,omitempty"`
+}
+
+func (m *MsgRegisterERC20) Reset()         { *m = MsgRegisterERC20{} }
+func (m *MsgRegisterERC20) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20) ProtoMessage()    {}
+func (*MsgRegisterERC20) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{6}
+}
+func (m *MsgRegisterERC20) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterERC20) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterERC20) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20.Merge(m, src)
+}
+func (m *MsgRegisterERC20) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterERC20 proto.InternalMessageInfo
+
+func (m *MsgRegisterERC20) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterERC20) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterERC20) GetOwnerSignature() []byte {
+	if m != nil {
+		return m.OwnerSignature
+	}
+	return nil
+}
+
+// MsgRegisterERC20Response returns the registered token pair
+type MsgRegisterERC20Response struct {
+	// token_pair is the token pair created for the ERC20 contract
+	TokenPair TokenPair `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair"`
+}
+
+func (m *MsgRegisterERC20Response) Reset()         { *m = MsgRegisterERC20Response{} }
+func (m *MsgRegisterERC20Response) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20Response) ProtoMessage()    {}
+func (*MsgRegisterERC20Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{7}
+}
+func (m *MsgRegisterERC20Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterERC20Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterERC20Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20Response.Merge(m, src)
+}
+func (m *MsgRegisterERC20Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterERC20Response proto.InternalMessageInfo
+
+func (m *MsgRegisterERC20Response) GetTokenPair() TokenPair {
+	if m != nil {
+		return m.TokenPair
+	}
+	return TokenPair{}
+}
+
+// MsgDeregisterERC20 defines a Msg to delete a token pair that was registered
+// without governance and to refund its registration deposit.
+type MsgDeregisterERC20 struct {
+	// sender is the bech32 address of the depositor or of the contract owner
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_address is the hex address of the registered ERC20 token contract
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgDeregisterERC20) Reset()         { *m = MsgDeregisterERC20{} }
+func (m *MsgDeregisterERC20) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterERC20) ProtoMessage()    {}
+func (*MsgDeregisterERC20) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{8}
+}
+func (m *MsgDeregisterERC20) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterERC20) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterERC20.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterERC20) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterERC20.Merge(m, src)
+}
+func (m *MsgDeregisterERC20) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterERC20) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterERC20.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterERC20 proto.InternalMessageInfo
+
+func (m *MsgDeregisterERC20) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgDeregisterERC20) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgDeregisterERC20Response returns no fields
+type MsgDeregisterERC20Response struct {
+}
+
+func (m *MsgDeregisterERC20Response) Reset()         { *m = MsgDeregisterERC20Response{} }
+func (m *MsgDeregisterERC20Response) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterERC20Response) ProtoMessage()    {}
+func (*MsgDeregisterERC20Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{9}
+}
+func (m *MsgDeregisterERC20Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterERC20Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterERC20Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterERC20Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterERC20Response.Merge(m, src)
+}
+func (m *MsgDeregisterERC20Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterERC20Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterERC20Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterERC20Response proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertERC20)(nil), "evmos.erc20.v1.MsgConvertERC20")
 	proto.RegisterType((*MsgConvertERC20Response)(nil), "evmos.erc20.v1.MsgConvertERC20Response")
@@ -342,46 +549,61 @@ func init() {
 	proto.RegisterType((*MsgConvertCoinResponse)(nil), "evmos.erc20.v1.MsgConvertCoinResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.erc20.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.erc20.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterERC20)(nil), "evmos.erc20.v1.MsgRegisterERC20")
+	proto.RegisterType((*MsgRegisterERC20Response)(nil), "evmos.erc20.v1.MsgRegisterERC20Response")
+	proto.RegisterType((*MsgDeregisterERC20)(nil), "evmos.erc20.v1.MsgDeregisterERC20")
+	proto.RegisterType((*MsgDeregisterERC20Response)(nil), "evmos.erc20.v1.MsgDeregisterERC20Response")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/tx.proto", fileDescriptor_f8926fc6cb676914) }
 
 var fileDescriptor_f8926fc6cb676914 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x8a, 0x13, 0x31,
-	0x18, 0xc7, 0x3b, 0xdb, 0x52, 0xdc, 0xec, 0xd2, 0x95, 0xb0, 0xb6, 0xd3, 0xa2, 0xd3, 0xa5, 0x17,
-	0xab, 0x60, 0xb2, 0xed, 0xaa, 0xa0, 0x37, 0x5b, 0x3c, 0x78, 0x58, 0x90, 0x11, 0x41, 0xbc, 0x94,
-	0x74, 0x1a, 0xd2, 0x41, 0x27, 0x19, 0x92, 0x74, 0xd8, 0x5e, 0xfb, 0x02, 0x0a, 0x3e, 0x84, 0x57,
-	0x0f, 0x3e, 0xc4, 0x1e, 0x17, 0xbd, 0x88, 0x87, 0x45, 0xda, 0x05, 0x5f, 0x43, 0x26, 0x93, 0xb6,
-	0x3b, 0xb5, 0xe2, 0xa5, 0xf4, 0xcb, 0xff, 0xff, 0x7d, 0xf9, 0xcd, 0x3f, 0x09, 0xa8, 0xd1, 0x24,
-	0x12, 0x0a, 0x53, 0x19, 0x74, 0x8f, 0x71, 0xd2, 0xc1, 0xfa, 0x0c, 0xc5, 0x52, 0x68, 0x01, 0x2b,
-	0x46, 0x40, 0x46, 0x40, 0x49, 0xa7, 0xe1, 0x05, 0x42, 0xa5, 0xce, 0x21, 0x51, 0x14, 0x27, 0x9d,
-	0x21, 0xd5, 0xa4, 0x83, 0x03, 0x11, 0xf2, 0xcc, 0xdf, 0xa8, 0x59, 0x3d, 0x52, 0x2c, 0x9d, 0x13,
-	0x29, 0x66, 0x85, 0x7a, 0x26, 0x0c, 0x4c, 0x85, 0xb3, 0xc2, 0x4a, 0xb7, 0x37, 0x36, 0x67, 0x94,
-	0x53, 0x15, 0x2e, 0xd5, 0x43, 0x26, 0x98, 0xc8, 0xba, 0xd2, 0x7f, 0xcb, 0x1e, 0x26, 0x04, 0x7b,
-	0x4f, 0x31, 0x89, 0x43, 0x4c, 0x38, 0x17, 0x9a, 0xe8, 0x50, 0x70, 0xdb, 0xd3, 0xfa, 0xec, 0x80,
-	0x83, 0x53, 0xc5, 0xfa, 0x82, 0x27, 0x54, 0xea, 0xe7, 0x7e, 0xbf, 0x7b, 0x0c, 0xef, 0x81, 0x9b,
-	0x81, 0xe0, 0x5a, 0x92, 0x40, 0x0f, 0xc8, 0x68, 0x24, 0xa9, 0x52, 0xae, 0x73, 0xe4, 0xb4, 0x77,
-	0xfd, 0x83, 0xe5, 0xfa, 0xb3, 0x6c, 0x19, 0x3e, 0x02, 0x65, 0x12, 0x89, 0x09, 0xd7, 0xee, 0x4e,
-	0x6a, 0xe8, 0xdd, 0x39, 0xbf, 0x6c, 0x16, 0x7e, 0x5e, 0x36, 0x6f, 0x65, 0xd8, 0x6a, 0xf4, 0x0e,
-	0x85, 0x02, 0x47, 0x44, 0x8f, 0xd1, 0x0b, 0xae, 0x7d, 0x6b, 0x86, 0x0d, 0x70, 0x43, 0xd2, 0x80,
-	0x86, 0x09, 0x95, 0x6e, 0xd1, 0x4c, 0x5e, 0xd5, 0xb0, 0x0a, 0xca, 0x8a, 0xf2, 0x11, 0x95, 0x6e,
-	0xc9, 0x28, 0xb6, 0x6a, 0xd5, 0x41, 0x6d, 0x03, 0xd4, 0xa7, 0x2a, 0x16, 0x5c, 0xd1, 0xd6, 0x14,
-	0x54, 0xd6, 0x52, 0x5f, 0x84, 0x1c, 0x9e, 0x80, 0x52, 0x1a, 0xb5, 0xc1, 0xde, 0xeb, 0xd6, 0x91,
-	0x4d, 0x31, 0x3d, 0x0b, 0x64, 0xcf, 0x02, 0xa5, 0xc6, 0x5e, 0x29, 0x05, 0xf6, 0x8d, 0x39, 0x47,
-	0xb5, 0xf3, 0x4f, 0xaa, 0x62, 0x8e, 0xca, 0x05, 0xd5, 0xfc, 0xd6, 0x2b, 0xa8, 0x0f, 0x59, 0xb2,
-	0xaf, 0xe3, 0x11, 0xd1, 0xf4, 0x25, 0x91, 0x24, 0x52, 0xf0, 0x31, 0xd8, 0x25, 0x13, 0x3d, 0x16,
-	0x32, 0xd4, 0xd3, 0x2c, 0xd2, 0x9e, 0xfb, 0xed, 0xeb, 0x83, 0x43, 0x8b, 0x67, 0x53, 0x7d, 0xa5,
-	0x65, 0xc8, 0x99, 0xbf, 0xb6, 0xc2, 0x87, 0xa0, 0x1c, 0x9b, 0x09, 0x86, 0x6b, 0xaf, 0x5b, 0x45,
-	0xf9, 0xcb, 0x86, 0xb2, 0xf9, 0xf6, 0x6b, 0xac, 0xf7, 0x69, 0x65, 0xf6, 0xfb, 0xcb, 0xfd, 0xf5,
-	0x14, 0x9b, 0xe0, 0x75, 0xa0, 0x25, 0x6c, 0xf7, 0xca, 0x01, 0xc5, 0x53, 0xc5, 0xe0, 0xcc, 0x01,
-	0xfb, 0xb9, 0xbb, 0xd0, 0xdc, 0xdc, 0x69, 0xe3, 0x0c, 0x1a, 0x77, 0xff, 0x63, 0x58, 0xe5, 0xd1,
-	0x9e, 0x7d, 0xbf, 0xfa, 0xb4, 0xd3, 0x82, 0x47, 0xf8, 0xaf, 0x17, 0x84, 0x83, 0xac, 0x61, 0x60,
-	0xd6, 0xe0, 0x1b, 0xb0, 0x9f, 0x4b, 0x6d, 0x1b, 0xc3, 0x75, 0xc3, 0x56, 0x86, 0x6d, 0x9f, 0xd9,
-	0xeb, 0x9d, 0xcf, 0x3d, 0xe7, 0x62, 0xee, 0x39, 0xbf, 0xe6, 0x9e, 0xf3, 0x71, 0xe1, 0x15, 0x2e,
-	0x16, 0x5e, 0xe1, 0xc7, 0xc2, 0x2b, 0xbc, 0x6d, 0xb3, 0x50, 0x8f, 0x27, 0x43, 0x14, 0x88, 0x68,
-	0xc9, 0x67, 0x7e, 0x93, 0xce, 0x13, 0x7c, 0x66, 0x59, 0xf5, 0x34, 0xa6, 0x6a, 0x58, 0x36, 0x0f,
-	0xe7, 0xe4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x28, 0xb3, 0x69, 0x9f, 0x09, 0x04, 0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x26, 0x25, 0xd8, 0x69, 0x49, 0xca, 0x52, 0xdb, 0x74, 0xad, 0xdb, 0x92, 0x4b, 0x63,
+	0xc1, 0xdd, 0x26, 0x55, 0xc1, 0x1e, 0x04, 0x53, 0x3d, 0x78, 0x08, 0x94, 0xad, 0x82, 0xf4, 0x12,
+	0x26, 0x9b, 0x61, 0x3a, 0xb4, 0x3b, 0xb3, 0xcc, 0x4c, 0xd6, 0xf6, 0xe2, 0xa1, 0x7f, 0x40, 0xc1,
+	0xdf, 0x20, 0x5e, 0x45, 0xfc, 0x11, 0x3d, 0x16, 0xbd, 0x88, 0x87, 0x22, 0xad, 0xe0, 0xdf, 0x90,
+	0x9d, 0x9d, 0xdd, 0x76, 0xb7, 0x91, 0xdc, 0xbc, 0x84, 0xcc, 0xfb, 0xbe, 0x79, 0xef, 0x7b, 0xdf,
+	0x7b, 0x3b, 0x60, 0x11, 0x45, 0x01, 0x13, 0x2e, 0xe2, 0x7e, 0x67, 0xc3, 0x8d, 0xda, 0xae, 0x3c,
+	0x72, 0x42, 0xce, 0x24, 0x33, 0x6b, 0x0a, 0x70, 0x14, 0xe0, 0x44, 0x6d, 0xcb, 0xf6, 0x99, 0x88,
+	0x99, 0x03, 0x28, 0x90, 0x1b, 0xb5, 0x07, 0x48, 0xc2, 0xb6, 0xeb, 0x33, 0x42, 0x13, 0xbe, 0xb5,
+	0xa8, 0xf1, 0x40, 0xe0, 0x38, 0x4f, 0x20, 0xb0, 0x06, 0x96, 0x12, 0xa0, 0xaf, 0x4e, 0x6e, 0x72,
+	0xd0, 0x90, 0x55, 0x28, 0x9e, 0x14, 0x4b, 0xb0, 0xe5, 0x02, 0x86, 0x11, 0x45, 0x82, 0xa4, 0x37,
+	0xe7, 0x31, 0xc3, 0x2c, 0xc9, 0x18, 0xff, 0x4b, 0xef, 0x60, 0xc6, 0xf0, 0x21, 0x72, 0x61, 0x48,
+	0x5c, 0x48, 0x29, 0x93, 0x50, 0x12, 0x46, 0xf5, 0x9d, 0xe6, 0x27, 0x03, 0xd4, 0x7b, 0x02, 0x6f,
+	0x33, 0x1a, 0x21, 0x2e, 0x9f, 0x7b, 0xdb, 0x9d, 0x0d, 0xf3, 0x1e, 0x98, 0xf3, 0x19, 0x95, 0x1c,
+	0xfa, 0xb2, 0x0f, 0x87, 0x43, 0x8e, 0x84, 0x68, 0x18, 0xab, 0x46, 0x6b, 0xda, 0xab, 0xa7, 0xf1,
+	0xa7, 0x49, 0xd8, 0x7c, 0x08, 0xaa, 0x30, 0x60, 0x23, 0x2a, 0x1b, 0xe5, 0x98, 0xd0, 0xbd, 0x7b,
+	0x7a, 0xbe, 0x52, 0xfa, 0x79, 0xbe, 0x72, 0x3b, 0x69, 0x49, 0x0c, 0x0f, 0x1c, 0xc2, 0xdc, 0x00,
+	0xca, 0x7d, 0xe7, 0x05, 0x95, 0x9e, 0x26, 0x9b, 0x16, 0xb8, 0xc5, 0x91, 0x8f, 0x48, 0x84, 0x78,
+	0xa3, 0xa2, 0x32, 0x67, 0x67, 0x73, 0x01, 0x54, 0x05, 0xa2, 0x43, 0xc4, 0x1b, 0x53, 0x0a, 0xd1,
+	0xa7, 0xe6, 0x12, 0x58, 0x2c, 0x08, 0xf5, 0x90, 0x08, 0x19, 0x15, 0xa8, 0x79, 0x0c, 0x6a, 0x57,
+	0xd0, 0x36, 0x23, 0xd4, 0xdc, 0x04, 0x53, 0xf1, 0x18, 0x94, 0xec, 0x99, 0xce, 0x92, 0xa3, 0x1d,
+	0x8e, 0xe7, 0xe4, 0xe8, 0x39, 0x39, 0x31, 0xb1, 0x3b, 0x15, 0x0b, 0xf6, 0x14, 0x39, 0xa7, 0xaa,
+	0xfc, 0x4f, 0x55, 0x95, 0x9c, 0xaa, 0x06, 0x58, 0xc8, 0x97, 0xce, 0x44, 0xbd, 0x4b, 0x9c, 0x7d,
+	0x15, 0x0e, 0xa1, 0x44, 0x3b, 0x90, 0xc3, 0x40, 0x98, 0x8f, 0xc0, 0x34, 0x1c, 0xc9, 0x7d, 0xc6,
+	0x89, 0x3c, 0x4e, 0x2c, 0xed, 0x36, 0xbe, 0x7d, 0xbd, 0x3f, 0xaf, 0xe5, 0x69, 0x57, 0x77, 0x25,
+	0x27, 0x14, 0x7b, 0x57, 0x54, 0xf3, 0x01, 0xa8, 0x86, 0x2a, 0x83, 0xd2, 0x35, 0xd3, 0x59, 0x70,
+	0xf2, 0x8b, 0xe8, 0x24, 0xf9, 0x75, 0x37, 0x9a, 0xbb, 0x55, 0x3b, 0xf9, 0xf3, 0x79, 0xfd, 0x2a,
+	0x8b, 0x76, 0xf0, 0xba, 0xa0, 0x4c, 0xec, 0x47, 0x03, 0xcc, 0xf5, 0x04, 0xf6, 0x10, 0x26, 0x42,
+	0x22, 0x9e, 0xec, 0xc1, 0x46, 0xd6, 0xf3, 0x24, 0xa9, 0x9a, 0x37, 0x76, 0x73, 0xca, 0xe3, 0x37,
+	0x67, 0x0d, 0xd4, 0xd9, 0x1b, 0x8a, 0x78, 0x5f, 0x10, 0x4c, 0xa1, 0x1c, 0x71, 0xa4, 0x9c, 0x9d,
+	0xf5, 0x6a, 0x2a, 0xbc, 0x9b, 0x46, 0xb7, 0x66, 0xe2, 0x2e, 0x52, 0xbb, 0xf7, 0x40, 0xa3, 0x28,
+	0x33, 0xed, 0xc1, 0x7c, 0x02, 0x80, 0x64, 0x07, 0x88, 0xf6, 0x43, 0x48, 0x78, 0x36, 0xf9, 0x82,
+	0x51, 0x2f, 0x63, 0xc6, 0x0e, 0x24, 0x5c, 0x7b, 0x35, 0x2d, 0xd3, 0x40, 0xf3, 0x2d, 0x30, 0x7b,
+	0x02, 0x3f, 0x43, 0xfc, 0xbf, 0x99, 0x90, 0xef, 0x6d, 0x19, 0x58, 0x37, 0xeb, 0xa7, 0xdd, 0x75,
+	0xbe, 0x54, 0x40, 0xa5, 0x27, 0xb0, 0x79, 0x62, 0x80, 0xd9, 0xdc, 0xd7, 0xba, 0x52, 0x6c, 0xb1,
+	0xf0, 0x95, 0x58, 0x6b, 0x13, 0x08, 0xd9, 0x12, 0xb4, 0x4e, 0xbe, 0xff, 0xfe, 0x50, 0x6e, 0x9a,
+	0xab, 0xee, 0x8d, 0xf7, 0xcf, 0xf5, 0x93, 0x0b, 0x7d, 0x15, 0x33, 0x5f, 0x83, 0xd9, 0xdc, 0x5e,
+	0x8f, 0xd3, 0x70, 0x9d, 0x60, 0xad, 0x4d, 0x20, 0x64, 0x43, 0x24, 0xe0, 0x4e, 0x6e, 0xba, 0x3b,
+	0x88, 0x07, 0x44, 0x08, 0xc2, 0xe8, 0x61, 0xbc, 0x35, 0xab, 0x63, 0xf2, 0xe4, 0xf8, 0x56, 0x6b,
+	0x12, 0x23, 0x2b, 0x05, 0x41, 0xbd, 0x38, 0xec, 0xe6, 0x98, 0xcb, 0x05, 0x8e, 0xb5, 0x3e, 0x99,
+	0x93, 0x96, 0xe8, 0x76, 0x4f, 0x2f, 0x6c, 0xe3, 0xec, 0xc2, 0x36, 0x7e, 0x5d, 0xd8, 0xc6, 0xfb,
+	0x4b, 0xbb, 0x74, 0x76, 0x69, 0x97, 0x7e, 0x5c, 0xda, 0xa5, 0xbd, 0x16, 0x26, 0x72, 0x7f, 0x34,
+	0x70, 0x7c, 0x16, 0xa4, 0x6e, 0xab, 0xdf, 0xa8, 0xfd, 0xd8, 0x3d, 0xd2, 0xce, 0xcb, 0xe3, 0x10,
+	0x89, 0x41, 0x55, 0x3d, 0xd4, 0x9b, 0x7f, 0x07, 0x00, 0x5c, 0x17, 0x5b, 0x7b, 0x95, 0x06, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -402,6 +624,14 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterERC20Permissionless registers a token pair for an ERC20 contract
+	// without a governance proposal. The sender must prove control over the
+	// contract owner and escrows the registration deposit defined in the params.
+	RegisterERC20Permissionless(ctx context.Context, in *MsgRegisterERC20, opts ...grpc.CallOption) (*MsgRegisterERC20Response, error)
+	// DeregisterERC20 deletes a token pair registered without governance and
+	// refunds the registration deposit to the depositor. The sender must be the
+	// depositor or the contract owner and no converted coins can be outstanding.
+	DeregisterERC20(ctx context.Context, in *MsgDeregisterERC20, opts ...grpc.CallOption) (*MsgDeregisterERC20Response, error)
 }
 
 type msgClient struct {
@@ -430,6 +660,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterERC20Permissionless(ctx context.Context, in *MsgRegisterERC20, opts ...grpc.CallOption) (*MsgRegisterERC20Response, error) {
+	out := new(MsgRegisterERC20Response)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/RegisterERC20Permissionless", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterERC20(ctx context.Context, in *MsgDeregisterERC20, opts ...grpc.CallOption) (*MsgDeregisterERC20Response, error) {
+	out := new(MsgDeregisterERC20Response)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/DeregisterERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
@@ -438,6 +686,14 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterERC20Permissionless registers a token pair for an ERC20 contract
+	// without a governance proposal. The sender must prove control over the
+	// contract owner and escrows the registration deposit defined in the params.
+	RegisterERC20Permissionless(context.Context, *MsgRegisterERC20) (*MsgRegisterERC20Response, error)
+	// DeregisterERC20 deletes a token pair registered without governance and
+	// refunds the registration deposit to the depositor. The sender must be the
+	// depositor or the contract owner and no converted coins can be outstanding.
+	DeregisterERC20(context.Context, *MsgDeregisterERC20) (*MsgDeregisterERC20Response, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -450,6 +706,12 @@ func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvert
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterERC20Permissionless(ctx context.Context, req *MsgRegisterERC20) (*MsgRegisterERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20Permissionless not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterERC20(ctx context.Context, req *MsgDeregisterERC20) (*MsgDeregisterERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterERC20 not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -491,6 +753,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterERC20Permissionless_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC20Permissionless(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/RegisterERC20Permissionless",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC20Permissionless(ctx, req.(*MsgRegisterERC20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterERC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/DeregisterERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterERC20(ctx, req.(*MsgDeregisterERC20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.erc20.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -503,6 +801,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterERC20Permissionless",
+			Handler:    _Msg_RegisterERC20Permissionless_Handler,
+		},
+		{
+			MethodName: "DeregisterERC20",
+			Handler:    _Msg_DeregisterERC20_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/erc20/v1/tx.proto",
@@ -718,72 +1024,209 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgConvertERC20) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegisterERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OwnerSignature) > 0 {
+		i -= len(m.OwnerSignature)
+		copy(dAtA[i:], m.OwnerSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerSignature)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConvertERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgConvertCoin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConvertCoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeregisterERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	return n
@@ -813,6 +1256,64 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnerSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeregisterERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -829,28 +1330,291 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -878,11 +1642,11 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -910,13 +1674,111 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -944,13 +1806,13 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -960,23 +1822,24 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -999,7 +1862,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1022,10 +1885,10 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1049,7 +1912,7 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1072,17 +1935,17 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterERC20: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterERC20: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1092,28 +1955,27 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1141,13 +2003,13 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerSignature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1157,23 +2019,25 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.OwnerSignature = append(m.OwnerSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnerSignature == nil {
+				m.OwnerSignature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1196,7 +2060,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1219,12 +2083,45 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterERC20Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1246,7 +2143,7 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterERC20) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1269,15 +2166,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterERC20: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterERC20: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1305,13 +2202,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1321,24 +2218,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1361,7 +2257,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterERC20Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1384,10 +2280,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterERC20Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
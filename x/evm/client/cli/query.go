@@ -3,6 +3,8 @@
 package cli
 
 import (
+	"strconv"
+
 	rpctypes "github.com/evmos/evmos/v19/rpc/types"
 	"github.com/spf13/cobra"
 
@@ -26,6 +28,9 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetBlockProposerCmd(),
+		GetValidatorBlockCountsCmd(),
+		GetPermissionsCmd(),
 	)
 	return cmd
 }
@@ -134,3 +139,118 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBlockProposerCmd queries the proposer of a block at a given height
+func GetBlockProposerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-proposer [HEIGHT]",
+		Short: "Gets the proposer of a block",
+		Long:  "Gets the validator operator, coinbase address and moniker of the proposer of the block at the given height. If the height is not provided, it will use the latest height.", //nolint:lll
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBlockProposerRequest{}
+			if len(args) == 1 {
+				req.Height, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.BlockProposer(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidatorBlockCountsCmd queries the number of blocks proposed by each
+// validator within a height range
+func GetValidatorBlockCountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-block-counts START_HEIGHT [END_HEIGHT]",
+		Short: "Gets the number of blocks proposed by each validator within a height range",
+		Long:  "Gets the number of blocks proposed by each validator within the inclusive height range. If the end height is not provided, it will use the latest height.", //nolint:lll
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValidatorBlockCountsRequest{}
+			req.StartHeight, err = strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 2 {
+				req.EndHeight, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.ValidatorBlockCounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPermissionsCmd queries whether an address can create contracts and perform calls
+func GetPermissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permissions ADDRESS",
+		Short: "Gets the contract creation and call permissions of an account",
+		Long:  "Gets whether an account can create contracts and perform calls under the access control policy of the evm params.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPermissionsRequest{
+				Address: address,
+			}
+
+			res, err := queryClient.Permissions(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -36,7 +36,9 @@ func NewRawTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "raw TX_HEX",
 		Short: "Build cosmos transaction from raw ethereum transaction",
-		Args:  cobra.ExactArgs(1),
+		Long: `Build cosmos transaction from raw ethereum transaction.
+The fees are paid by the account set with the --fee-granter flag if it granted a fee allowance to the sender.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			data, err := hexutil.Decode(args[0])
 			if err != nil {
@@ -62,11 +64,18 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+			txBuilder := clientCtx.TxConfig.NewTxBuilder()
+			tx, err := msg.BuildTx(txBuilder, rsp.Params.EvmDenom)
 			if err != nil {
 				return err
 			}
 
+			// the fee granter pays the fees of the Ethereum tx using its fee allowance
+			if clientCtx.FeeGranter != nil {
+				txBuilder.SetFeeGranter(clientCtx.FeeGranter)
+				tx = txBuilder.GetTx()
+			}
+
 			if clientCtx.GenerateOnly {
 				json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
 				if err != nil {
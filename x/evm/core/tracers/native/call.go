@@ -45,6 +45,8 @@ type callFrame struct {
 	Output  string      `json:"output,omitempty"`
 	Error   string      `json:"error,omitempty"`
 	Calls   []callFrame `json:"calls,omitempty"`
+	// Precompile is the decoded call of a stateful precompile
+	Precompile *precompileFrame `json:"precompile,omitempty"`
 }
 
 type callTracer struct {
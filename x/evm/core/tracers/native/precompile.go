@@ -0,0 +1,129 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package native
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+var (
+	_ vm.PrecompileLogger = (*callTracer)(nil)
+	_ vm.PrecompileLogger = (*flatCallTracer)(nil)
+	_ vm.PrecompileLogger = (*muxTracer)(nil)
+	_ vm.PrecompileLogger = (*prestateTracer)(nil)
+)
+
+// precompileFrame is the decoded call of a stateful precompile along with the
+// balance changes and the Cosmos SDK events it produced.
+type precompileFrame struct {
+	Method         string                    `json:"method"`
+	Args           map[string]interface{}    `json:"args,omitempty"`
+	BalanceChanges []precompileBalanceChange `json:"balanceChanges,omitempty"`
+	Events         []precompileEvent         `json:"events,omitempty"`
+}
+
+type precompileBalanceChange struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+}
+
+type precompileEvent struct {
+	Type       string                     `json:"type"`
+	Attributes []precompileEventAttribute `json:"attributes,omitempty"`
+}
+
+type precompileEventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// newPrecompileFrame converts the precompile call reported by the EVM to its
+// json representation.
+func newPrecompileFrame(frame *vm.PrecompileFrame) *precompileFrame {
+	res := &precompileFrame{
+		Method: frame.Method,
+		Args:   make(map[string]interface{}, len(frame.Args)),
+	}
+	for name, arg := range frame.Args {
+		res.Args[name] = precompileArg(arg)
+	}
+	for _, change := range frame.BalanceChanges {
+		res.BalanceChanges = append(res.BalanceChanges, precompileBalanceChange{
+			Address: addrToHex(change.Address),
+			Amount:  signedBigToHex(change.Amount),
+		})
+	}
+	for _, event := range frame.Events {
+		traced := precompileEvent{Type: event.Type}
+		for _, attr := range event.Attributes {
+			traced.Attributes = append(traced.Attributes, precompileEventAttribute{Key: attr.Key, Value: attr.Value})
+		}
+		res.Events = append(res.Events, traced)
+	}
+	return res
+}
+
+// precompileArg encodes the numbers, addresses and bytes arguments in hex as the
+// rest of the tracer output. Other arguments are json encoded as is.
+func precompileArg(arg interface{}) interface{} {
+	switch v := arg.(type) {
+	case *big.Int:
+		return signedBigToHex(v)
+	case common.Address:
+		return addrToHex(v)
+	case []common.Address:
+		addrs := make([]string, len(v))
+		for i, addr := range v {
+			addrs[i] = addrToHex(addr)
+		}
+		return addrs
+	case []byte:
+		return bytesToHex(v)
+	default:
+		return arg
+	}
+}
+
+// signedBigToHex returns the hex representation of n, prefixed by a minus sign
+// when negative.
+func signedBigToHex(n *big.Int) string {
+	if n != nil && n.Sign() < 0 {
+		return "-" + bigToHex(new(big.Int).Neg(n))
+	}
+	return bigToHex(n)
+}
+
+// CapturePrecompile implements the PrecompileLogger interface to record the
+// stateful precompile call on its call frame.
+func (t *callTracer) CapturePrecompile(frame *vm.PrecompileFrame) {
+	// With OnlyTopCall, the last call frame is the top call regardless of the
+	// precompile call depth.
+	call := &t.callstack[len(t.callstack)-1]
+	if call.To != addrToHex(frame.Address) {
+		return
+	}
+	call.Precompile = newPrecompileFrame(frame)
+}
+
+// CapturePrecompile implements the PrecompileLogger interface to record the
+// stateful precompile call on its call frame.
+func (t *flatCallTracer) CapturePrecompile(frame *vm.PrecompileFrame) {
+	t.tracer.CapturePrecompile(frame)
+}
+
+// CapturePrecompile implements the PrecompileLogger interface to add the accounts
+// whose balance is changed by the stateful precompile call to the prestate.
+//
+// NOTE: the balance changes of the accounts that are not modified on the EVM state,
+// such as the module accounts, are not reflected on the post state of the diff mode.
+// They are reported on the precompile call frames of the call tracers.
+func (t *prestateTracer) CapturePrecompile(frame *vm.PrecompileFrame) {
+	t.lookupAccount(frame.Address)
+	for _, change := range frame.BalanceChanges {
+		t.lookupAccount(change.Address)
+	}
+}
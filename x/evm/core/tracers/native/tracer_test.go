@@ -0,0 +1,235 @@
+package native_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/x/evm/core/tracers"
+	_ "github.com/evmos/evmos/v19/x/evm/core/tracers/native"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+type testStateDB struct {
+	*state.StateDB
+}
+
+func (*testStateDB) GetTransientState(common.Address, common.Hash) common.Hash {
+	return common.Hash{}
+}
+func (*testStateDB) SetTransientState(common.Address, common.Hash, common.Hash) {}
+func (*testStateDB) Selfdestruct6780(common.Address)                            {}
+
+var (
+	caller   = common.BytesToAddress([]byte("caller"))
+	contract = common.BytesToAddress([]byte("contract"))
+	callee   = common.BytesToAddress([]byte("callee"))
+)
+
+// runTracer executes a call to a contract that calls a second contract, which
+// stores a value, and the identity precompile.
+func runTracer(t *testing.T, name, cfg string) json.RawMessage {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+
+	// call(0xffff, callee, 0, 0, 0, 0, 0) staticcall(0xffff, 0x04, 0, 0, 0, 0)
+	code := append(common.Hex2Bytes("60006000600060006000"), byte(vm.PUSH20))
+	code = append(code, callee.Bytes()...)
+	code = append(code, common.Hex2Bytes("61fffff1506000600060006000600461fffffa5000")...)
+	statedb.SetCode(contract, code)
+	// sstore(0, 1)
+	statedb.SetCode(callee, common.Hex2Bytes("600160005500"))
+	statedb.SetNonce(caller, 1)
+	statedb.Finalise(true)
+
+	ctx := &tracers.Context{BlockHash: common.Hash{1}, BlockNumber: big.NewInt(2), TxIndex: 3, TxHash: common.Hash{4}}
+	tracer, err := tracers.New(name, ctx, json.RawMessage(cfg))
+	require.NoError(t, err)
+
+	blockCtx := vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, addr common.Address, amount *big.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+			db.SubBalance(sender, amount)
+			db.AddBalance(recipient, amount)
+		},
+		BlockNumber: big.NewInt(2),
+	}
+	txCtx := vm.TxContext{Origin: caller, GasPrice: big.NewInt(0)}
+	evm := vm.NewEVM(blockCtx, txCtx, &testStateDB{statedb}, params.TestChainConfig, vm.Config{Debug: true, Tracer: tracer})
+
+	tracer.CaptureTxStart(100000)
+	_, leftOver, err := evm.Call(vm.AccountRef(caller), contract, nil, 100000, new(big.Int))
+	require.NoError(t, err)
+	tracer.CaptureTxEnd(leftOver)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	return res
+}
+
+func TestFlatCallTracer(t *testing.T) {
+	var frames []struct {
+		Action struct {
+			CallType string         `json:"callType"`
+			From     common.Address `json:"from"`
+			To       common.Address `json:"to"`
+			Value    string         `json:"value"`
+		} `json:"action"`
+		BlockHash           common.Hash `json:"blockHash"`
+		BlockNumber         uint64      `json:"blockNumber"`
+		Subtraces           int         `json:"subtraces"`
+		TraceAddress        []int       `json:"traceAddress"`
+		TransactionHash     common.Hash `json:"transactionHash"`
+		TransactionPosition uint64      `json:"transactionPosition"`
+		Type                string      `json:"type"`
+	}
+	require.NoError(t, json.Unmarshal(runTracer(t, "flatCallTracer", `{"convertParityErrors":true}`), &frames))
+
+	// the call to the identity precompile is not included
+	require.Len(t, frames, 2)
+	require.Equal(t, "call", frames[0].Type)
+	require.Equal(t, caller, frames[0].Action.From)
+	require.Equal(t, contract, frames[0].Action.To)
+	require.Equal(t, 1, frames[0].Subtraces)
+	require.Empty(t, frames[0].TraceAddress)
+	require.Equal(t, common.Hash{1}, frames[0].BlockHash)
+	require.Equal(t, uint64(2), frames[0].BlockNumber)
+	require.Equal(t, common.Hash{4}, frames[0].TransactionHash)
+	require.Equal(t, uint64(3), frames[0].TransactionPosition)
+
+	require.Equal(t, "call", frames[1].Action.CallType)
+	require.Equal(t, contract, frames[1].Action.From)
+	require.Equal(t, callee, frames[1].Action.To)
+	require.Equal(t, "0x0", frames[1].Action.Value)
+	require.Equal(t, []int{0}, frames[1].TraceAddress)
+
+	require.NoError(t, json.Unmarshal(runTracer(t, "flatCallTracer", `{"includePrecompiles":true}`), &frames))
+	require.Len(t, frames, 3)
+	require.Equal(t, "staticcall", frames[2].Action.CallType)
+	require.Equal(t, []int{1}, frames[2].TraceAddress)
+}
+
+func TestPrestateTracerDiffMode(t *testing.T) {
+	var diff struct {
+		Pre  map[common.Address]map[string]interface{} `json:"pre"`
+		Post map[common.Address]struct {
+			Storage map[common.Hash]common.Hash `json:"storage"`
+		} `json:"post"`
+	}
+	require.NoError(t, json.Unmarshal(runTracer(t, "prestateTracer", `{"diffMode":true}`), &diff))
+
+	// only the callee storage is modified by the call
+	require.Contains(t, diff.Pre, callee)
+	require.NotContains(t, diff.Pre, contract)
+	require.Equal(t, common.BigToHash(big.NewInt(1)), diff.Post[callee].Storage[common.Hash{}])
+}
+
+func TestVMTracer(t *testing.T) {
+	type vmTrace struct {
+		Code string `json:"code"`
+		Ops  []struct {
+			Cost uint64 `json:"cost"`
+			Ex   *struct {
+				Push  []string `json:"push"`
+				Store *struct {
+					Key string `json:"key"`
+					Val string `json:"val"`
+				} `json:"store"`
+				Used uint64 `json:"used"`
+			} `json:"ex"`
+			Pc  uint64          `json:"pc"`
+			Sub json.RawMessage `json:"sub"`
+		} `json:"ops"`
+	}
+
+	var trace vmTrace
+	require.NoError(t, json.Unmarshal(runTracer(t, "vmTracer", ""), &trace))
+	require.Equal(t, []string{"0x0"}, trace.Ops[0].Ex.Push)
+	require.Equal(t, uint64(2), trace.Ops[1].Pc)
+
+	// the eighth opcode is the call, which contains the callee trace
+	call := trace.Ops[7]
+	require.Equal(t, []string{"0x1"}, call.Ex.Push)
+
+	var sub vmTrace
+	require.NoError(t, json.Unmarshal(call.Sub, &sub))
+	require.Equal(t, "0x600160005500", sub.Code)
+	require.Len(t, sub.Ops, 4)
+	require.Equal(t, "0x0", sub.Ops[2].Ex.Store.Key)
+	require.Equal(t, "0x1", sub.Ops[2].Ex.Store.Val)
+}
+
+func TestCallTracerPrecompile(t *testing.T) {
+	precompile := common.HexToAddress("0x0000000000000000000000000000000000000800")
+	frame := &vm.PrecompileFrame{
+		Address: precompile,
+		Method:  "delegate",
+		Args:    map[string]interface{}{"delegatorAddress": caller, "amount": big.NewInt(100)},
+		BalanceChanges: []vm.PrecompileBalanceChange{
+			{Address: caller, Amount: big.NewInt(-100)},
+		},
+		Events: []vm.PrecompileEvent{
+			{Type: "delegate", Attributes: []vm.PrecompileEventAttribute{{Key: "amount", Value: "100unxq"}}},
+		},
+	}
+
+	var res struct {
+		Calls []struct {
+			To         string          `json:"to"`
+			Precompile json.RawMessage `json:"precompile"`
+		} `json:"calls"`
+	}
+	tracer, err := tracers.New("callTracer", &tracers.Context{}, nil)
+	require.NoError(t, err)
+	precompileTracer, ok := tracer.(vm.PrecompileLogger)
+	require.True(t, ok)
+
+	tracer.CaptureStart(nil, caller, contract, false, nil, 100000, big.NewInt(0))
+	tracer.CaptureEnter(vm.CALL, contract, precompile, nil, 50000, big.NewInt(0))
+	precompileTracer.CapturePrecompile(frame)
+	tracer.CaptureExit(nil, 30000, nil)
+	// the frame of another precompile is not recorded on the contract call
+	precompileTracer.CapturePrecompile(frame)
+	tracer.CaptureEnd(nil, 60000, 0, nil)
+
+	out, err := tracer.GetResult()
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(out, &res))
+	require.Len(t, res.Calls, 1)
+	require.JSONEq(t, `{
+		"method": "delegate",
+		"args": {"amount": "0x64", "delegatorAddress": "`+strings.ToLower(caller.Hex())+`"},
+		"balanceChanges": [{"address": "`+strings.ToLower(caller.Hex())+`", "amount": "-0x64"}],
+		"events": [{"type": "delegate", "attributes": [{"key": "amount", "value": "100unxq"}]}]
+	}`, string(res.Calls[0].Precompile))
+	require.Equal(t, 1, strings.Count(string(out), `"precompile"`))
+}
+
+func TestMuxTracer(t *testing.T) {
+	var res map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(runTracer(t, "muxTracer", `{"callTracer":{"onlyTopCall":true},"prestateTracer":{"diffMode":true}}`), &res))
+	require.Len(t, res, 2)
+
+	var call struct {
+		To    common.Address    `json:"to"`
+		Calls []json.RawMessage `json:"calls"`
+	}
+	require.NoError(t, json.Unmarshal(res["callTracer"], &call))
+	require.Equal(t, contract, call.To)
+	require.Empty(t, call.Calls)
+
+	// the results match the ones of the tracers run separately
+	require.JSONEq(t, string(runTracer(t, "prestateTracer", `{"diffMode":true}`)), string(res["prestateTracer"]))
+
+	_, err := tracers.New("muxTracer", &tracers.Context{}, json.RawMessage(`{"unknownTracer":{}}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,323 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+
+	"github.com/evmos/evmos/v19/x/evm/core/tracers"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+func init() {
+	register("vmTracer", newVMTracer)
+}
+
+// vmTrace is the executed code of a call frame in the OpenEthereum (Parity)
+// vmTrace format.
+type vmTrace struct {
+	Code string       `json:"code"`
+	Ops  []*vmTraceOp `json:"ops"`
+}
+
+// vmTraceOp is a single executed opcode. Sub contains the trace of the call
+// frame entered by the call and create opcodes.
+type vmTraceOp struct {
+	Cost uint64     `json:"cost"`
+	Ex   *vmTraceEx `json:"ex"`
+	Pc   uint64     `json:"pc"`
+	Sub  *vmTrace   `json:"sub"`
+}
+
+// vmTraceEx is the state after the execution of an opcode: the remaining gas,
+// the pushed stack items and the memory and storage writes.
+type vmTraceEx struct {
+	Mem   *vmTraceMem   `json:"mem"`
+	Push  []string      `json:"push"`
+	Store *vmTraceStore `json:"store"`
+	Used  uint64        `json:"used"`
+}
+
+type vmTraceMem struct {
+	Data string `json:"data"`
+	Off  uint64 `json:"off"`
+}
+
+type vmTraceStore struct {
+	Key string `json:"key"`
+	Val string `json:"val"`
+}
+
+// vmTraceFrame holds the trace of an active call frame along with the last
+// executed opcode, whose post state is only known once the next opcode starts.
+type vmTraceFrame struct {
+	trace   *vmTrace
+	pending *vmTraceOp
+	op      vm.OpCode
+	gas     uint64
+	cost    uint64
+	memOff  uint64
+	memSize uint64
+}
+
+// vmTracer reports the executed opcodes of a tx in the nested vmTrace format
+// used by the `trace_replayBlockTransactions` and `trace_replayTransaction` methods.
+type vmTracer struct {
+	env       *vm.EVM
+	root      *vmTrace
+	frames    []*vmTraceFrame
+	entered   []bool // whether each entered scope has its own frame
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// newVMTracer returns a native go tracer which records the executed opcodes
+// of a tx, and implements vm.EVMLogger.
+func newVMTracer(_ *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+	return &vmTracer{}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *vmTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	t.root = &vmTrace{Code: t.frameCode(to, input, create), Ops: []*vmTraceOp{}}
+	t.frames = []*vmTraceFrame{{trace: t.root}}
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *vmTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	if len(t.frames) > 0 {
+		t.frames[0].finalize(nil, 0)
+	}
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *vmTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+	if len(t.frames) == 0 {
+		return
+	}
+
+	frame := t.frames[len(t.frames)-1]
+	frame.finalize(scope, gas)
+
+	traceOp := &vmTraceOp{Cost: cost, Pc: pc}
+	frame.trace.Ops = append(frame.trace.Ops, traceOp)
+	// the opcode failed before its execution
+	if err != nil {
+		return
+	}
+
+	frame.pending = traceOp
+	frame.op = op
+	frame.gas = gas
+	frame.cost = cost
+	frame.memOff, frame.memSize = memoryWrite(op, scope.Stack)
+
+	if op == vm.SSTORE && len(scope.Stack.Data) >= 2 {
+		frame.pending.Ex = &vmTraceEx{
+			Store: &vmTraceStore{
+				Key: peekStack(scope.Stack, 0).Hex(),
+				Val: peekStack(scope.Stack, 1).Hex(),
+			},
+		}
+	}
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *vmTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, _ *vm.ScopeContext, depth int, err error) {
+	// the reverted opcode ends the frame as the other halting opcodes
+	if len(t.frames) == 0 || errors.Is(err, vm.ErrExecutionReverted) {
+		return
+	}
+	// the failed opcode has no post state
+	frame := t.frames[len(t.frames)-1]
+	if frame.pending != nil {
+		frame.pending.Ex = nil
+		frame.pending = nil
+	}
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *vmTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// the selfdestruct opcode doesn't execute any code
+	if typ == vm.SELFDESTRUCT || len(t.frames) == 0 {
+		t.entered = append(t.entered, false)
+		return
+	}
+
+	sub := &vmTrace{Code: t.frameCode(to, input, typ == vm.CREATE || typ == vm.CREATE2), Ops: []*vmTraceOp{}}
+	if parent := t.frames[len(t.frames)-1]; parent.pending != nil {
+		parent.pending.Sub = sub
+	}
+	t.frames = append(t.frames, &vmTraceFrame{trace: sub})
+	t.entered = append(t.entered, true)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *vmTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if len(t.entered) == 0 {
+		return
+	}
+	entered := t.entered[len(t.entered)-1]
+	t.entered = t.entered[:len(t.entered)-1]
+	if !entered || len(t.frames) <= 1 {
+		return
+	}
+
+	t.frames[len(t.frames)-1].finalize(nil, 0)
+	t.frames = t.frames[:len(t.frames)-1]
+}
+
+func (*vmTracer) CaptureTxStart(gasLimit uint64) {}
+
+func (*vmTracer) CaptureTxEnd(restGas uint64) {}
+
+// GetResult returns the json-encoded vmTrace of the tx, and any error arising
+// from the encoding or forceful termination (via `Stop`).
+func (t *vmTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.root)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *vmTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// frameCode returns the code executed by a call frame, following the EIP-7702
+// delegation of the called account.
+func (t *vmTracer) frameCode(to common.Address, input []byte, create bool) string {
+	if create {
+		return bytesToHex(input)
+	}
+	code := t.env.StateDB.GetCode(to)
+	if target, ok := vm.ParseDelegation(code); ok {
+		code = t.env.StateDB.GetCode(target)
+	}
+	return bytesToHex(code)
+}
+
+// finalize sets the post state of the pending opcode of the frame. The scope is
+// nil when the frame ends, in which case the remaining gas is derived from the
+// opcode cost.
+func (f *vmTraceFrame) finalize(scope *vm.ScopeContext, gas uint64) {
+	if f.pending == nil {
+		return
+	}
+
+	ex := f.pending.Ex
+	if ex == nil {
+		ex = &vmTraceEx{}
+	}
+	ex.Push = []string{}
+	ex.Used = gas
+	if scope == nil {
+		ex.Used = 0
+		if f.gas > f.cost {
+			ex.Used = f.gas - f.cost
+		}
+	} else {
+		stack := scope.Stack.Data
+		if n := pushCount(f.op); n > 0 && n <= len(stack) {
+			for _, item := range stack[len(stack)-n:] {
+				ex.Push = append(ex.Push, item.Hex())
+			}
+		}
+		if f.memSize > 0 && f.memOff+f.memSize <= uint64(scope.Memory.Len()) {
+			ex.Mem = &vmTraceMem{
+				Data: bytesToHex(scope.Memory.GetCopy(int64(f.memOff), int64(f.memSize))), // #nosec G701 -- bounded by the memory length
+				Off:  f.memOff,
+			}
+		}
+	}
+
+	f.pending.Ex = ex
+	f.pending = nil
+}
+
+// peekStack returns the n-th item from the top of the stack.
+func peekStack(stack *vm.Stack, n int) *uint256.Int {
+	return &stack.Data[len(stack.Data)-1-n]
+}
+
+// memoryWrite returns the memory region written by the opcode, computed from
+// its arguments before the execution.
+func memoryWrite(op vm.OpCode, stack *vm.Stack) (offset, size uint64) {
+	var offsetArg, sizeArg int
+	switch op {
+	case vm.MSTORE:
+		if len(stack.Data) < 1 {
+			return 0, 0
+		}
+		return peekStack(stack, 0).Uint64(), 32
+	case vm.MSTORE8:
+		if len(stack.Data) < 1 {
+			return 0, 0
+		}
+		return peekStack(stack, 0).Uint64(), 1
+	case vm.CALLDATACOPY, vm.CODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+		offsetArg, sizeArg = 0, 2
+	case vm.EXTCODECOPY:
+		offsetArg, sizeArg = 1, 3
+	case vm.CALL, vm.CALLCODE:
+		offsetArg, sizeArg = 5, 6
+	case vm.DELEGATECALL, vm.STATICCALL:
+		offsetArg, sizeArg = 4, 5
+	default:
+		return 0, 0
+	}
+
+	if len(stack.Data) <= sizeArg {
+		return 0, 0
+	}
+	offsetItem, sizeItem := peekStack(stack, offsetArg), peekStack(stack, sizeArg)
+	if !offsetItem.IsUint64() || !sizeItem.IsUint64() {
+		return 0, 0
+	}
+	return offsetItem.Uint64(), sizeItem.Uint64()
+}
+
+// pushCount returns the number of stack items reported as pushed by the opcode.
+// Following the vmTrace format, the DUP and SWAP opcodes report all the stack
+// items they touch.
+func pushCount(op vm.OpCode) int {
+	switch {
+	case op.IsPush(), op == vm.PUSH0:
+		return 1
+	case op >= vm.DUP1 && op <= vm.DUP16:
+		return int(op-vm.DUP1) + 2
+	case op >= vm.SWAP1 && op <= vm.SWAP16:
+		return int(op-vm.SWAP1) + 2
+	case op == vm.CALLDATACOPY, op == vm.CODECOPY, op == vm.EXTCODECOPY, op == vm.RETURNDATACOPY:
+		return 0
+	case op >= vm.ADD && op <= vm.SIGNEXTEND,
+		op >= vm.LT && op <= vm.SAR,
+		op == vm.KECCAK256,
+		op >= vm.ADDRESS && op <= vm.BLOBBASEFEE:
+		return 1
+	}
+
+	switch op {
+	case vm.MLOAD, vm.SLOAD, vm.PC, vm.MSIZE, vm.GAS, vm.TLOAD,
+		vm.CREATE, vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.CREATE2, vm.STATICCALL:
+		return 1
+	}
+	return 0
+}
@@ -88,6 +88,7 @@ func memoryCopierGas(stackpos int) gasFunc {
 
 var (
 	gasCallDataCopy   = memoryCopierGas(2)
+	gasMcopy          = memoryCopierGas(2)
 	gasCodeCopy       = memoryCopierGas(2)
 	gasExtCodeCopy    = memoryCopierGas(3)
 	gasReturnDataCopy = memoryCopierGas(2)
@@ -22,11 +22,15 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
@@ -714,3 +718,230 @@ func TestRandom(t *testing.T) {
 		}
 	}
 }
+
+func TestOpTstore(t *testing.T) {
+	var (
+		statedb, _     = state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		env            = NewEVM(BlockContext{}, TxContext{}, newTestStateDB(statedb), params.TestChainConfig, Config{})
+		stack, err     = NewStack()
+		mem            = NewMemory()
+		evmInterpreter = NewEVMInterpreter(env, env.Config)
+		caller         = common.Address{}
+		to             = common.Address{1}
+		contract       = NewContract(AccountRef(caller), AccountRef(to), new(big.Int), 0)
+		scopeContext   = ScopeContext{mem, stack, contract}
+		value          = common.Hex2Bytes("abcdef00000000000000abba000000000deaf000000c0de00100000000133700")
+	)
+
+	require.NoError(t, err)
+
+	env.interpreter = evmInterpreter
+	pc := uint64(0)
+	// push the value and the location to the stack
+	stack.Push(new(uint256.Int).SetBytes(value))
+	stack.Push(new(uint256.Int))
+	_, err = opTstore(&pc, evmInterpreter, &scopeContext)
+	require.NoError(t, err)
+	// there should be no elements on the stack after TSTORE
+	require.Len(t, stack.Data, 0)
+	// the value is not written to the persistent storage
+	require.Equal(t, common.Hash{}, env.StateDB.GetState(to, common.Hash{}))
+
+	// push the location to the stack
+	stack.Push(new(uint256.Int))
+	_, err = opTload(&pc, evmInterpreter, &scopeContext)
+	require.NoError(t, err)
+	// there should be one element on the stack after TLOAD
+	require.Len(t, stack.Data, 1)
+	require.Equal(t, value, stack.Peek().Bytes())
+
+	// the transient storage is per contract
+	scopeContext.Contract = NewContract(AccountRef(caller), AccountRef(common.Address{2}), new(big.Int), 0)
+	_, err = opTload(&pc, evmInterpreter, &scopeContext)
+	require.NoError(t, err)
+	require.True(t, stack.Peek().IsZero())
+
+	// TSTORE is not allowed in a static call
+	evmInterpreter.readOnly = true
+	stack.Push(new(uint256.Int).SetBytes(value))
+	stack.Push(new(uint256.Int))
+	_, err = opTstore(&pc, evmInterpreter, &scopeContext)
+	require.ErrorIs(t, err, ErrWriteProtection)
+}
+
+func TestOpMCopy(t *testing.T) {
+	// Test cases from https://eips.ethereum.org/EIPS/eip-5656#test-cases
+	for i, tc := range []struct {
+		dst, src, len string
+		pre           string
+		want          string
+		wantGas       uint64
+	}{
+		{ // MCOPY 0 32 32 - copy 32 bytes from offset 32 to offset 0.
+			dst: "0x0", src: "0x20", len: "0x20",
+			pre:     "0000000000000000000000000000000000000000000000000000000000000000 000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			want:    "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f 000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			wantGas: 6,
+		},
+		{ // MCOPY 0 0 32 - copy 32 bytes from offset 0 to offset 0.
+			dst: "0x0", src: "0x0", len: "0x20",
+			pre:     "0101010101010101010101010101010101010101010101010101010101010101",
+			want:    "0101010101010101010101010101010101010101010101010101010101010101",
+			wantGas: 6,
+		},
+		{ // MCOPY 0 1 8 - copy 8 bytes from offset 1 to offset 0 (overlapping).
+			dst: "0x0", src: "0x1", len: "0x8",
+			pre:     "000102030405060708 0000000000000000000000000000000000000000000000",
+			want:    "010203040506070808 0000000000000000000000000000000000000000000000",
+			wantGas: 6,
+		},
+		{ // MCOPY 1 0 8 - copy 8 bytes from offset 0 to offset 1 (overlapping).
+			dst: "0x1", src: "0x0", len: "0x8",
+			pre:     "000102030405060708 0000000000000000000000000000000000000000000000",
+			want:    "000001020304050607 0000000000000000000000000000000000000000000000",
+			wantGas: 6,
+		},
+		// Tests below are not in the EIP
+		{ // MCOPY 32 0 32 - copy 32 bytes from offset 0 to offset 32, expanding the memory.
+			dst: "0x20", src: "0x0", len: "0x20",
+			pre:     "0101010101010101010101010101010101010101010101010101010101010101",
+			want:    "0101010101010101010101010101010101010101010101010101010101010101 0101010101010101010101010101010101010101010101010101010101010101",
+			wantGas: 9,
+		},
+		{ // MCOPY 0xFFFFFFFFFFFF 0xFFFFFFFFFFFF 0 - copy zero bytes from out-of-bounds index (overlapping).
+			dst: "0xFFFFFFFFFFFF", src: "0xFFFFFFFFFFFF", len: "0x0",
+			pre:     "11",
+			want:    "11",
+			wantGas: 3,
+		},
+		{ // MCOPY 0xFFFFFFFFFFFF 0 0 - copy zero bytes from start of mem to out-of-bounds.
+			dst: "0xFFFFFFFFFFFF", src: "0x0", len: "0x0",
+			pre:     "11",
+			want:    "11",
+			wantGas: 3,
+		},
+		{ // MCOPY 0 0xFFFFFFFFFFFF 0 - copy zero bytes from out-of-bounds to start of mem.
+			dst: "0x0", src: "0xFFFFFFFFFFFF", len: "0x0",
+			pre:     "11",
+			want:    "11",
+			wantGas: 3,
+		},
+		{ // MCOPY - copy 1 from space outside of uint64 space.
+			dst: "0x0", src: "0x10000000000000000", len: "0x1",
+			pre: "00",
+		},
+		{ // MCOPY - copy 1 from 0 to space outside of uint64.
+			dst: "0x10000000000000000", src: "0x0", len: "0x1",
+			pre: "00",
+		},
+		{ // MCOPY - copy nothing from 0 to space outside of uint64.
+			dst: "0x10000000000000000", src: "0x0", len: "0x0",
+			pre:     "",
+			want:    "",
+			wantGas: 3,
+		},
+	} {
+		var (
+			env            = NewEVM(BlockContext{}, TxContext{}, nil, params.TestChainConfig, Config{})
+			stack, err     = NewStack()
+			pc             = uint64(0)
+			evmInterpreter = env.interpreter.(*EVMInterpreter)
+		)
+		require.NoError(t, err)
+
+		data := common.FromHex(strings.ReplaceAll(tc.pre, " ", ""))
+		// Set pre, charging its expansion so only the new words are paid
+		mem := NewMemory()
+		_, err = memoryGasCost(mem, uint64(len(data)))
+		require.NoError(t, err)
+		mem.Resize(uint64(len(data)))
+		mem.Set(0, uint64(len(data)), data)
+		// Push stack args
+		length, _ := uint256.FromHex(tc.len)
+		src, _ := uint256.FromHex(tc.src)
+		dst, _ := uint256.FromHex(tc.dst)
+		stack.Push(length)
+		stack.Push(src)
+		stack.Push(dst)
+
+		wantErr := tc.wantGas == 0
+		// Calc mem expansion
+		memSize, overflow := memoryMcopy(stack)
+		if overflow {
+			require.True(t, wantErr, "test %d: unexpected overflow", i)
+			continue
+		}
+		memorySize, overflow := math.SafeMul(toWordSize(memSize), 32)
+		require.False(t, overflow, "test %d", i)
+		// and the dynamic cost
+		dynamicCost, err := gasMcopy(env, nil, stack, mem, memorySize)
+		require.NoError(t, err, "test %d", i)
+		require.Equal(t, tc.wantGas, GasFastestStep+dynamicCost, "test %d: gas mismatch", i)
+
+		// Expand mem and do the copy
+		if memorySize > 0 {
+			mem.Resize(memorySize)
+		}
+		_, err = opMcopy(&pc, evmInterpreter, &ScopeContext{mem, stack, nil})
+		require.NoError(t, err)
+
+		want := common.FromHex(strings.ReplaceAll(tc.want, " ", ""))
+		require.Equal(t, common.Bytes2Hex(want), common.Bytes2Hex(mem.store), "test %d: memory mismatch", i)
+	}
+}
+
+func TestOpBlobBaseFee(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		blobBaseFee *big.Int
+		expected    uint64
+	}{
+		{name: "not set", blobBaseFee: nil, expected: 0},
+		{name: "minimum", blobBaseFee: big.NewInt(1), expected: 1},
+		{name: "1 gwei", blobBaseFee: big.NewInt(1_000_000_000), expected: 1_000_000_000},
+	} {
+		var (
+			env         = NewEVM(BlockContext{BlobBaseFee: tt.blobBaseFee}, TxContext{}, nil, params.TestChainConfig, Config{})
+			stack, err  = NewStack()
+			pc          = uint64(0)
+			interpreter = env.interpreter
+		)
+
+		require.NoError(t, err)
+
+		_, err = opBlobBaseFee(&pc, interpreter.(*EVMInterpreter), &ScopeContext{nil, stack, nil})
+		require.NoError(t, err, tt.name)
+		require.Len(t, stack.Data, 1, tt.name)
+		actual := stack.Pop()
+		require.Equal(t, tt.expected, actual.Uint64(), tt.name)
+	}
+}
+
+func TestOpBlobHash(t *testing.T) {
+	hash := common.HexToHash("0x01a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8")
+	for _, tt := range []struct {
+		name     string
+		index    uint64
+		hashes   []common.Hash
+		expected common.Hash
+	}{
+		{name: "no blob hashes", index: 0, hashes: nil, expected: common.Hash{}},
+		{name: "in range", index: 0, hashes: []common.Hash{hash}, expected: hash},
+		{name: "out of range", index: 1, hashes: []common.Hash{hash}, expected: common.Hash{}},
+	} {
+		var (
+			env         = NewEVM(BlockContext{}, TxContext{BlobHashes: tt.hashes}, nil, params.TestChainConfig, Config{})
+			stack, err  = NewStack()
+			pc          = uint64(0)
+			interpreter = env.interpreter
+		)
+
+		require.NoError(t, err)
+
+		stack.Push(new(uint256.Int).SetUint64(tt.index))
+		_, err = opBlobHash(&pc, interpreter.(*EVMInterpreter), &ScopeContext{nil, stack, nil})
+		require.NoError(t, err, tt.name)
+		actual := stack.Pop()
+		require.Equal(t, tt.expected, common.Hash(actual.Bytes32()), tt.name)
+	}
+}
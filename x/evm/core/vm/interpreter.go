@@ -70,6 +70,10 @@ type EVMInterpreter struct {
 func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 	// If jump table was not initialised we set the default one.
 	if cfg.JumpTable == nil {
+		// NOTE: the Cancun instructions are not activated from the chain config, as
+		// its Cancun block is already set on existing chains. They are enabled
+		// through the extra EIPs instead, so that past blocks are replayed with the
+		// instructions that were active at their height.
 		cfg.JumpTable = DefaultJumpTable(evm.chainRules)
 		for i, eip := range cfg.ExtraEips {
 			if len(cfg.ExtraEips) == 1 && eip == "\x8f\x1e" {
@@ -26,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
 )
 
 var loopInterruptTests = []string{
@@ -47,7 +48,7 @@ func TestLoopInterrupt(t *testing.T) {
 		statedb.SetCode(address, common.Hex2Bytes(tt))
 		statedb.Finalise(true)
 
-		evm := NewEVM(vmctx, TxContext{}, statedb, params.AllEthashProtocolChanges, Config{})
+		evm := NewEVM(vmctx, TxContext{}, newTestStateDB(statedb), params.AllEthashProtocolChanges, Config{})
 
 		errChannel := make(chan error)
 		timeout := make(chan bool)
@@ -74,3 +75,170 @@ func TestLoopInterrupt(t *testing.T) {
 		}
 	}
 }
+
+// cancunEIPs are the extra EIPs that enable the Cancun instructions.
+var cancunEIPs = []string{
+	"ethereum_3855",
+	"ethereum_4844",
+	"ethereum_7516",
+	"ethereum_1153",
+	"ethereum_5656",
+	"ethereum_6780",
+}
+
+func newCancunEVM(statedb StateDB, cancun bool) *EVM {
+	// the Cancun block of the chain config doesn't activate the Cancun instructions
+	chainConfig := *params.TestChainConfig
+	chainConfig.CancunBlock = big.NewInt(0)
+	var config Config
+	if cancun {
+		config.ExtraEips = append([]string{}, cancunEIPs...)
+	}
+	vmctx := BlockContext{
+		CanTransfer: func(db StateDB, addr common.Address, amount *big.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db StateDB, sender, recipient common.Address, amount *big.Int) {
+			db.SubBalance(sender, amount)
+			db.AddBalance(recipient, amount)
+		},
+		BlockNumber: big.NewInt(1),
+		BlobBaseFee: big.NewInt(1),
+	}
+	return NewEVM(vmctx, TxContext{}, statedb, &chainConfig, config)
+}
+
+func TestCancunActivation(t *testing.T) {
+	address := common.BytesToAddress([]byte("contract"))
+	testCases := []struct {
+		name string
+		code string
+		want uint64
+	}{
+		// push(42) push(0) tstore push(0) tload push(0) mstore push(32) push(0) return
+		{"TSTORE and TLOAD", "602a60005d60005c60005260206000f3", 42},
+		// push(42) push(32) mstore push(32) push(32) push(0) mcopy push(32) push(0) return
+		{"MCOPY", "602a6020526020602060005e60206000f3", 42},
+		// blobbasefee push(0) mstore push(32) push(0) return
+		{"BLOBBASEFEE", "4a60005260206000f3", 1},
+	}
+
+	for _, tc := range testCases {
+		for _, cancun := range []bool{true, false} {
+			statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+			statedb.CreateAccount(address)
+			statedb.SetCode(address, common.Hex2Bytes(tc.code))
+			statedb.Finalise(true)
+
+			evm := newCancunEVM(newTestStateDB(statedb), cancun)
+			ret, _, err := evm.Call(AccountRef(common.Address{}), address, nil, 100000, new(big.Int))
+			if !cancun {
+				require.Error(t, err, tc.name)
+				require.IsType(t, &ErrInvalidOpCode{}, err, tc.name)
+				continue
+			}
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.want, new(big.Int).SetBytes(ret).Uint64(), tc.name)
+		}
+	}
+}
+
+func TestSelfdestruct6780(t *testing.T) {
+	var (
+		caller      = common.BytesToAddress([]byte("caller"))
+		address     = common.BytesToAddress([]byte("contract"))
+		beneficiary = common.BytesToAddress([]byte("beneficiary"))
+		// push20(beneficiary) selfdestruct
+		code = append(append([]byte{byte(PUSH20)}, beneficiary.Bytes()...), byte(SELFDESTRUCT))
+	)
+
+	// a contract created before the transaction only sends its balance
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	statedb.CreateAccount(address)
+	statedb.SetCode(address, code)
+	statedb.AddBalance(address, big.NewInt(100))
+	statedb.Finalise(true)
+
+	db := newTestStateDB(statedb)
+	evm := newCancunEVM(db, true)
+	_, _, err := evm.Call(AccountRef(caller), address, nil, 100000, new(big.Int))
+	require.NoError(t, err)
+	require.False(t, db.HasSuicided(address))
+	require.Equal(t, code, db.GetCode(address))
+	require.Equal(t, int64(0), db.GetBalance(address).Int64())
+	require.Equal(t, int64(100), db.GetBalance(beneficiary).Int64())
+
+	// a contract created in the same transaction is deleted
+	statedb, _ = state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	statedb.CreateAccount(caller)
+	statedb.AddBalance(caller, big.NewInt(100))
+	statedb.Finalise(true)
+
+	db = newTestStateDB(statedb)
+	evm = newCancunEVM(db, true)
+	_, created, _, err := evm.Create(AccountRef(caller), code, 100000, big.NewInt(100))
+	require.NoError(t, err)
+	require.True(t, db.HasSuicided(created))
+	require.Equal(t, int64(100), db.GetBalance(beneficiary).Int64())
+
+	// before Cancun the contract is always deleted
+	statedb, _ = state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	statedb.CreateAccount(address)
+	statedb.SetCode(address, code)
+	statedb.AddBalance(address, big.NewInt(100))
+	statedb.Finalise(true)
+
+	db = newTestStateDB(statedb)
+	evm = newCancunEVM(db, false)
+	_, _, err = evm.Call(AccountRef(caller), address, nil, 100000, new(big.Int))
+	require.NoError(t, err)
+	require.True(t, db.HasSuicided(address))
+	require.Equal(t, int64(100), db.GetBalance(beneficiary).Int64())
+}
+
+func TestDelegation(t *testing.T) {
+	var (
+		caller    = common.BytesToAddress([]byte("caller"))
+		delegated = common.BytesToAddress([]byte("delegated"))
+		target    = common.BytesToAddress([]byte("target"))
+		// mstore(0, 42) return(0, 32)
+		targetCode = common.Hex2Bytes("602a60005260206000f3")
+	)
+
+	require.Equal(t, 23, len(AddressToDelegation(target)))
+	parsed, ok := ParseDelegation(AddressToDelegation(target))
+	require.True(t, ok)
+	require.Equal(t, target, parsed)
+	_, ok = ParseDelegation(targetCode)
+	require.False(t, ok)
+	_, ok = ParseDelegation(append(AddressToDelegation(target), 0x00))
+	require.False(t, ok)
+
+	newState := func() *testStateDB {
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		statedb.SetCode(delegated, AddressToDelegation(target))
+		statedb.SetCode(target, targetCode)
+		// call(0xffff, delegated, 0, 0, 0, 0, 0)
+		callCode := append(common.Hex2Bytes("60006000600060006000"), byte(PUSH20))
+		callCode = append(callCode, delegated.Bytes()...)
+		statedb.SetCode(caller, append(callCode, common.Hex2Bytes("61fffff1")...))
+		statedb.Finalise(true)
+		return newTestStateDB(statedb)
+	}
+
+	// calling a delegated account executes the code of the delegation target
+	evm := newCancunEVM(newState(), true)
+	ret, _, err := evm.Call(AccountRef(caller), delegated, nil, 100000, new(big.Int))
+	require.NoError(t, err)
+	require.Equal(t, int64(42), new(big.Int).SetBytes(ret).Int64())
+
+	// with EIP-7702 enabled, loading a cold delegation target is charged
+	callGas := func(extraEips []string) uint64 {
+		vmctx := newCancunEVM(nil, true).Context
+		evm := NewEVM(vmctx, TxContext{}, newState(), params.AllEthashProtocolChanges, Config{ExtraEips: extraEips})
+		_, leftOver, err := evm.Call(AccountRef(common.Address{}), caller, nil, 100000, new(big.Int))
+		require.NoError(t, err)
+		return 100000 - leftOver
+	}
+	require.Equal(t, params.ColdAccountAccessCostEIP2929, callGas([]string{"ethereum_7702"})-callGas(nil))
+}
@@ -42,3 +42,39 @@ type EVMLogger interface {
 	CaptureState(pc uint64, op OpCode, gas, cost uint64, scope *ScopeContext, rData []byte, depth int, err error)
 	CaptureFault(pc uint64, op OpCode, gas, cost uint64, scope *ScopeContext, depth int, err error)
 }
+
+// PrecompileLogger is an optional interface of the EVMLogger used to collect the
+// Cosmos SDK side effects of the stateful precompiles. CapturePrecompile is
+// called within the call frame of the precompile once its execution succeeded.
+type PrecompileLogger interface {
+	CapturePrecompile(frame *PrecompileFrame)
+}
+
+// PrecompileFrame contains the decoded method and arguments of a stateful
+// precompile call along with the balance changes and SDK events it produced.
+type PrecompileFrame struct {
+	Address        common.Address
+	Method         string
+	Args           map[string]interface{}
+	BalanceChanges []PrecompileBalanceChange
+	Events         []PrecompileEvent
+}
+
+// PrecompileBalanceChange is the net change of an account balance in the EVM
+// denomination. A negative amount is a balance decrease.
+type PrecompileBalanceChange struct {
+	Address common.Address
+	Amount  *big.Int
+}
+
+// PrecompileEvent is an SDK event emitted by a precompile call.
+type PrecompileEvent struct {
+	Type       string
+	Attributes []PrecompileEventAttribute
+}
+
+// PrecompileEventAttribute is a key value attribute of a PrecompileEvent.
+type PrecompileEventAttribute struct {
+	Key   string
+	Value string
+}
@@ -16,7 +16,13 @@
 
 package vm
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
 
 // OpCodeHooks is a set of hooks that can be used to intercept and modify the
 // behavior of the EVM when executing certain opcodes.
@@ -45,3 +51,47 @@ func newNoopOpCodeHooks() OpCodeHooks {
 func NewDefaultOpCodeHooks() OpCodeHooks {
 	return newNoopOpCodeHooks()
 }
+
+// HookRevertError is returned by the opcode hooks to revert the call or the
+// contract creation with the error message as revert reason, instead of
+// failing it.
+type HookRevertError struct {
+	Err error
+}
+
+func (e *HookRevertError) Error() string { return e.Err.Error() }
+
+func (e *HookRevertError) Unwrap() error { return e.Err }
+
+// hookResult returns the return data and the error of a call rejected by an
+// opcode hook.
+func hookResult(err error) ([]byte, error) {
+	var revertErr *HookRevertError
+	if !errors.As(err, &revertErr) {
+		return nil, err
+	}
+	return RevertReason(revertErr.Error()), ErrExecutionReverted
+}
+
+// RevertReason returns the ABI encoding of the Error(string) revert reason.
+func RevertReason(reason string) []byte {
+	data, err := revertReasonArgs.Pack(reason)
+	if err != nil {
+		// packing a string never fails
+		panic(err)
+	}
+	return append(common.CopyBytes(revertSelector), data...)
+}
+
+var (
+	revertSelector   = crypto.Keccak256([]byte("Error(string)"))[:4]
+	revertReasonArgs = abi.Arguments{{Type: mustNewType("string")}}
+)
+
+func mustNewType(typ string) abi.Type {
+	t, err := abi.NewType(typ, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
@@ -0,0 +1,67 @@
+package vm
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+type rejectHooks struct {
+	err error
+}
+
+func (h rejectHooks) CallHook(*EVM, common.Address, common.Address) error { return h.err }
+
+func (h rejectHooks) CreateHook(*EVM, common.Address) error { return h.err }
+
+func TestHookRevertError(t *testing.T) {
+	errRejected := errors.New("rejected by policy")
+	caller := common.BytesToAddress([]byte("caller"))
+	gas := uint64(100000)
+
+	testCases := []struct {
+		name      string
+		err       error
+		expErr    error
+		expReason string
+	}{
+		{"hook error fails the call", errRejected, errRejected, ""},
+		{"hook revert error reverts with reason", &HookRevertError{Err: errRejected}, ErrExecutionReverted, errRejected.Error()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+			vmctx := BlockContext{
+				CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+				Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+				BlockNumber: big.NewInt(0),
+			}
+			evm := NewEVMWithHooks(rejectHooks{tc.err}, vmctx, TxContext{}, newTestStateDB(statedb), params.AllEthashProtocolChanges, Config{})
+
+			ret, leftOverGas, err := evm.Call(AccountRef(caller), common.Address{}, nil, gas, new(big.Int))
+			require.ErrorIs(t, err, tc.expErr)
+			require.Equal(t, gas, leftOverGas)
+
+			createRet, _, createLeftOverGas, createErr := evm.Create(AccountRef(caller), nil, gas, new(big.Int))
+			require.ErrorIs(t, createErr, tc.expErr)
+			require.Equal(t, gas, createLeftOverGas)
+			require.Equal(t, ret, createRet)
+
+			if tc.expReason == "" {
+				require.Nil(t, ret)
+				return
+			}
+			reason, err := abi.UnpackRevert(ret)
+			require.NoError(t, err)
+			require.Equal(t, tc.expReason, reason)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package vm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// testStateDB extends the go-ethereum state with the transient storage and the
+// EIP-6780 SELFDESTRUCT, which are not implemented by its version of the StateDB.
+type testStateDB struct {
+	*state.StateDB
+
+	created   map[common.Address]bool
+	transient map[common.Address]map[common.Hash]common.Hash
+}
+
+var _ StateDB = &testStateDB{}
+
+func newTestStateDB(statedb *state.StateDB) *testStateDB {
+	return &testStateDB{
+		StateDB:   statedb,
+		created:   make(map[common.Address]bool),
+		transient: make(map[common.Address]map[common.Hash]common.Hash),
+	}
+}
+
+func (s *testStateDB) CreateAccount(addr common.Address) {
+	s.StateDB.CreateAccount(addr)
+	s.created[addr] = true
+}
+
+func (s *testStateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transient[addr][key]
+}
+
+func (s *testStateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	if _, ok := s.transient[addr]; !ok {
+		s.transient[addr] = make(map[common.Hash]common.Hash)
+	}
+	s.transient[addr][key] = value
+}
+
+func (s *testStateDB) Selfdestruct6780(addr common.Address) {
+	if s.created[addr] {
+		s.Suicide(addr)
+	}
+}
@@ -10,9 +10,18 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper and records
+// the block proposer so that it can be queried for historical heights. Only the
+// proposers of the blocks within the retention defined by the EVM params are kept.
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	k.WithChainID(ctx)
+
+	retention := k.GetParams(ctx).BlockProposerRetention
+	if proposerAddress := ctx.BlockHeader().ProposerAddress; retention > 0 && len(proposerAddress) != 0 {
+		k.SetBlockProposer(ctx, ctx.BlockHeight(), proposerAddress)
+	}
+
+	k.PruneBlockProposers(ctx, retention)
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
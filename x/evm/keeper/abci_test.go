@@ -16,3 +16,65 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 	suite.Require().Equal(1, len(em.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
 }
+
+func (suite *KeeperTestSuite) TestBeginBlock() {
+	suite.app.EvmKeeper.BeginBlock(suite.ctx, types.RequestBeginBlock{})
+
+	proposer, found := suite.app.EvmKeeper.GetBlockProposer(suite.ctx, suite.ctx.BlockHeight())
+	suite.Require().True(found)
+	suite.Require().Equal(suite.consAddress, proposer)
+
+	// should not record a proposer for a block without one
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithProposer(nil)
+	suite.app.EvmKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+
+	_, found = suite.app.EvmKeeper.GetBlockProposer(ctx, ctx.BlockHeight())
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockProposerRetention() {
+	testCases := []struct {
+		name      string
+		retention uint64
+		expFound  []bool
+	}{
+		{
+			"proposers older than the retention are pruned",
+			3,
+			[]bool{false, false, true, true, true},
+		},
+		{
+			"retention longer than the chain",
+			10,
+			[]bool{true, true, true, true, true},
+		},
+		{
+			"zero retention prunes all the proposers",
+			0,
+			[]bool{false, false, false, false, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// record the proposers of the heights 1 to 4 before updating the retention
+			for height := int64(1); height < 5; height++ {
+				suite.app.EvmKeeper.SetBlockProposer(suite.ctx, height, suite.consAddress)
+			}
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.BlockProposerRetention = tc.retention
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			ctx := suite.ctx.WithBlockHeight(5)
+			suite.app.EvmKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+
+			for i, expFound := range tc.expFound {
+				_, found := suite.app.EvmKeeper.GetBlockProposer(ctx, int64(i+1))
+				suite.Require().Equal(expFound, found, "height %d", i+1)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/x/evm/types"
 )
 
 // GetCoinbaseAddress returns the block proposer's validator operator address.
@@ -31,3 +32,87 @@ func GetProposerAddress(ctx sdk.Context, proposerAddress sdk.ConsAddress) sdk.Co
 	}
 	return proposerAddress
 }
+
+// SetBlockProposer stores the consensus address of the proposer of the block
+// at the given height.
+func (k Keeper) SetBlockProposer(ctx sdk.Context, height int64, proposerAddress sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockProposerKey(height), proposerAddress)
+}
+
+// PruneBlockProposers deletes the proposers of the blocks that are older than
+// the given retention at the current height. All the proposers are deleted when
+// the retention is zero.
+func (k Keeper) PruneBlockProposers(ctx sdk.Context, retention uint64) {
+	// the proposers of the heights lower than minHeight are deleted
+	minHeight := ctx.BlockHeight() + 1
+	if retention < uint64(minHeight) { // #nosec G701 -- block heights are always positive
+		minHeight -= int64(retention) // #nosec G701 -- retention is lower than the block height
+	} else {
+		minHeight = 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixBlockProposer, types.BlockProposerKey(minHeight))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetBlockProposer returns the consensus address of the proposer of the block
+// at the given height, if it was recorded.
+func (k Keeper) GetBlockProposer(ctx sdk.Context, height int64) (sdk.ConsAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockProposerKey(height))
+	if len(bz) == 0 {
+		return nil, false
+	}
+	return sdk.ConsAddress(bz), true
+}
+
+// IterateBlockProposers iterates over the recorded block proposers within the
+// inclusive height range and calls cb for each of them. The iteration stops
+// when cb returns true.
+func (k Keeper) IterateBlockProposers(
+	ctx sdk.Context,
+	startHeight, endHeight int64,
+	cb func(height int64, proposerAddress sdk.ConsAddress) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.BlockProposerKey(startHeight), types.BlockProposerKey(endHeight+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		height := int64(sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixBlockProposer):])) // #nosec G701 -- heights are stored from int64 values
+		if cb(height, iterator.Value()) {
+			break
+		}
+	}
+}
+
+// GetBlockProposerInfo resolves the validator operator, coinbase address and
+// moniker of the given proposer. The validator fields are left empty if the
+// validator no longer exists.
+func (k Keeper) GetBlockProposerInfo(ctx sdk.Context, height int64, proposerAddress sdk.ConsAddress) types.BlockProposer {
+	proposer := types.BlockProposer{
+		Height:      height,
+		ConsAddress: proposerAddress.String(),
+	}
+
+	validator, found := k.stakingKeeper.GetValidatorByConsAddr(ctx, proposerAddress)
+	if !found {
+		return proposer
+	}
+
+	proposer.ValidatorAddress = validator.GetOperator().String()
+	proposer.Coinbase = common.BytesToAddress(validator.GetOperator()).Hex()
+	proposer.Moniker = validator.GetMoniker()
+	return proposer
+}
@@ -0,0 +1,30 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// SetAcceptedFeeGranter stores the fee granter that the grantee accepts to pay
+// the fees of its Ethereum transactions. The zero address removes it.
+func (k Keeper) SetAcceptedFeeGranter(ctx sdk.Context, grantee, granter common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	if granter == (common.Address{}) {
+		store.Delete(types.AcceptedFeeGranterKey(grantee))
+		return
+	}
+	store.Set(types.AcceptedFeeGranterKey(grantee), granter.Bytes())
+}
+
+// GetAcceptedFeeGranter returns the fee granter accepted by the grantee, if any.
+func (k Keeper) GetAcceptedFeeGranter(ctx sdk.Context, grantee common.Address) (common.Address, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AcceptedFeeGranterKey(grantee))
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
@@ -500,7 +500,13 @@ func (suite *KeeperTestSuite) TestVerifyFeeAndDeductTxCostsFromUserBalance() {
 			baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, ethCfg)
 			priority := evmtypes.GetTxPriority(txData, baseFee)
 
-			fees, err := keeper.VerifyFee(txData, evmtypes.DefaultEVMDenom, baseFee, false, false, suite.ctx.IsCheckTx())
+			msg := ethtypes.NewMessage(
+				common.HexToAddress(tc.from), txData.GetTo(), txData.GetNonce(), txData.GetValue(), txData.GetGas(),
+				txData.GetGasPrice(), txData.GetGasFeeCap(), txData.GetGasTipCap(), txData.GetData(), txData.GetAccessList(), false,
+			)
+			fees, err := suite.app.EvmKeeper.VerifyFee(
+				suite.ctx, msg, txData, evmtypes.DefaultEVMDenom, baseFee, ethCfg, evmParams, suite.ctx.IsCheckTx(),
+			)
 			if tc.expectPassVerify {
 				suite.Require().NoError(err, "valid test %d failed - '%s'", i, tc.name)
 				if tc.enableFeemarket {
@@ -3,6 +3,7 @@
 package keeper
 
 import (
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core"
@@ -17,13 +18,52 @@ import (
 	"github.com/evmos/evmos/v19/x/evm/types"
 )
 
-// GetEthIntrinsicGas returns the intrinsic gas cost for the transaction
-func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *params.ChainConfig, isContractCreation bool) (uint64, error) {
+// GetEthIntrinsicGas returns the intrinsic gas cost for the transaction, including the
+// contract creation surcharge and the system call discount defined by the EVM params.
+func (k *Keeper) GetEthIntrinsicGas(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *params.ChainConfig,
+	evmParams types.Params,
+	isContractCreation bool,
+) (uint64, error) {
 	height := big.NewInt(ctx.BlockHeight())
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	gas, err := core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+
+	// EIP-7702 set code transactions pay for every authorization in the list
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		gas += types.AuthorizationListGas(setCodeMsg.AuthList)
+	}
+
+	// Contract creations pay a surcharge for every byte of the init code
+	if isContractCreation && evmParams.ContractCreationByteGas > 0 {
+		dataLen := uint64(len(msg.Data()))
+		if (math.MaxUint64-gas)/evmParams.ContractCreationByteGas < dataLen {
+			return 0, core.ErrGasUintOverflow
+		}
+		gas += dataLen * evmParams.ContractCreationByteGas
+	}
+
+	// Calls to the system contracts get a discount on the intrinsic gas
+	if !isContractCreation && msg.To() != nil && evmParams.IsSystemContract(*msg.To()) {
+		gas -= GasDiscount(gas, evmParams.SystemCallGasDiscountBPS)
+	}
+
+	return gas, nil
+}
+
+// GasDiscount returns the discount of the given gas amount for a discount in basis points.
+func GasDiscount(gas uint64, discountBPS uint32) uint64 {
+	return sdkmath.NewIntFromUint64(gas).
+		MulRaw(int64(discountBPS)).
+		QuoRaw(types.MaxGasDiscountBPS).
+		Uint64()
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
@@ -31,6 +71,11 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
+	return k.refundGas(ctx, msg, msg.From().Bytes(), leftoverGas, denom)
+}
+
+// refundGas transfers the leftover gas of the message to the given recipient.
+func (k *Keeper) refundGas(ctx sdk.Context, msg core.Message, recipient sdk.AccAddress, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
 
@@ -42,9 +87,9 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to the fee payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
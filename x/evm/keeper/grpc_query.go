@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/evmos/evmos/v19/x/evm/core/logger"
@@ -256,7 +257,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	log.Println("TX Config", txConfig.TxHash.Hex(), txConfig.TxIndex)
 
 	// pass false to not commit StateDB
-	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
+	res, err := k.ApplyMessageWithConfig(ctx, args.WithAuthorizations(msg), nil, false, cfg, txConfig)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -341,6 +342,12 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// The intrinsic gas of the discounted system calls can be lower than the base tx gas
+	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, cfg.Params, msg.To() == nil)
+	if err == nil && intrinsicGas > 0 && intrinsicGas <= lo {
+		lo = intrinsicGas - 1
+	}
+
 	// NOTE: the errors from the executable below should be consistent with go-ethereum,
 	// so we don't wrap them with the gRPC status code
 
@@ -384,7 +391,7 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 			tmpCtx = evmante.BuildEvmExecutionCtx(tmpCtx).WithGasMeter(gasMeter)
 		}
 		// pass false to not commit StateDB
-		rsp, err = k.ApplyMessageWithConfig(tmpCtx, msg, nil, false, cfg, txConfig)
+		rsp, err = k.ApplyMessageWithConfig(tmpCtx, args.WithAuthorizations(msg), nil, false, cfg, txConfig)
 		if err != nil {
 			if errors.Is(err, core.ErrIntrinsicGas) {
 				return true, nil, nil // Special case, raise gas limit
@@ -474,12 +481,11 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	// and avoid stacking the gas used of every predecessor in the same gas meter
 
 	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		msg, err := tx.AsMessage(signer, cfg.BaseFee)
 		if err != nil {
 			continue
 		}
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.ComputeHash()
 		txConfig.TxIndex = uint(i)
 		// reset gas meter for each transaction
 		ctx = evmante.BuildEvmExecutionCtx(ctx).
@@ -491,8 +497,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		txConfig.LogIndex += uint(len(rsp.Logs))
 	}
 
-	tx := req.Msg.AsTransaction()
-	txConfig.TxHash = tx.Hash()
+	txConfig.TxHash = req.Msg.ComputeHash()
 	if len(req.Predecessors) > 0 {
 		txConfig.TxIndex++
 	}
@@ -503,7 +508,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
 	}
 
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
+	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, req.Msg, req.TraceConfig, false, tracerConfig)
 	if err != nil {
 		// error will be returned with detail status from traceTx
 		return nil, err
@@ -570,12 +575,17 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
 	for i, tx := range req.Txs {
 		result := types.TxTraceResult{}
-		ethTx := tx.AsTransaction()
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.ComputeHash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, true, tracerConfig)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -601,7 +611,7 @@ func (k *Keeper) traceTx(
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
 	signer ethtypes.Signer,
-	tx *ethtypes.Transaction,
+	tx *types.MsgEthereumTx,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
@@ -639,9 +649,10 @@ func (k *Keeper) traceTx(
 	tracer = logger.NewStructLogger(&logConfig)
 
 	tCtx := &tracers.Context{
-		BlockHash: txConfig.BlockHash,
-		TxIndex:   int(txConfig.TxIndex),
-		TxHash:    txConfig.TxHash,
+		BlockHash:   txConfig.BlockHash,
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		TxIndex:     int(txConfig.TxIndex),
+		TxHash:      txConfig.TxHash,
 	}
 
 	if traceConfig.Tracer != "" {
@@ -702,6 +713,96 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// BlockProposer implements the Query/BlockProposer gRPC method. It returns the
+// proposer of the block at the given height along with its validator operator,
+// EVM coinbase address and moniker.
+func (k Keeper) BlockProposer(c context.Context, req *types.QueryBlockProposerRequest) (*types.QueryBlockProposerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height := req.Height
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+
+	proposerAddress, found := k.GetBlockProposer(ctx, height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "block proposer not found for height %d", height)
+	}
+
+	return &types.QueryBlockProposerResponse{
+		Proposer: k.GetBlockProposerInfo(ctx, height, proposerAddress),
+	}, nil
+}
+
+// ValidatorBlockCounts implements the Query/ValidatorBlockCounts gRPC method.
+// It returns the number of blocks proposed by each validator within the
+// inclusive height range, sorted by block count in descending order.
+func (k Keeper) ValidatorBlockCounts(c context.Context, req *types.QueryValidatorBlockCountsRequest) (*types.QueryValidatorBlockCountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	endHeight := req.EndHeight
+	if endHeight == 0 {
+		endHeight = ctx.BlockHeight()
+	}
+
+	if req.StartHeight <= 0 || endHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", req.StartHeight, endHeight)
+	}
+
+	if endHeight-req.StartHeight+1 > types.MaxBlockProposerQueryRange {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"height range [%d, %d] exceeds the maximum of %d blocks", req.StartHeight, endHeight, types.MaxBlockProposerQueryRange,
+		)
+	}
+
+	counts := make(map[string]uint64)
+	var total uint64
+
+	k.IterateBlockProposers(ctx, req.StartHeight, endHeight, func(_ int64, proposerAddress sdk.ConsAddress) bool {
+		counts[string(proposerAddress)]++
+		total++
+		return false
+	})
+
+	res := &types.QueryValidatorBlockCountsResponse{
+		Counts:      make([]types.ValidatorBlockCount, 0, len(counts)),
+		TotalBlocks: total,
+	}
+
+	for addr, blocks := range counts {
+		proposer := k.GetBlockProposerInfo(ctx, 0, sdk.ConsAddress(addr))
+		res.Counts = append(res.Counts, types.ValidatorBlockCount{
+			ConsAddress:      proposer.ConsAddress,
+			ValidatorAddress: proposer.ValidatorAddress,
+			Coinbase:         proposer.Coinbase,
+			Moniker:          proposer.Moniker,
+			Blocks:           blocks,
+		})
+	}
+
+	sort.Slice(res.Counts, func(i, j int) bool {
+		if res.Counts[i].Blocks != res.Counts[j].Blocks {
+			return res.Counts[i].Blocks > res.Counts[j].Blocks
+		}
+		return res.Counts[i].ConsAddress < res.Counts[j].ConsAddress
+	})
+
+	return res, nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
@@ -709,3 +810,30 @@ func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	}
 	return big.NewInt(chainID), nil
 }
+
+// Permissions implements the Query/Permissions gRPC method. It returns whether the
+// address can create contracts and perform calls when signing a transaction.
+func (k Keeper) Permissions(c context.Context, req *types.QueryPermissionsRequest) (*types.QueryPermissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument, err.Error(),
+		)
+	}
+
+	addr := common.HexToAddress(req.Address)
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	policy := types.NewRestrictedPermissionPolicy(&params.AccessControl, addr)
+
+	return &types.QueryPermissionsResponse{
+		CanCreate:        policy.CanCreate(addr, addr),
+		CanCall:          policy.CanCall(addr, addr, common.Address{}),
+		CreateAccessType: params.AccessControl.Create.AccessType,
+		CallAccessType:   params.AccessControl.Call.AccessType,
+	}, nil
+}
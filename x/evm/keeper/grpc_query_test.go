@@ -495,6 +495,189 @@ func (suite *KeeperTestSuite) TestQueryValidatorAccount() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryBlockProposer() {
+	var (
+		req         *types.QueryBlockProposerRequest
+		expProposer types.BlockProposer
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - negative height",
+			func() {
+				req = &types.QueryBlockProposerRequest{Height: -1}
+			},
+			false,
+		},
+		{
+			"fail - proposer not recorded",
+			func() {
+				req = &types.QueryBlockProposerRequest{Height: 10}
+			},
+			false,
+		},
+		{
+			"pass - current height",
+			func() {
+				suite.app.EvmKeeper.SetBlockProposer(suite.ctx, suite.ctx.BlockHeight(), suite.consAddress)
+
+				expProposer = types.BlockProposer{
+					Height:           suite.ctx.BlockHeight(),
+					ConsAddress:      suite.consAddress.String(),
+					ValidatorAddress: sdk.ValAddress(suite.address.Bytes()).String(),
+					Coinbase:         suite.address.Hex(),
+				}
+				req = &types.QueryBlockProposerRequest{}
+			},
+			true,
+		},
+		{
+			"pass - historical height",
+			func() {
+				suite.app.EvmKeeper.SetBlockProposer(suite.ctx, 5, suite.consAddress)
+
+				expProposer = types.BlockProposer{
+					Height:           5,
+					ConsAddress:      suite.consAddress.String(),
+					ValidatorAddress: sdk.ValAddress(suite.address.Bytes()).String(),
+					Coinbase:         suite.address.Hex(),
+				}
+				req = &types.QueryBlockProposerRequest{Height: 5}
+			},
+			true,
+		},
+		{
+			"pass - validator no longer exists",
+			func() {
+				consAddress := sdk.ConsAddress(utiltx.GenerateAddress().Bytes())
+				suite.app.EvmKeeper.SetBlockProposer(suite.ctx, 5, consAddress)
+
+				expProposer = types.BlockProposer{
+					Height:      5,
+					ConsAddress: consAddress.String(),
+				}
+				req = &types.QueryBlockProposerRequest{Height: 5}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.BlockProposer(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expProposer, res.Proposer)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryValidatorBlockCounts() {
+	var (
+		req       *types.QueryValidatorBlockCountsRequest
+		expCounts []types.ValidatorBlockCount
+		expTotal  uint64
+	)
+
+	otherConsAddress := sdk.ConsAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - zero start height",
+			func() {
+				req = &types.QueryValidatorBlockCountsRequest{StartHeight: 0, EndHeight: 10}
+			},
+			false,
+		},
+		{
+			"fail - end height before start height",
+			func() {
+				req = &types.QueryValidatorBlockCountsRequest{StartHeight: 10, EndHeight: 5}
+			},
+			false,
+		},
+		{
+			"fail - range too large",
+			func() {
+				req = &types.QueryValidatorBlockCountsRequest{StartHeight: 1, EndHeight: types.MaxBlockProposerQueryRange + 1}
+			},
+			false,
+		},
+		{
+			"pass - no blocks recorded",
+			func() {
+				expCounts = nil
+				expTotal = 0
+				req = &types.QueryValidatorBlockCountsRequest{StartHeight: 1, EndHeight: 10}
+			},
+			true,
+		},
+		{
+			"pass - counts within range sorted by blocks",
+			func() {
+				for height := int64(1); height <= 10; height++ {
+					proposer := suite.consAddress
+					if height%3 == 0 {
+						proposer = otherConsAddress
+					}
+					suite.app.EvmKeeper.SetBlockProposer(suite.ctx, height, proposer)
+				}
+
+				// heights 2 to 9: 5 blocks by the suite validator, 3 by the other one
+				expCounts = []types.ValidatorBlockCount{
+					{
+						ConsAddress:      suite.consAddress.String(),
+						ValidatorAddress: sdk.ValAddress(suite.address.Bytes()).String(),
+						Coinbase:         suite.address.Hex(),
+						Blocks:           5,
+					},
+					{
+						ConsAddress: otherConsAddress.String(),
+						Blocks:      3,
+					},
+				}
+				expTotal = 8
+				req = &types.QueryValidatorBlockCountsRequest{StartHeight: 2, EndHeight: 9}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.ValidatorBlockCounts(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expCounts, res.Counts)
+				suite.Require().Equal(expTotal, res.TotalBlocks)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEstimateGas() {
 	gasHelper := hexutil.Uint64(20000)
 	higherGas := hexutil.Uint64(25000)
@@ -729,6 +912,33 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 			ethparams.TxGas,
 			false,
 		},
+		{
+			"call to a system contract with a gas discount",
+			func() {
+				systemContract := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				params.WalletStateContractAddress = systemContract.Hex()
+				params.SystemCallGasDiscountBPS = 2500
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+				args = types.TransactionArgs{To: &systemContract}
+			},
+			true,
+			ethparams.TxGas - ethparams.TxGas/4,
+			false,
+		},
+		{
+			"contract creation with a byte surcharge",
+			func() {
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				params.ContractCreationByteGas = 100
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+				// the init code only executes STOP
+				args = types.TransactionArgs{Data: &hexutil.Bytes{0x00}}
+			},
+			true,
+			ethparams.TxGasContractCreation + ethparams.TxDataZeroGas + 100,
+			false,
+		},
 		{
 			"invalid args - specified both gasPrice and maxFeePerGas",
 			func() {
@@ -1495,3 +1705,28 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryPermissions() {
+	allowed := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	other := common.HexToAddress("0x2234567890123456789012345678901234567890")
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.AccessControl.Create.AccessType = types.AccessTypePermissioned
+	params.AccessControl.Create.AccessControlList = []string{allowed.Hex()}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	_, err := suite.queryClient.Permissions(suite.ctx, &types.QueryPermissionsRequest{Address: "invalid"})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.Permissions(suite.ctx, &types.QueryPermissionsRequest{Address: allowed.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().True(res.CanCreate)
+	suite.Require().True(res.CanCall)
+	suite.Require().Equal(types.AccessTypePermissioned, res.CreateAccessType)
+	suite.Require().Equal(types.AccessTypePermissionless, res.CallAccessType)
+
+	res, err = suite.queryClient.Permissions(suite.ctx, &types.QueryPermissionsRequest{Address: other.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().False(res.CanCreate)
+	suite.Require().True(res.CanCall)
+}
@@ -345,6 +345,25 @@ func (k Keeper) SetTransientGasUsed(ctx sdk.Context, gasUsed uint64) {
 	store.Set(types.KeyPrefixTransientGasUsed, bz)
 }
 
+// SetTxFeePayerTransient sets the account that paid the fees of the transaction
+// with the given hash when it differs from the sender, e.g. a gas sponsor. The
+// leftover gas of the transaction is refunded to that account.
+func (k Keeper) SetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash, feePayer sdk.AccAddress) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.TransientFeePayerKey(txHash), feePayer.Bytes())
+}
+
+// GetTxFeePayerTransient returns the account that paid the fees of the
+// transaction with the given hash. It returns false if the sender paid them.
+func (k Keeper) GetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash) (sdk.AccAddress, bool) {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.TransientFeePayerKey(txHash))
+	if len(bz) == 0 {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
 // AddTransientGasUsed accumulate gas used by each eth msgs included in current cosmos tx.
 func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, error) {
 	result := k.GetTransientGasUsed(ctx) + gasUsed
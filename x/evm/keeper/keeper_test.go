@@ -171,3 +171,20 @@ func (suite *KeeperTestSuite) TestGetAccountOrEmpty() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTxFeePayerTransient() {
+	txHash := common.BytesToHash([]byte("tx"))
+
+	_, found := suite.app.EvmKeeper.GetTxFeePayerTransient(suite.ctx, txHash)
+	suite.Require().False(found)
+
+	feePayer := authtypes.NewModuleAddress("sponsor")
+	suite.app.EvmKeeper.SetTxFeePayerTransient(suite.ctx, txHash, feePayer)
+
+	res, found := suite.app.EvmKeeper.GetTxFeePayerTransient(suite.ctx, txHash)
+	suite.Require().True(found)
+	suite.Require().Equal(feePayer, res)
+
+	_, found = suite.app.EvmKeeper.GetTxFeePayerTransient(suite.ctx, common.BytesToHash([]byte("other")))
+	suite.Require().False(found)
+}
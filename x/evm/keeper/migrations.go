@@ -5,6 +5,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v7 "github.com/evmos/evmos/v19/x/evm/migrations/v7"
+	v8 "github.com/evmos/evmos/v19/x/evm/migrations/v8"
 	"github.com/evmos/evmos/v19/x/evm/types"
 )
 
@@ -26,3 +27,8 @@ func NewMigrator(keeper Keeper, legacySubspace types.Subspace) Migrator {
 func (m Migrator) Migrate6to7(ctx sdk.Context) error {
 	return v7.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate7to8 migrates the store from consensus version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
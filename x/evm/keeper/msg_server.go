@@ -17,7 +17,6 @@ import (
 	"golang.org/x/crypto/sha3"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -27,6 +26,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/evmos/evmos/v19/x/evm/types"
 )
@@ -109,14 +109,7 @@ func getFunctionSelector(signature string) []byte {
 // isWhitelisted checks if an address is in the whitelist (loaded from params)
 func (k *Keeper) isWhitelisted(ctx sdk.Context, address common.Address) bool {
 	params := k.GetParams(ctx)
-	addrHex := address.Hex()
-
-	for _, whitelisted := range params.WhitelistedAddresses {
-		if whitelisted == addrHex {
-			return true
-		}
-	}
-	return false
+	return params.IsWhitelistedAddress(address)
 }
 
 // IsChainOpen checks if the chain is open for new transactions based on the
@@ -186,39 +179,8 @@ func (k *Keeper) IsChainOpen(ctx sdk.Context, from common.Address) (bool, error)
 	// Verify cache state before making EthCall
 	verifyCacheState()
 
-	previousHeight := currentHeight - 1
-
-	// Get the previous block's header
-	previousHeader := ctx.BlockHeader()
-	previousHeader.Height = previousHeight
-
-	// Create context for the previous block
-	previousCtx := ctx.WithBlockHeader(previousHeader)
-
-	// Get contract address from params
-	addr := common.HexToAddress(params.OnlineServerCountContract)
-	data := hexutil.Bytes(getFunctionSelector("getOnlineServerCount()"))
-
-	// Prepare the EthCall request
-	args := types.TransactionArgs{
-		From: &from,
-		To:   &addr,
-		Data: &data,
-	}
-
-	argsBytes, err := json.Marshal(args)
-	if err != nil {
-		return false, err
-	}
-
-	req := &types.EthCallRequest{
-		Args:            argsBytes,
-		GasCap:          uint64(25000000),
-		ProposerAddress: previousHeader.ProposerAddress,
-	}
-
-	// Call EthCall with previous block's context
-	res, err := k.EthCall(previousCtx, req)
+	// Call the OnlineServerCount contract with the previous block's context
+	res, err := k.CallOnlineServerCount(ctx, from, networkStateCallGasCap)
 	if err != nil {
 		return false, err
 	}
@@ -229,8 +191,7 @@ func (k *Keeper) IsChainOpen(ctx sdk.Context, from common.Address) (bool, error)
 	log.Printf("Online Server Count: %s", count.String())
 
 	// Check if the chain is open based on the count
-	threshold := big.NewInt(1000)
-	isOpen := count.Cmp(threshold) >= 0
+	isOpen := types.IsChainOpenForServerCount(count)
 
 	// Cache the result
 	cacheMutex.Lock()
@@ -347,60 +308,30 @@ func (k *Keeper) IsWalletUnlocked(ctx sdk.Context, from common.Address, txAmount
 	}
 	cacheMutex.RUnlock()
 
-	// Get contract address from params
-	walletStateContract := common.HexToAddress(params.WalletStateContractAddress)
-
-	// Prepare the function selector for getWalletLock(address)
-	functionSelector := getFunctionSelector("getWalletLock(address)")
-	paddedAddress := common.LeftPadBytes(from.Bytes(), 32) // 32-byte encoding for address
-	data := append(functionSelector, paddedAddress...)
-
-	// Convert data to hexutil.Bytes explicitly
-	hexData := hexutil.Bytes(data)
-
-	args := types.TransactionArgs{
-		From: &from,
-		To:   &walletStateContract,
-		Data: &hexData, // Corrected type conversion
-	}
-
-	argsBytes, err := json.Marshal(args)
-	if err != nil {
-		log.Println("Failed to marshal args:", err)
-		return false, err
-	}
-
-	req := &types.EthCallRequest{
-		Args:   argsBytes,
-		GasCap: uint64(25000000), // Set a fixed gas cap
-	}
-
-	// Call EthCall function
-	res, err := k.EthCall(ctx, req)
+	// Call the WalletState contract
+	res, err := k.CallWalletLock(ctx, from, networkStateCallGasCap)
 	if err != nil {
 		log.Println("Failed to call EthCall:", err)
 		return false, err
 	}
 
 	// Parse the response: (LockStatus, lockValue, lockCode)
-	if len(res.Ret) < 96 {
+	lockStatus, lockedAmount, err := types.UnpackWalletLock(res.Ret)
+	if err != nil {
 		log.Println("Invalid response length", res.Ret)
-		return false, fmt.Errorf("invalid response length")
+		return false, err
 	}
-	// log.Println("Raw EthCall Response:", hexutil.Encode(res.Ret))
-	lockStatus := new(big.Int).SetBytes(res.Ret[:32]).Uint64() % 256
-	lockedAmount := new(big.Int).SetBytes(res.Ret[32:64]) // Correct position
 
 	var isUnlocked bool
 	var resultError error
 
 	switch lockStatus {
-	case 0: // No_Lock
+	case types.WalletLockNone:
 		log.Println("✅ Wallet is unlocked")
 		isUnlocked = true
 		resultError = nil
 
-	case 1: // Amount_Lock
+	case types.WalletLockAmount:
 		// Ensure locked amount is not greater than total balance
 		// Fetch the balance using Keeper
 		balanceRes, err := k.Balance(ctx, &types.QueryBalanceRequest{
@@ -410,7 +341,6 @@ func (k *Keeper) IsWalletUnlocked(ctx sdk.Context, from common.Address, txAmount
 			log.Println("Failed to fetch wallet balance:", err)
 			return false, err
 		}
-		// log.Println("=============== Wallet Balance:", balanceRes.Balance)
 		totalBalance, ok := new(big.Int).SetString(balanceRes.Balance, 10)
 		if !ok {
 			return false, fmt.Errorf("failed to convert balance to *big.Int")
@@ -425,16 +355,13 @@ func (k *Keeper) IsWalletUnlocked(ctx sdk.Context, from common.Address, txAmount
 
 		log.Printf("✅ Max Allowed Transfer: %s", maxAllowed.String())
 
-		// Create tolerance of 0.0001 NXQ (10^14 wei)
-		tolerance := new(big.Int).Exp(big.NewInt(10), big.NewInt(14), nil)
-
 		// Calculate difference between tx amount and max allowed
 		diff := new(big.Int)
 		if txAmount.Cmp(maxAllowed) > 0 {
 			diff.Sub(txAmount, maxAllowed)
 
 			// If difference is within tolerance, allow the transaction
-			if diff.Cmp(tolerance) <= 0 {
+			if diff.Cmp(types.AmountLockTolerance) <= 0 {
 				log.Printf("✅ Transaction within tolerance (diff: %s wei)", diff.String())
 				isUnlocked = true
 				resultError = nil
@@ -449,7 +376,7 @@ func (k *Keeper) IsWalletUnlocked(ctx sdk.Context, from common.Address, txAmount
 			resultError = nil
 		}
 
-	case 2: // Absolute_Lock
+	case types.WalletLockAbsolute:
 		log.Println("❌ Wallet is fully locked")
 		isUnlocked = false
 		resultError = fmt.Errorf("wallet is fully locked")
@@ -526,8 +453,13 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		log.Println("Address is whitelisted, skipping chain open and wallet unlock checks")
 	}
 
+	txData, err := types.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+
 	labels := []metrics.Label{
-		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", tx.Type())),
+		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", txData.TxType())),
 	}
 	if tx.To() == nil {
 		labels = append(labels, telemetry.NewLabel("execution", "create"))
@@ -535,7 +467,12 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
-	response, err := k.ApplyTransaction(ctx, tx)
+	var response *types.MsgEthereumTxResponse
+	if _, ok := txData.(*types.SetCodeTx); ok {
+		response, err = k.ApplySetCodeTransaction(ctx, msg)
+	} else {
+		response, err = k.ApplyTransaction(ctx, tx)
+	}
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
@@ -638,3 +575,57 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// AddPermissionedAddress implements the gRPC MsgServer interface. When an
+// AddPermissionedAddress proposal passes, it adds the address to the access control
+// list of the operation. The update can only be performed if the requested
+// authority is the Cosmos SDK governance module account.
+func (k *Keeper) AddPermissionedAddress(goCtx context.Context, req *types.MsgAddPermissionedAddress) (*types.MsgAddPermissionedAddressResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	accessControlType, err := params.AccessControl.GetAccessControlType(req.Operation)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	if !accessControlType.AddAddress(common.HexToAddress(req.Address)) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "address %s is already in the %s access control list", req.Address, req.Operation)
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddPermissionedAddressResponse{}, nil
+}
+
+// RemovePermissionedAddress implements the gRPC MsgServer interface. When a
+// RemovePermissionedAddress proposal passes, it removes the address from the access
+// control list of the operation. The update can only be performed if the requested
+// authority is the Cosmos SDK governance module account.
+func (k *Keeper) RemovePermissionedAddress(goCtx context.Context, req *types.MsgRemovePermissionedAddress) (*types.MsgRemovePermissionedAddressResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	accessControlType, err := params.AccessControl.GetAccessControlType(req.Operation)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	if !accessControlType.RemoveAddress(common.HexToAddress(req.Address)) {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "address %s is not in the %s access control list", req.Address, req.Operation)
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemovePermissionedAddressResponse{}, nil
+}
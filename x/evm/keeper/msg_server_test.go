@@ -2,10 +2,12 @@ package keeper_test
 
 import (
 	"math/big"
+	"strings"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v19/x/evm/statedb"
@@ -109,3 +111,52 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestAddRemovePermissionedAddress() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	// invalid authority
+	_, err := suite.app.EvmKeeper.AddPermissionedAddress(suite.ctx, &types.MsgAddPermissionedAddress{
+		Authority: "foobar",
+		Operation: types.PermissionOperationCreate,
+		Address:   addr.Hex(),
+	})
+	suite.Require().Error(err)
+
+	// address is added in checksum format to the create list only
+	_, err = suite.app.EvmKeeper.AddPermissionedAddress(suite.ctx, &types.MsgAddPermissionedAddress{
+		Authority: authority,
+		Operation: types.PermissionOperationCreate,
+		Address:   strings.ToLower(addr.Hex()),
+	})
+	suite.Require().NoError(err)
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	suite.Require().Equal([]string{addr.Hex()}, params.AccessControl.Create.AccessControlList)
+	suite.Require().Empty(params.AccessControl.Call.AccessControlList)
+
+	// duplicated address
+	_, err = suite.app.EvmKeeper.AddPermissionedAddress(suite.ctx, &types.MsgAddPermissionedAddress{
+		Authority: authority,
+		Operation: types.PermissionOperationCreate,
+		Address:   addr.Hex(),
+	})
+	suite.Require().Error(err)
+
+	// address not in the call list
+	_, err = suite.app.EvmKeeper.RemovePermissionedAddress(suite.ctx, &types.MsgRemovePermissionedAddress{
+		Authority: authority,
+		Operation: types.PermissionOperationCall,
+		Address:   addr.Hex(),
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.app.EvmKeeper.RemovePermissionedAddress(suite.ctx, &types.MsgRemovePermissionedAddress{
+		Authority: authority,
+		Operation: types.PermissionOperationCreate,
+		Address:   addr.Hex(),
+	})
+	suite.Require().NoError(err)
+	params = suite.app.EvmKeeper.GetParams(suite.ctx)
+	suite.Require().Empty(params.AccessControl.Create.AccessControlList)
+}
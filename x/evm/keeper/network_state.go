@@ -0,0 +1,87 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// networkStateCallGasCap is the gas cap used for the read-only calls to the
+// network state contracts made when processing the Ethereum transactions.
+const networkStateCallGasCap = uint64(25000000)
+
+// CallOnlineServerCount calls getOnlineServerCount() on the OnlineServerCount
+// contract with the previous block's context and the given gas cap. The
+// response is returned as is, so the caller decides how to handle a failed call
+// and whether to charge the gas used.
+func (k *Keeper) CallOnlineServerCount(ctx sdk.Context, from common.Address, gasCap uint64) (*types.MsgEthereumTxResponse, error) {
+	params := k.GetParams(ctx)
+
+	// Get the previous block's header
+	previousHeader := ctx.BlockHeader()
+	previousHeader.Height = ctx.BlockHeight() - 1
+
+	// Create context for the previous block
+	previousCtx := ctx.WithBlockHeader(previousHeader)
+
+	addr := common.HexToAddress(params.OnlineServerCountContract)
+	data := hexutil.Bytes(getFunctionSelector("getOnlineServerCount()"))
+
+	args := types.TransactionArgs{
+		From: &from,
+		To:   &addr,
+		Data: &data,
+	}
+
+	argsBytes, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &types.EthCallRequest{
+		Args:            argsBytes,
+		GasCap:          gasCap,
+		ProposerAddress: previousHeader.ProposerAddress,
+	}
+
+	// Call EthCall with previous block's context
+	return k.EthCall(previousCtx, req)
+}
+
+// CallWalletLock calls getWalletLock(address) on the WalletState contract for
+// the given account with the given gas cap. The response is returned as is, so
+// the caller decides how to handle a failed call and whether to charge the gas
+// used.
+func (k *Keeper) CallWalletLock(ctx sdk.Context, account common.Address, gasCap uint64) (*types.MsgEthereumTxResponse, error) {
+	params := k.GetParams(ctx)
+
+	walletStateContract := common.HexToAddress(params.WalletStateContractAddress)
+
+	// getWalletLock(address) with the 32-byte encoded address
+	data := append(getFunctionSelector("getWalletLock(address)"), common.LeftPadBytes(account.Bytes(), 32)...)
+	hexData := hexutil.Bytes(data)
+
+	args := types.TransactionArgs{
+		From: &account,
+		To:   &walletStateContract,
+		Data: &hexData,
+	}
+
+	argsBytes, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &types.EthCallRequest{
+		Args:   argsBytes,
+		GasCap: gasCap,
+	}
+
+	return k.EthCall(ctx, req)
+}
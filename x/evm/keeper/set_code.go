@@ -0,0 +1,85 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	"github.com/evmos/evmos/v19/x/evm/statedb"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// applyAuthorizations installs the delegation designators of the EIP-7702
+// authorization list in the state. Invalid authorizations are skipped, as
+// defined by the EIP, and don't fail the transaction.
+func applyAuthorizations(stateDB *statedb.StateDB, chainID *big.Int, authList []types.SetCodeAuthorization) {
+	for _, auth := range authList {
+		// the error is ignored on purpose, the remaining authorizations still apply
+		_ = applyAuthorization(stateDB, chainID, auth)
+	}
+}
+
+// applyAuthorization validates the authorization against the current state and
+// delegates the code of the authority to the authorized address.
+func applyAuthorization(stateDB *statedb.StateDB, chainID *big.Int, auth types.SetCodeAuthorization) error {
+	authority, err := validateAuthorization(stateDB, chainID, auth)
+	if err != nil {
+		return err
+	}
+
+	// If the account already exists in state, refund the new account cost
+	// charged in the intrinsic calculation.
+	if stateDB.Exist(authority) {
+		stateDB.AddRefund(types.PerEmptyAccountCost - types.PerAuthBaseCost)
+	}
+
+	stateDB.SetNonce(authority, auth.Nonce+1)
+
+	// delegating to the zero address clears the delegation
+	if auth.GetAddress() == (common.Address{}) {
+		stateDB.SetCode(authority, nil)
+		return nil
+	}
+
+	stateDB.SetCode(authority, vm.AddressToDelegation(auth.GetAddress()))
+	return nil
+}
+
+// validateAuthorization checks the chain id, nonce and signature of the
+// authorization, and that the authority account is allowed to delegate its code.
+func validateAuthorization(stateDB *statedb.StateDB, chainID *big.Int, auth types.SetCodeAuthorization) (common.Address, error) {
+	authChainID := auth.GetChainID()
+	if authChainID.Sign() != 0 && authChainID.Cmp(chainID) != 0 {
+		return common.Address{}, fmt.Errorf("%w: wrong chain id %s", types.ErrInvalidAuthorization, authChainID)
+	}
+
+	// limit the nonce to 2^64-1 as defined by EIP-2681
+	if auth.Nonce+1 < auth.Nonce {
+		return common.Address{}, fmt.Errorf("%w: nonce overflow", types.ErrInvalidAuthorization)
+	}
+
+	authority, err := auth.Authority()
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: invalid signature: %s", types.ErrInvalidAuthorization, err)
+	}
+
+	// the authority is added to the access list even if the authorization is invalid
+	stateDB.AddAddressToAccessList(authority)
+
+	code := stateDB.GetCode(authority)
+	if _, ok := vm.ParseDelegation(code); len(code) != 0 && !ok {
+		return common.Address{}, fmt.Errorf("%w: authority %s has code", types.ErrInvalidAuthorization, authority)
+	}
+
+	if nonce := stateDB.GetNonce(authority); nonce != auth.Nonce {
+		return common.Address{}, fmt.Errorf(
+			"%w: nonce mismatch for authority %s (%d != %d)", types.ErrInvalidAuthorization, authority, nonce, auth.Nonce,
+		)
+	}
+
+	return authority, nil
+}
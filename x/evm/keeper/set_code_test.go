@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestApplySetCodeAuthorizations() {
+	var (
+		authority   common.Address
+		delegate    common.Address
+		sender      common.Address
+		authChainID *big.Int
+		authAddress common.Address
+		authNonce   uint64
+	)
+
+	// the gas limit covers the intrinsic gas of a call with a single authorization
+	gasLimit := params.TxGas + evmtypes.PerEmptyAccountCost
+	// refund of the new account cost when the authority exists, below the gas refund cap
+	existingRefund := evmtypes.PerEmptyAccountCost - evmtypes.PerAuthBaseCost
+
+	contractCode := []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expCode    func() []byte
+		expNonce   uint64
+		expGasUsed uint64
+	}{
+		{
+			"valid authorization delegates the code of a new account",
+			func() {},
+			func() []byte { return vm.AddressToDelegation(delegate) },
+			1,
+			gasLimit,
+		},
+		{
+			"authorization valid on every chain",
+			func() {
+				authChainID = big.NewInt(0)
+			},
+			func() []byte { return vm.AddressToDelegation(delegate) },
+			1,
+			gasLimit,
+		},
+		{
+			"existing authority refunded the new account cost",
+			func() {
+				vmdb := suite.StateDB()
+				vmdb.SetNonce(authority, 3)
+				suite.Require().NoError(vmdb.Commit())
+				authNonce = 3
+			},
+			func() []byte { return vm.AddressToDelegation(delegate) },
+			4,
+			gasLimit - existingRefund,
+		},
+		{
+			"chain id mismatch skipped",
+			func() {
+				authChainID = big.NewInt(1234)
+			},
+			func() []byte { return nil },
+			0,
+			gasLimit,
+		},
+		{
+			"nonce mismatch skipped",
+			func() {
+				authNonce = 1
+			},
+			func() []byte { return nil },
+			0,
+			gasLimit,
+		},
+		{
+			"authority with code skipped",
+			func() {
+				vmdb := suite.StateDB()
+				vmdb.SetCode(authority, contractCode)
+				suite.Require().NoError(vmdb.Commit())
+			},
+			func() []byte { return contractCode },
+			0,
+			gasLimit,
+		},
+		{
+			"delegation cleared with the zero address",
+			func() {
+				vmdb := suite.StateDB()
+				vmdb.SetCode(authority, vm.AddressToDelegation(delegate))
+				vmdb.SetNonce(authority, 1)
+				suite.Require().NoError(vmdb.Commit())
+				authAddress = common.Address{}
+				authNonce = 1
+			},
+			func() []byte { return nil },
+			2,
+			gasLimit - existingRefund,
+		},
+		{
+			"self-sponsored authorization signed with the nonce after the tx",
+			func() {
+				// the AnteHandler increments the nonce of the sender before the execution
+				sender = authority
+				vmdb := suite.StateDB()
+				vmdb.SetNonce(authority, 1)
+				suite.Require().NoError(vmdb.Commit())
+				authNonce = 1
+			},
+			func() []byte { return vm.AddressToDelegation(delegate) },
+			2,
+			gasLimit - existingRefund,
+		},
+		{
+			"non Ethereum account authority bumps the nonce without storing the code",
+			func() {
+				acc := suite.app.AccountKeeper.NewAccount(suite.ctx, authtypes.NewBaseAccountWithAddress(authority.Bytes()))
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			func() []byte { return nil },
+			1,
+			gasLimit - existingRefund,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			key, err := crypto.GenerateKey()
+			suite.Require().NoError(err)
+			authority = crypto.PubkeyToAddress(key.PublicKey)
+			delegate = utiltx.GenerateAddress()
+			sender = suite.address
+			authChainID = suite.app.EvmKeeper.ChainID()
+			authAddress = delegate
+			authNonce = 0
+
+			tc.malleate()
+
+			auth, err := evmtypes.SignSetCodeAuthorization(key, evmtypes.NewSetCodeAuthorization(authChainID, authAddress, authNonce))
+			suite.Require().NoError(err)
+
+			recipient := utiltx.GenerateAddress()
+			msg := evmtypes.SetCodeMessage{
+				Message: ethtypes.NewMessage(
+					sender, &recipient, 0, big.NewInt(0), gasLimit, big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, true,
+				),
+				AuthList: []evmtypes.SetCodeAuthorization{auth},
+			}
+
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, suite.ctx.BlockHeader().ProposerAddress, suite.app.EvmKeeper.ChainID())
+			suite.Require().NoError(err)
+			config.Params.ExtraEIPs = append(config.Params.ExtraEIPs, evmtypes.SetCodeEIP)
+			config.Params.RefundQuotient = 2
+
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Equal(tc.expGasUsed, res.GasUsed)
+
+			vmdb := suite.StateDB()
+			suite.Require().Equal(tc.expCode(), vmdb.GetCode(authority))
+			suite.Require().Equal(tc.expNonce, vmdb.GetNonce(authority))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplySetCodeTxNotEnabled() {
+	suite.SetupTest()
+
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	auth, err := evmtypes.SignSetCodeAuthorization(key, evmtypes.NewSetCodeAuthorization(suite.app.EvmKeeper.ChainID(), utiltx.GenerateAddress(), 0))
+	suite.Require().NoError(err)
+
+	recipient := utiltx.GenerateAddress()
+	msg := evmtypes.SetCodeMessage{
+		Message: ethtypes.NewMessage(
+			suite.address, &recipient, 0, big.NewInt(0), 100_000, big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, true,
+		),
+		AuthList: []evmtypes.SetCodeAuthorization{auth},
+	}
+
+	config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, suite.ctx.BlockHeader().ProposerAddress, suite.app.EvmKeeper.ChainID())
+	suite.Require().NoError(err)
+
+	txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+	_, err = suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+	suite.Require().ErrorIs(err, evmtypes.ErrInvalidAuthorization)
+	suite.Require().Empty(suite.StateDB().GetCode(crypto.PubkeyToAddress(key.PublicKey)))
+}
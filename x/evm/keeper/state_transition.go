@@ -4,6 +4,7 @@ package keeper
 
 import (
 	"math/big"
+	"slices"
 
 	tmtypes "github.com/cometbft/cometbft/types"
 
@@ -49,6 +50,8 @@ func (k *Keeper) NewEVM(
 		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		BaseFee:     cfg.BaseFee,
+		// blob txs are not supported, so the blob base fee stays at its minimum
+		BlobBaseFee: big.NewInt(1),
 		Random:      nil, // not supported
 	}
 
@@ -70,6 +73,14 @@ func (k *Keeper) NewEVM(
 		accessControl.GetCallHook(signer),
 		k.GetPrecompilesCallHook(ctx),
 	)
+
+	// Set the hooks of the opcode policies enabled in the extra EIPs
+	if slices.Contains(cfg.Params.ExtraEIPs, types.CallDenylistEIP) {
+		evmHooks.AddCallHooks(types.GetCallDenylistHook(cfg.Params))
+	}
+	if cfg.Params.EnableChainStatusCheck && slices.Contains(cfg.Params.ExtraEIPs, types.DeployerWhitelistEIP) {
+		evmHooks.AddCreateHooks(types.GetDeployerWhitelistHook(cfg.Params))
+	}
 	return vm.NewEVMWithHooks(evmHooks, blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
 }
 
@@ -147,13 +158,10 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
-	var bloom *big.Int
-
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
-	txConfig := k.TxConfig(ctx, tx.Hash())
 
 	// get the signer according to the chain rules from the config and block height
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
@@ -162,6 +170,39 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
 
+	return k.applyTransaction(ctx, cfg, tx.Hash(), msg)
+}
+
+// ApplySetCodeTransaction runs the EIP-7702 set code transaction of the given message. It follows
+// the same state transition as ApplyTransaction, which cannot be used since set code transactions
+// have no go-ethereum representation.
+func (k *Keeper) ApplySetCodeTransaction(ctx sdk.Context, ethMsg *types.MsgEthereumTx) (*types.MsgEthereumTxResponse, error) {
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	msg, err := ethMsg.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
+	}
+
+	return k.applyTransaction(ctx, cfg, ethMsg.ComputeHash(), msg)
+}
+
+// applyTransaction applies the core message of the transaction with the given hash and updates
+// the transient block values.
+func (k *Keeper) applyTransaction(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txHash common.Hash,
+	msg core.Message,
+) (*types.MsgEthereumTxResponse, error) {
+	var bloom *big.Int
+
+	txConfig := k.TxConfig(ctx, txHash)
+
 	// Create a cache context to revert state. The cache context is only committed when both tx and hooks executed successfully.
 	// Didn't use `Snapshot` because the context stack has exponential complexity on certain operations,
 	// thus restricted to be used only inside `ApplyMessage`.
@@ -189,8 +230,14 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
+	// The leftover gas is refunded to the account that paid the fees, which is the sender unless
+	// the fees were paid by a gas sponsor.
+	refundRecipient := sdk.AccAddress(msg.From().Bytes())
+	if feePayer, found := k.GetTxFeePayerTransient(ctx, txHash); found {
+		refundRecipient = feePayer
+	}
+	if err = k.refundGas(ctx, msg, refundRecipient, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to fee payer %s", refundRecipient)
 	}
 
 	if len(logs) > 0 {
@@ -291,7 +338,13 @@ func (k *Keeper) ApplyMessageWithConfig(
 	contractCreation := msg.To() == nil
 	isLondon := cfg.ChainConfig.IsLondon(evm.Context.BlockNumber)
 
-	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
+	setCodeMsg, isSetCode := msg.(types.SetCodeMessage)
+	// should have already been checked on Ante Handler, but eth_call doesn't go through it
+	if isSetCode && !cfg.Params.IsSetCodeEnabled() {
+		return nil, errorsmod.Wrap(types.ErrInvalidAuthorization, "set code transactions are not enabled")
+	}
+
+	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, cfg.Params, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
 		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
@@ -310,6 +363,17 @@ func (k *Keeper) ApplyMessageWithConfig(
 		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
 	}
 
+	// install the EIP-7702 delegations before the execution, so that the call
+	// already runs the delegated code
+	if isSetCode {
+		applyAuthorizations(stateDB, cfg.ChainConfig.ChainID, setCodeMsg.AuthList)
+
+		// the delegation target of the recipient is warm, as the recipient itself
+		if target, ok := vm.ParseDelegation(stateDB.GetCode(*msg.To())); ok {
+			stateDB.AddAddressToAccessList(target)
+		}
+	}
+
 	if contractCreation {
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce() before calling evm.
@@ -328,6 +392,11 @@ func (k *Keeper) ApplyMessageWithConfig(
 		refundQuotient = params.RefundQuotientEIP3529
 	}
 
+	// The refund quotient parameter overrides the fork rules
+	if cfg.Params.RefundQuotient != 0 {
+		refundQuotient = cfg.Params.RefundQuotient
+	}
+
 	// calculate gas refund
 	if msg.Gas() < leftoverGas {
 		return nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
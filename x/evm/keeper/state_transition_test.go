@@ -278,7 +278,7 @@ func (suite *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			)
 			suite.Require().NoError(err)
 
-			gas, err := suite.app.EvmKeeper.GetEthIntrinsicGas(suite.ctx, m, ethCfg, tc.isContractCreation)
+			gas, err := suite.app.EvmKeeper.GetEthIntrinsicGas(suite.ctx, m, ethCfg, params, tc.isContractCreation)
 			if tc.noError {
 				suite.Require().NoError(err)
 			} else {
@@ -290,6 +290,103 @@ func (suite *KeeperTestSuite) TestGetEthIntrinsicGas() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGetEthIntrinsicGasRules() {
+	systemContract := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	data := []byte{1, 2, 3}
+
+	testCases := []struct {
+		name     string
+		to       *common.Address
+		malleate func(params *evmtypes.Params)
+		expErr   error
+		expGas   func(baseGas uint64) uint64
+	}{
+		{
+			"contract creation with a byte surcharge",
+			nil,
+			func(params *evmtypes.Params) {
+				params.ContractCreationByteGas = 100
+			},
+			nil,
+			func(baseGas uint64) uint64 { return baseGas + 300 },
+		},
+		{
+			"contract creation surcharge overflow",
+			nil,
+			func(params *evmtypes.Params) {
+				params.ContractCreationByteGas = math.MaxUint64
+			},
+			core.ErrGasUintOverflow,
+			nil,
+		},
+		{
+			"call not charged the contract creation surcharge",
+			&systemContract,
+			func(params *evmtypes.Params) {
+				params.ContractCreationByteGas = 100
+			},
+			nil,
+			func(baseGas uint64) uint64 { return baseGas },
+		},
+		{
+			"call to the wallet state contract with a discount",
+			&systemContract,
+			func(params *evmtypes.Params) {
+				params.WalletStateContractAddress = systemContract.Hex()
+				params.SystemCallGasDiscountBPS = 2500
+			},
+			nil,
+			func(baseGas uint64) uint64 { return baseGas - baseGas/4 },
+		},
+		{
+			"call to the online server count contract with the highest discount",
+			&systemContract,
+			func(params *evmtypes.Params) {
+				params.OnlineServerCountContract = systemContract.Hex()
+				params.SystemCallGasDiscountBPS = evmtypes.MaxGasDiscountBPS - 1
+			},
+			nil,
+			func(baseGas uint64) uint64 {
+				return baseGas - baseGas*(evmtypes.MaxGasDiscountBPS-1)/evmtypes.MaxGasDiscountBPS
+			},
+		},
+		{
+			"call to another contract not discounted",
+			&systemContract,
+			func(params *evmtypes.Params) {
+				params.WalletStateContractAddress = common.HexToAddress("0x0000000000000000000000000000000000000def").Hex()
+				params.SystemCallGasDiscountBPS = 2500
+			},
+			nil,
+			func(baseGas uint64) uint64 { return baseGas },
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			ethCfg := params.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+			msg := ethtypes.NewMessage(suite.address, tc.to, 0, big.NewInt(0), 100_000, big.NewInt(1), nil, nil, data, nil, true)
+			isContractCreation := tc.to == nil
+
+			baseGas, err := suite.app.EvmKeeper.GetEthIntrinsicGas(suite.ctx, msg, ethCfg, params, isContractCreation)
+			suite.Require().NoError(err)
+
+			tc.malleate(&params)
+			gas, err := suite.app.EvmKeeper.GetEthIntrinsicGas(suite.ctx, msg, ethCfg, params, isContractCreation)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expGas(baseGas), gas)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGasToRefund() {
 	testCases := []struct {
 		name           string
@@ -645,6 +742,30 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 			false,
 			true,
 		},
+		{
+			"call to a system contract with a gas discount",
+			func() {
+				systemContract := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+				msg = ethtypes.NewMessage(
+					suite.address,
+					&systemContract,
+					vmdb.GetNonce(suite.address),
+					big.NewInt(0),
+					params.TxGas,
+					big.NewInt(1),
+					nil,
+					nil,
+					nil,
+					nil,
+					true,
+				)
+				config.Params.WalletStateContractAddress = systemContract.Hex()
+				config.Params.SystemCallGasDiscountBPS = 2500
+				expectedGasUsed = params.TxGas - params.TxGas/4
+			},
+			false,
+			false,
+		},
 		{
 			"fix panic when minimumGasUsed is not uint64",
 			func() {
@@ -7,22 +7,33 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
 	"github.com/ethereum/go-ethereum/common"
 	bankprecompile "github.com/evmos/evmos/v19/precompiles/bank"
 	"github.com/evmos/evmos/v19/precompiles/bech32"
 	distprecompile "github.com/evmos/evmos/v19/precompiles/distribution"
+	feegrantprecompile "github.com/evmos/evmos/v19/precompiles/feegrant"
+	govprecompile "github.com/evmos/evmos/v19/precompiles/gov"
 	ics20precompile "github.com/evmos/evmos/v19/precompiles/ics20"
+	inflationprecompile "github.com/evmos/evmos/v19/precompiles/inflation"
+	networkstateprecompile "github.com/evmos/evmos/v19/precompiles/networkstate"
 	"github.com/evmos/evmos/v19/precompiles/p256"
+	slashingprecompile "github.com/evmos/evmos/v19/precompiles/slashing"
 	stakingprecompile "github.com/evmos/evmos/v19/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v19/precompiles/vesting"
+	epochskeeper "github.com/evmos/evmos/v19/x/epochs/keeper"
 	erc20Keeper "github.com/evmos/evmos/v19/x/erc20/keeper"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 	"github.com/evmos/evmos/v19/x/evm/types"
 	transferkeeper "github.com/evmos/evmos/v19/x/ibc/transfer/keeper"
+	inflationkeeper "github.com/evmos/evmos/v19/x/inflation/v1/keeper"
 	stakingkeeper "github.com/evmos/evmos/v19/x/staking/keeper"
 	vestingkeeper "github.com/evmos/evmos/v19/x/vesting/keeper"
 	"golang.org/x/exp/maps"
@@ -33,6 +44,7 @@ const bech32PrecompileBaseGas = 6_000
 // AvailableStaticPrecompiles returns the list of all available static precompiled contracts.
 // NOTE: this should only be used during initialization of the Keeper.
 func NewAvailableStaticPrecompiles(
+	evmKeeper *Keeper,
 	stakingKeeper stakingkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
 	bankKeeper bankkeeper.Keeper,
@@ -41,6 +53,12 @@ func NewAvailableStaticPrecompiles(
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
+	inflationKeeper inflationkeeper.Keeper,
+	epochsKeeper epochskeeper.Keeper,
+	govKeeper govkeeper.Keeper,
+	codec codec.Codec,
+	slashingKeeper slashingkeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -87,6 +105,31 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
 	}
 
+	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, authzKeeper, codec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate gov precompile: %w", err))
+	}
+
+	slashingPrecompile, err := slashingprecompile.NewPrecompile(slashingKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate slashing precompile: %w", err))
+	}
+
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(feegrantKeeper, evmKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
+	networkStatePrecompile, err := networkstateprecompile.NewPrecompile(evmKeeper, stakingKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate network state precompile: %w", err))
+	}
+
+	inflationPrecompile, err := inflationprecompile.NewPrecompile(inflationKeeper, epochsKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate inflation precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -97,6 +140,11 @@ func NewAvailableStaticPrecompiles(
 	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
 	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[bankPrecompile.Address()] = bankPrecompile
+	precompiles[govPrecompile.Address()] = govPrecompile
+	precompiles[slashingPrecompile.Address()] = slashingPrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
+	precompiles[networkStatePrecompile.Address()] = networkStatePrecompile
+	precompiles[inflationPrecompile.Address()] = inflationPrecompile
 	return precompiles
 }
 
@@ -0,0 +1,44 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v8
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+// MigrateStore migrates the x/evm module state from the consensus version 7 to
+// version 8. Specifically, it sets the default BlockProposerRetention, which is
+// read as zero from the params stored before it was introduced and would
+// disable the recording of the block proposers. The other params added with it
+// (CallDenylist, RefundQuotient, ContractCreationByteGas and
+// SystemCallGasDiscountBPS) default to their zero value.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+
+	store := ctx.KVStore(storeKey)
+
+	paramsBz := store.Get(types.KeyPrefixParams)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	if params.BlockProposerRetention == 0 {
+		params.BlockProposerRetention = types.DefaultBlockProposerRetention
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+
+	store.Set(types.KeyPrefixParams, bz)
+	return nil
+}
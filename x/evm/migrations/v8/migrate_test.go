@@ -0,0 +1,57 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v8_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/encoding"
+	v8 "github.com/evmos/evmos/v19/x/evm/migrations/v8"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	testCases := []struct {
+		name         string
+		retention    uint64
+		expRetention uint64
+	}{
+		{"params stored without the retention", 0, types.DefaultBlockProposerRetention},
+		{"retention already set", 100, 100},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Initialize the store
+			storeKey := sdk.NewKVStoreKey(types.ModuleName)
+			tKey := sdk.NewTransientStoreKey("transient_storekey")
+			ctx := testutil.DefaultContext(storeKey, tKey)
+			kvStore := ctx.KVStore(storeKey)
+
+			// Create a pre migration environment with the params stored by v7
+			paramsV7 := types.DefaultParams()
+			paramsV7.BlockProposerRetention = tc.retention
+			kvStore.Set(types.KeyPrefixParams, cdc.MustMarshal(&paramsV7))
+
+			err := v8.MigrateStore(ctx, storeKey, cdc)
+			require.NoError(t, err)
+
+			var params types.Params
+			cdc.MustUnmarshal(kvStore.Get(types.KeyPrefixParams), &params)
+
+			require.Equal(t, tc.expRetention, params.BlockProposerRetention)
+			// the other params are kept
+			params.BlockProposerRetention = tc.retention
+			require.Equal(t, cdc.MustMarshal(&paramsV7), cdc.MustMarshal(&params))
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package statedb_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	"github.com/evmos/evmos/v19/x/evm/statedb"
+)
+
+// The vectors below follow the Cancun tests of the Ethereum execution spec
+// tests (tests/cancun/eip1153_tstore, eip5656_mcopy and eip6780_selfdestruct),
+// executed with the StateDB of the EVM module.
+
+const specTestGas = 1_000_000
+
+var (
+	specCaller      = common.BigToAddress(big.NewInt(201))
+	specContract    = common.BigToAddress(big.NewInt(202))
+	specLibrary     = common.BigToAddress(big.NewInt(203))
+	specBeneficiary = common.BigToAddress(big.NewInt(204))
+)
+
+// program is a minimal EVM assembler used to write the spec vectors.
+type program []byte
+
+// op appends the given opcodes.
+func (p program) op(ops ...vm.OpCode) program {
+	for _, op := range ops {
+		p = append(p, byte(op))
+	}
+	return p
+}
+
+// push appends the shortest PUSH of the given value.
+func (p program) push(value uint64) program {
+	bz := new(big.Int).SetUint64(value).Bytes()
+	if len(bz) == 0 {
+		bz = []byte{0}
+	}
+	p = append(p, byte(vm.PUSH1)+byte(len(bz)-1))
+	return append(p, bz...)
+}
+
+// pushAddress appends a PUSH20 of the given address.
+func (p program) pushAddress(address common.Address) program {
+	p = append(p, byte(vm.PUSH20))
+	return append(p, address.Bytes()...)
+}
+
+// returnTop returns the word at the top of the stack.
+func (p program) returnTop() program {
+	return p.push(0).op(vm.MSTORE).push(32).push(0).op(vm.RETURN)
+}
+
+// callSelf calls the contract itself with one byte of call data using the
+// given call opcode and stores the first word returned at memory offset 0.
+func (p program) callSelf(callOp vm.OpCode) program {
+	p = p.push(32).push(0).push(1).push(0)
+	if callOp == vm.CALL {
+		p = p.push(0)
+	}
+	return p.op(vm.ADDRESS, vm.GAS, callOp)
+}
+
+// branch returns the code running inner when called with call data and outer
+// otherwise.
+func branch(inner, outer program) program {
+	// CALLDATASIZE ISZERO PUSH1 dest JUMPI
+	dest := 5 + len(inner)
+	code := program{}.op(vm.CALLDATASIZE, vm.ISZERO).push(uint64(dest)).op(vm.JUMPI)
+	code = append(code, inner...)
+	code = code.op(vm.JUMPDEST)
+	return append(code, outer...)
+}
+
+// newSpecEVM returns an EVM with the Cancun EIPs under test enabled.
+func newSpecEVM(db *statedb.StateDB) *vm.EVM {
+	blockCtx := vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, addr common.Address, amount *big.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+			db.SubBalance(sender, amount)
+			db.AddBalance(recipient, amount)
+		},
+		BlockNumber: big.NewInt(1),
+		BlobBaseFee: big.NewInt(1),
+	}
+	config := vm.Config{ExtraEips: []string{"ethereum_1153", "ethereum_5656", "ethereum_6780"}}
+	return vm.NewEVM(blockCtx, vm.TxContext{}, db, params.TestChainConfig, config)
+}
+
+// deploy commits the given code and balance to the address.
+func (suite *StateDBTestSuite) deploy(keeper *MockKeeper, address common.Address, code []byte, balance int64) {
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.CreateAccount(address)
+	db.SetCode(address, code)
+	db.AddBalance(address, big.NewInt(balance))
+	suite.Require().NoError(db.Commit())
+}
+
+func (suite *StateDBTestSuite) TestEIP1153Vectors() {
+	slot := common.BigToHash(big.NewInt(1))
+	testCases := []struct {
+		name     string
+		code     program
+		library  program
+		expRet   uint64
+		expGas   uint64
+		postTest func(db *statedb.StateDB)
+	}{
+		{
+			name:   "tload of an unset slot is zero",
+			code:   program{}.push(1).op(vm.TLOAD).returnTop(),
+			expRet: 0,
+		},
+		{
+			name:   "tload after tstore",
+			code:   program{}.push(42).push(1).op(vm.TSTORE).push(1).op(vm.TLOAD).returnTop(),
+			expRet: 42,
+			// 3 PUSH1, TSTORE and TLOAD at 100 and the 15 gas return of the word
+			expGas: 3*3 + 100 + 100 + 15,
+			postTest: func(db *statedb.StateDB) {
+				suite.Require().Equal(common.BigToHash(big.NewInt(42)), db.GetTransientState(specContract, slot))
+				suite.Require().Equal(common.Hash{}, db.GetState(specContract, slot))
+			},
+		},
+		{
+			name:   "tload after sstore is zero",
+			code:   program{}.push(42).push(1).op(vm.SSTORE).push(1).op(vm.TLOAD).returnTop(),
+			expRet: 0,
+			postTest: func(db *statedb.StateDB) {
+				suite.Require().Equal(common.BigToHash(big.NewInt(42)), db.GetState(specContract, slot))
+			},
+		},
+		{
+			name: "reentrant call reads the stored value",
+			code: branch(
+				program{}.push(0).op(vm.TLOAD).returnTop(),
+				program{}.push(42).push(0).op(vm.TSTORE).callSelf(vm.CALL).op(vm.POP).push(32).push(0).op(vm.RETURN),
+			),
+			expRet: 42,
+		},
+		{
+			name: "reverted call discards its writes",
+			code: branch(
+				program{}.push(2).push(0).op(vm.TSTORE).push(0).push(0).op(vm.REVERT),
+				program{}.push(1).push(0).op(vm.TSTORE).callSelf(vm.CALL).op(vm.POP).push(0).op(vm.TLOAD).returnTop(),
+			),
+			expRet: 1,
+		},
+		{
+			name: "tstore in a static call fails",
+			code: branch(
+				program{}.push(1).push(0).op(vm.TSTORE, vm.STOP),
+				program{}.callSelf(vm.STATICCALL).returnTop(),
+			),
+			expRet: 0,
+			postTest: func(db *statedb.StateDB) {
+				suite.Require().Equal(common.Hash{}, db.GetTransientState(specContract, common.Hash{}))
+			},
+		},
+		{
+			name: "tstore in a delegate call writes the caller storage",
+			code: program{}.push(0).push(0).push(0).push(0).pushAddress(specLibrary).op(vm.GAS, vm.DELEGATECALL, vm.POP).
+				push(0).op(vm.TLOAD).returnTop(),
+			library: program{}.push(42).push(0).op(vm.TSTORE, vm.STOP),
+			expRet:  42,
+			postTest: func(db *statedb.StateDB) {
+				suite.Require().Equal(common.Hash{}, db.GetTransientState(specLibrary, common.Hash{}))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			keeper := NewMockKeeper()
+			suite.deploy(keeper, specContract, tc.code, 0)
+			if tc.library != nil {
+				suite.deploy(keeper, specLibrary, tc.library, 0)
+			}
+
+			db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			// the called contract is warm, as in a transaction
+			db.AddAddressToAccessList(specContract)
+			ret, leftOverGas, err := newSpecEVM(db).Call(vm.AccountRef(specCaller), specContract, nil, specTestGas, big.NewInt(0))
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRet, new(big.Int).SetBytes(ret).Uint64())
+			if tc.expGas != 0 {
+				suite.Require().Equal(tc.expGas, specTestGas-leftOverGas)
+			}
+			if tc.postTest != nil {
+				tc.postTest(db)
+			}
+		})
+	}
+
+	suite.Run("transient storage is discarded after the transaction", func() {
+		keeper := NewMockKeeper()
+		code := branch(
+			program{}.push(0).op(vm.TLOAD).returnTop(),
+			program{}.push(42).push(0).op(vm.TSTORE, vm.STOP),
+		)
+		suite.deploy(keeper, specContract, code, 0)
+
+		db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		_, _, err := newSpecEVM(db).Call(vm.AccountRef(specCaller), specContract, nil, specTestGas, big.NewInt(0))
+		suite.Require().NoError(err)
+		suite.Require().NoError(db.Commit())
+
+		db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		ret, _, err := newSpecEVM(db).Call(vm.AccountRef(specCaller), specContract, []byte{1}, specTestGas, big.NewInt(0))
+		suite.Require().NoError(err)
+		suite.Require().Equal(common.Hash{}, common.BytesToHash(ret))
+	})
+}
+
+func (suite *StateDBTestSuite) TestEIP5656Vectors() {
+	testCases := []struct {
+		name   string
+		code   program
+		expRet []byte
+		expGas uint64
+	}{
+		{
+			name:   "mcopy expands the memory",
+			code:   program{}.push(32).push(0).push(0).op(vm.MCOPY).push(32).push(0).op(vm.RETURN),
+			expRet: make([]byte, 32),
+			// 5 PUSH1, MCOPY with one copied word and one memory word
+			expGas: 5*3 + 3 + 3 + 3,
+		},
+		{
+			name: "mcopy copies a word",
+			code: program{}.push(0x0102030405060708).push(32).op(vm.MSTORE).
+				push(32).push(32).push(0).op(vm.MCOPY).push(64).push(0).op(vm.RETURN),
+			expRet: append(
+				common.BigToHash(big.NewInt(0x0102030405060708)).Bytes(),
+				common.BigToHash(big.NewInt(0x0102030405060708)).Bytes()...,
+			),
+		},
+		{
+			name:   "mcopy of zero bytes out of bounds",
+			code:   program{}.push(0).push(0xFFFFFFFFFFFF).push(0xFFFFFFFFFFFF).op(vm.MCOPY).push(0).push(0).op(vm.RETURN),
+			expRet: []byte{},
+			// 5 PUSH and the MCOPY static gas, without memory expansion
+			expGas: 5*3 + 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			keeper := NewMockKeeper()
+			suite.deploy(keeper, specContract, tc.code, 0)
+
+			db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			ret, leftOverGas, err := newSpecEVM(db).Call(vm.AccountRef(specCaller), specContract, nil, specTestGas, big.NewInt(0))
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRet, append([]byte{}, ret...))
+			if tc.expGas != 0 {
+				suite.Require().Equal(tc.expGas, specTestGas-leftOverGas)
+			}
+		})
+	}
+
+	suite.Run("mcopy out of the uint64 space fails", func() {
+		keeper := NewMockKeeper()
+		code := program{}.push(1).push(0).op(vm.PUSH9)
+		code = append(code, common.FromHex("0x010000000000000000")...)
+		code = code.op(vm.MCOPY, vm.STOP)
+		suite.deploy(keeper, specContract, code, 0)
+
+		db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		_, leftOverGas, err := newSpecEVM(db).Call(vm.AccountRef(specCaller), specContract, nil, specTestGas, big.NewInt(0))
+		suite.Require().ErrorIs(err, vm.ErrGasUintOverflow)
+		suite.Require().Zero(leftOverGas)
+	})
+}
+
+func (suite *StateDBTestSuite) TestEIP6780Vectors() {
+	slot := common.BigToHash(big.NewInt(1))
+	selfdestruct := program{}.pushAddress(specBeneficiary).op(vm.SELFDESTRUCT)
+	// initcode returning the selfdestruct code as the contract code
+	initcode := program{}.push(uint64(len(selfdestruct))).push(12).push(0).op(vm.CODECOPY).
+		push(uint64(len(selfdestruct))).push(0).op(vm.RETURN)
+	initcode = append(initcode, selfdestruct...)
+
+	suite.Run("selfdestruct in the initcode deletes the contract", func() {
+		keeper := NewMockKeeper()
+		suite.deploy(keeper, specCaller, nil, 100)
+
+		db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		_, contract, _, err := newSpecEVM(db).Create(vm.AccountRef(specCaller), selfdestruct, specTestGas, big.NewInt(100))
+		suite.Require().NoError(err)
+		suite.Require().NoError(db.Commit())
+
+		db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		suite.Require().False(db.Exist(contract))
+		suite.Require().Equal(big.NewInt(100), db.GetBalance(specBeneficiary))
+	})
+
+	suite.Run("selfdestruct in the creation transaction deletes the contract", func() {
+		keeper := NewMockKeeper()
+		suite.deploy(keeper, specCaller, nil, 100)
+
+		db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		evm := newSpecEVM(db)
+		code, contract, _, err := evm.Create(vm.AccountRef(specCaller), initcode, specTestGas, big.NewInt(100))
+		suite.Require().NoError(err)
+		suite.Require().Equal([]byte(selfdestruct), code)
+
+		_, _, err = evm.Call(vm.AccountRef(specCaller), contract, nil, specTestGas, big.NewInt(0))
+		suite.Require().NoError(err)
+		suite.Require().NoError(db.Commit())
+
+		db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		suite.Require().False(db.Exist(contract))
+		suite.Require().Equal(big.NewInt(100), db.GetBalance(specBeneficiary))
+	})
+
+	suite.Run("selfdestruct of an existing contract only sends its balance", func() {
+		keeper := NewMockKeeper()
+		suite.deploy(keeper, specContract, selfdestruct, 100)
+		db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		db.SetState(specContract, slot, common.BigToHash(big.NewInt(42)))
+		suite.Require().NoError(db.Commit())
+
+		db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		_, _, err := newSpecEVM(db).Call(vm.AccountRef(specCaller), specContract, nil, specTestGas, big.NewInt(0))
+		suite.Require().NoError(err)
+		suite.Require().NoError(db.Commit())
+
+		db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		suite.Require().True(db.Exist(specContract))
+		suite.Require().Equal([]byte(selfdestruct), db.GetCode(specContract))
+		suite.Require().Equal(common.BigToHash(big.NewInt(42)), db.GetState(specContract, slot))
+		suite.Require().Zero(db.GetBalance(specContract).Sign())
+		suite.Require().Equal(big.NewInt(100), db.GetBalance(specBeneficiary))
+	})
+
+	suite.Run("selfdestruct of an existing contract to itself keeps its balance", func() {
+		keeper := NewMockKeeper()
+		suite.deploy(keeper, specContract, program{}.op(vm.ADDRESS, vm.SELFDESTRUCT), 100)
+
+		db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		_, _, err := newSpecEVM(db).Call(vm.AccountRef(specCaller), specContract, nil, specTestGas, big.NewInt(0))
+		suite.Require().NoError(err)
+		suite.Require().NoError(db.Commit())
+
+		db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		suite.Require().True(db.Exist(specContract))
+		suite.Require().Equal(big.NewInt(100), db.GetBalance(specContract))
+	})
+}
@@ -120,6 +120,10 @@ type (
 		account       *common.Address
 		key, prevalue common.Hash
 	}
+	transientStorageChange struct {
+		account       *common.Address
+		key, prevalue common.Hash
+	}
 	codeChange struct {
 		account            *common.Address
 		prevcode, prevhash []byte
@@ -152,6 +156,7 @@ var (
 	_ JournalEntry = balanceChange{}
 	_ JournalEntry = nonceChange{}
 	_ JournalEntry = storageChange{}
+	_ JournalEntry = transientStorageChange{}
 	_ JournalEntry = codeChange{}
 	_ JournalEntry = refundChange{}
 	_ JournalEntry = addLogChange{}
@@ -236,6 +241,14 @@ func (ch storageChange) Dirtied() *common.Address {
 	return ch.account
 }
 
+func (ch transientStorageChange) Revert(s *StateDB) {
+	s.setTransientState(*ch.account, ch.key, ch.prevalue)
+}
+
+func (ch transientStorageChange) Dirtied() *common.Address {
+	return nil
+}
+
 func (ch refundChange) Revert(s *StateDB) {
 	s.refund = ch.prev
 }
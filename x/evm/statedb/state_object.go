@@ -66,6 +66,9 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	// created is set when the account is created in the current transaction,
+	// it restricts SELFDESTRUCT as defined by EIP-6780.
+	created bool
 }
 
 // newObject creates a state object.
@@ -316,6 +316,15 @@ func (suite *StateDBTestSuite) TestRevertSnapshot() {
 			db.SetCode(address, []byte("hello world"))
 			suite.Require().True(db.Suicide(address))
 		}},
+		{"set transient state", func(db vm.StateDB) {
+			db.SetTransientState(address, v1, v3)
+		}},
+		{"selfdestruct 6780", func(db vm.StateDB) {
+			db.CreateAccount(address3)
+			db.AddBalance(address3, big.NewInt(10))
+			db.Selfdestruct6780(address3)
+			suite.Require().True(db.HasSuicided(address3))
+		}},
 		{"add log", func(db vm.StateDB) {
 			db.AddLog(&ethtypes.Log{
 				Address: address,
@@ -387,6 +396,86 @@ func (suite *StateDBTestSuite) TestNestedSnapshot() {
 	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
 }
 
+func (suite *StateDBTestSuite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	rev1 := db.Snapshot()
+	db.SetTransientState(address, key, value1)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+	// the transient storage is isolated per account and from the persistent storage
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
+
+	rev2 := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	db.SetTransientState(address2, key, value2)
+	suite.Require().Equal(value2, db.GetTransientState(address, key))
+
+	// clearing a slot is reverted too
+	rev3 := db.Snapshot()
+	db.SetTransientState(address, key, common.Hash{})
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	db.RevertToSnapshot(rev3)
+	suite.Require().Equal(value2, db.GetTransientState(address, key))
+
+	db.RevertToSnapshot(rev2)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+
+	db.RevertToSnapshot(rev1)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	// the transient storage is discarded at the end of the transaction
+	db.SetTransientState(address, key, value1)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Empty(keeper.accounts)
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+}
+
+func (suite *StateDBTestSuite) TestSelfdestruct6780() {
+	keeper := NewMockKeeper()
+
+	// an account created before the transaction is not deleted
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.CreateAccount(address)
+	db.SetCode(address, []byte("hello world"))
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.Selfdestruct6780(address)
+	suite.Require().False(db.HasSuicided(address))
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().True(db.Exist(address))
+	suite.Require().Equal([]byte("hello world"), db.GetCode(address))
+
+	// an account created in the same transaction is deleted
+	db.CreateAccount(address2)
+	db.SetCode(address2, []byte("hello world"))
+	db.AddBalance(address2, big.NewInt(100))
+	db.Selfdestruct6780(address2)
+	suite.Require().True(db.HasSuicided(address2))
+	suite.Require().Equal(big.NewInt(0), db.GetBalance(address2))
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().False(db.Exist(address2))
+
+	// non-existent account
+	db.Selfdestruct6780(address3)
+	suite.Require().False(db.HasSuicided(address3))
+}
+
 func (suite *StateDBTestSuite) TestInvalidSnapshotId() {
 	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
 	suite.Require().Panics(func() {
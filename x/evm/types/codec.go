@@ -25,7 +25,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName              = "ethermint/MsgUpdateParams"
+	addPermissionedAddressName    = "ethermint/MsgAddPermissionedAddress"
+	removePermissionedAddressName = "ethermint/MsgRemovePermissionedAddress"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,6 +46,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgAddPermissionedAddress{},
+		&MsgRemovePermissionedAddress{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -51,6 +55,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&DynamicFeeTx{},
 		&AccessListTx{},
 		&LegacyTx{},
+		&SetCodeTx{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -91,4 +96,6 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgAddPermissionedAddress{}, addPermissionedAddressName, nil)
+	cdc.RegisterConcrete(&MsgRemovePermissionedAddress{}, removePermissionedAddressName, nil)
 }
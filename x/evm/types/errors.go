@@ -32,6 +32,11 @@ const (
 	codeErrInactivePrecompile
 	codeErrABIPack
 	codeErrABIUnpack
+	codeErrInvalidAuthorization
+	codeErrCallDenylisted
+	codeErrDeployerNotWhitelisted
+	codeErrCreateNotPermitted
+	codeErrCallNotPermitted
 )
 
 var (
@@ -88,6 +93,24 @@ var (
 
 	// ErrABIUnpack returns an error if the contract ABI unpacking fails
 	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
+
+	// ErrInvalidAuthorization returns an error if a set code authorization is invalid
+	ErrInvalidAuthorization = errorsmod.Register(ModuleName, codeErrInvalidAuthorization, "invalid set code authorization")
+
+	// ErrCallDenylisted returns an error if a call targets an address of the call denylist
+	ErrCallDenylisted = errorsmod.Register(ModuleName, codeErrCallDenylisted, "call target is denylisted")
+
+	// ErrDeployerNotWhitelisted returns an error if a contract is deployed by an address
+	// that is not whitelisted while the chain status check is enabled
+	ErrDeployerNotWhitelisted = errorsmod.Register(ModuleName, codeErrDeployerNotWhitelisted, "contract deployer is not whitelisted")
+
+	// ErrCreateNotPermitted returns an error if the access control policy does not allow
+	// an address to deploy contracts
+	ErrCreateNotPermitted = errorsmod.Register(ModuleName, codeErrCreateNotPermitted, "contract creation not permitted")
+
+	// ErrCallNotPermitted returns an error if the access control policy does not allow
+	// an address to perform calls
+	ErrCallNotPermitted = errorsmod.Register(ModuleName, codeErrCallNotPermitted, "call not permitted")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
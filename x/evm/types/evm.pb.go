@@ -92,6 +92,21 @@ type Params struct {
 	EnableWalletLockCheck bool `protobuf:"varint,17,opt,name=enable_wallet_lock_check,json=enableWalletLockCheck,proto3" json:"enable_wallet_lock_check,omitempty" yaml:"enable_wallet_lock_check"`
 	// multi_sig_address is the bech32 address of the multi-sig wallet for daily emissions (empty during bootstrap)
 	MultiSigAddress string `protobuf:"bytes,18,opt,name=multi_sig_address,json=multiSigAddress,proto3" json:"multi_sig_address,omitempty" yaml:"multi_sig_address"`
+	// call_denylist is the list of hex addresses that cannot be called when the
+	// nexqloud_0 call denylist policy is enabled in the extra_eips
+	CallDenylist []string `protobuf:"bytes,19,rep,name=call_denylist,json=callDenylist,proto3" json:"call_denylist,omitempty" yaml:"call_denylist"`
+	// refund_quotient caps the gas refund of a transaction to its gas used divided by
+	// the quotient. Zero applies the fork rules (2 before London, 5 after EIP-3529)
+	RefundQuotient uint64 `protobuf:"varint,20,opt,name=refund_quotient,json=refundQuotient,proto3" json:"refund_quotient,omitempty" yaml:"refund_quotient"`
+	// contract_creation_byte_gas is the intrinsic gas surcharge per byte of the data
+	// of contract creation transactions
+	ContractCreationByteGas uint64 `protobuf:"varint,21,opt,name=contract_creation_byte_gas,json=contractCreationByteGas,proto3" json:"contract_creation_byte_gas,omitempty" yaml:"contract_creation_byte_gas"`
+	// system_call_gas_discount_bps is the discount, in basis points, of the intrinsic
+	// gas of the transactions calling the online server count or wallet state contracts
+	SystemCallGasDiscountBPS uint32 `protobuf:"varint,22,opt,name=system_call_gas_discount_bps,json=systemCallGasDiscountBps,proto3" json:"system_call_gas_discount_bps,omitempty" yaml:"system_call_gas_discount_bps"`
+	// block_proposer_retention is the number of recent blocks whose proposer is
+	// kept in state for the block proposer queries. Zero disables the recording
+	BlockProposerRetention uint64 `protobuf:"varint,23,opt,name=block_proposer_retention,json=blockProposerRetention,proto3" json:"block_proposer_retention,omitempty" yaml:"block_proposer_retention"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -232,6 +247,41 @@ func (m *Params) GetMultiSigAddress() string {
 	return ""
 }
 
+func (m *Params) GetCallDenylist() []string {
+	if m != nil {
+		return m.CallDenylist
+	}
+	return nil
+}
+
+func (m *Params) GetRefundQuotient() uint64 {
+	if m != nil {
+		return m.RefundQuotient
+	}
+	return 0
+}
+
+func (m *Params) GetContractCreationByteGas() uint64 {
+	if m != nil {
+		return m.ContractCreationByteGas
+	}
+	return 0
+}
+
+func (m *Params) GetSystemCallGasDiscountBPS() uint32 {
+	if m != nil {
+		return m.SystemCallGasDiscountBPS
+	}
+	return 0
+}
+
+func (m *Params) GetBlockProposerRetention() uint64 {
+	if m != nil {
+		return m.BlockProposerRetention
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -921,144 +971,156 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 2185 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcb, 0x4f, 0x1c, 0xc9,
-	0x19, 0xe7, 0xd1, 0x40, 0x53, 0x33, 0xcc, 0x34, 0xc5, 0x80, 0xc7, 0xd8, 0x4b, 0x93, 0xb6, 0x95,
-	0x90, 0xd5, 0x06, 0x0c, 0x5e, 0x12, 0xc7, 0x9b, 0x17, 0x83, 0xc7, 0x59, 0x08, 0xf6, 0xa2, 0x1a,
-	0x9c, 0xd5, 0x46, 0xbb, 0x6a, 0xd5, 0x74, 0x97, 0x7b, 0x7a, 0xe9, 0xee, 0x1a, 0x75, 0xd5, 0x8c,
-	0x21, 0x52, 0xee, 0x2b, 0xe7, 0x92, 0xfd, 0x03, 0x2c, 0xad, 0x94, 0x7f, 0x24, 0xc7, 0x55, 0x4e,
-	0x7b, 0x8c, 0x22, 0xa5, 0x15, 0xe1, 0x1b, 0xc7, 0xb9, 0x47, 0x8a, 0xea, 0x31, 0x6f, 0xcc, 0xb2,
-	0x17, 0xe8, 0xef, 0xf5, 0xfb, 0x55, 0x7d, 0xf5, 0x55, 0x7d, 0x55, 0x03, 0x56, 0x09, 0x6f, 0x90,
-	0x34, 0x0e, 0x13, 0xbe, 0x45, 0xda, 0xf1, 0x56, 0x7b, 0x5b, 0xfc, 0xdb, 0x6c, 0xa6, 0x94, 0x53,
-	0x68, 0xf5, 0x6c, 0x9b, 0x42, 0xd9, 0xde, 0x5e, 0x2d, 0x05, 0x34, 0xa0, 0xd2, 0xb8, 0x25, 0xbe,
-	0x94, 0x9f, 0xf3, 0x35, 0x00, 0xb3, 0xc7, 0x38, 0xc5, 0x31, 0x83, 0xdb, 0x60, 0x9e, 0xb4, 0x63,
-	0xd7, 0x27, 0x09, 0x8d, 0xcb, 0x93, 0xeb, 0x93, 0x1b, 0xf3, 0x95, 0x52, 0x27, 0xb3, 0xad, 0x73,
-	0x1c, 0x47, 0x8f, 0x9d, 0x9e, 0xc9, 0x41, 0x26, 0x69, 0xc7, 0x4f, 0xc4, 0x27, 0xdc, 0x03, 0x80,
-	0x9c, 0xf1, 0x14, 0xbb, 0x24, 0x6c, 0xb2, 0xb2, 0xb1, 0x3e, 0xbd, 0x31, 0x5f, 0x71, 0x2e, 0x32,
-	0x7b, 0xbe, 0x2a, 0xb4, 0xd5, 0x83, 0x63, 0xd6, 0xc9, 0xec, 0x45, 0x0d, 0xd0, 0x73, 0x74, 0xd0,
-	0xbc, 0x14, 0xaa, 0x61, 0x93, 0xc1, 0x2f, 0x40, 0xde, 0x6b, 0xe0, 0x30, 0x71, 0x3d, 0x9a, 0xbc,
-	0x0c, 0x83, 0xf2, 0xcc, 0xfa, 0xe4, 0x46, 0x6e, 0xe7, 0xbd, 0xcd, 0xd1, 0xf1, 0x6f, 0xee, 0x0b,
-	0xaf, 0x7d, 0xe9, 0x54, 0xb9, 0xf3, 0x6d, 0x66, 0x4f, 0x74, 0x32, 0x7b, 0x49, 0x41, 0x0f, 0x02,
-	0x38, 0x28, 0xe7, 0xf5, 0x3d, 0xe1, 0x0e, 0x58, 0xc6, 0x51, 0x44, 0x5f, 0xb9, 0xad, 0x44, 0x4c,
-	0x98, 0x78, 0x9c, 0xf8, 0x2e, 0x3f, 0x63, 0xe5, 0xd9, 0xf5, 0xc9, 0x0d, 0x13, 0x2d, 0x49, 0xe3,
-	0x8b, 0xbe, 0xed, 0xe4, 0x8c, 0xc1, 0x1d, 0x90, 0x17, 0xb3, 0xf5, 0x1a, 0x38, 0x49, 0x48, 0xc4,
-	0xca, 0xa6, 0x9c, 0x57, 0xf1, 0x22, 0xb3, 0x73, 0xd5, 0x3f, 0x3e, 0xdb, 0xd7, 0x6a, 0x94, 0x23,
-	0xed, 0xb8, 0x2b, 0xc0, 0x2f, 0x40, 0x01, 0x7b, 0x1e, 0x61, 0x4c, 0x0c, 0x83, 0xa7, 0x34, 0x2a,
-	0xcf, 0xcb, 0x89, 0xd8, 0xe3, 0x13, 0xd9, 0x93, 0x7e, 0xfb, 0xca, 0xad, 0xb2, 0x2c, 0xa6, 0x72,
-	0x91, 0xd9, 0x0b, 0x43, 0x6a, 0xb4, 0x80, 0x07, 0x45, 0xf8, 0x18, 0xdc, 0xc6, 0x1e, 0x0f, 0xdb,
-	0xc4, 0x65, 0x1c, 0xf3, 0xd0, 0x73, 0x9b, 0x29, 0xf1, 0x68, 0xdc, 0x0c, 0x23, 0xc2, 0xca, 0x40,
-	0x8c, 0x0f, 0xdd, 0x52, 0x0e, 0x35, 0x69, 0x3f, 0xee, 0x9b, 0x61, 0x03, 0xdc, 0xa5, 0x49, 0x14,
-	0x26, 0xc4, 0x65, 0x24, 0x6d, 0x93, 0xd4, 0xf5, 0x68, 0x2b, 0xe1, 0x6a, 0x9c, 0xd8, 0xe3, 0xe5,
-	0x9c, 0x5c, 0xea, 0x9f, 0x74, 0x32, 0xfb, 0x9e, 0x4a, 0xe7, 0x75, 0xde, 0x0e, 0xba, 0xad, 0xcc,
-	0x35, 0x69, 0xdd, 0x17, 0xc6, 0x7d, 0x6d, 0x83, 0x01, 0x28, 0x25, 0x2f, 0xfb, 0xbe, 0x2e, 0xf6,
-	0xfd, 0x94, 0x30, 0x56, 0xce, 0x4b, 0x86, 0xdd, 0x8b, 0xcc, 0x86, 0xcf, 0x9f, 0x9e, 0x74, 0xdd,
-	0xf7, 0x94, 0xb5, 0x93, 0xd9, 0x77, 0x14, 0xef, 0x55, 0xb1, 0x0e, 0x82, 0xc9, 0x4b, 0x3e, 0x12,
-	0x02, 0x4f, 0xc1, 0x7b, 0xaf, 0x70, 0x14, 0x11, 0x2e, 0xd3, 0x41, 0xc6, 0x19, 0x17, 0x24, 0xe3,
-	0x46, 0x27, 0xb3, 0xef, 0x2b, 0xec, 0x6b, 0xdd, 0x1d, 0xb4, 0xaa, 0xec, 0x22, 0x79, 0x64, 0x94,
-	0xec, 0x2f, 0xe0, 0x5e, 0x1b, 0x47, 0xa1, 0x8f, 0x39, 0x4d, 0x5d, 0xdc, 0x6c, 0xa6, 0xb4, 0x8d,
-	0xa3, 0x71, 0xca, 0x82, 0xa4, 0xdc, 0xec, 0x64, 0xf6, 0xfb, 0x8a, 0xf2, 0x06, 0x41, 0x0e, 0x5a,
-	0xef, 0x79, 0xed, 0x69, 0xa7, 0x51, 0xfa, 0x17, 0x60, 0xf9, 0x55, 0x23, 0xe4, 0x24, 0x0a, 0x99,
-	0xa8, 0x5d, 0x1d, 0x49, 0x58, 0xb9, 0x28, 0xcb, 0x72, 0xbd, 0x93, 0xd9, 0x77, 0xf5, 0x1c, 0xaf,
-	0x72, 0x73, 0x50, 0x69, 0x40, 0xbf, 0xd7, 0x55, 0x43, 0x17, 0xdc, 0x26, 0x09, 0xae, 0x47, 0xc4,
-	0x55, 0xbb, 0x47, 0x64, 0xa6, 0xc5, 0x5c, 0xaf, 0x41, 0xbc, 0xd3, 0xb2, 0x25, 0x36, 0x47, 0xe5,
-	0x7e, 0x27, 0xb3, 0xd7, 0xf5, 0xe6, 0x7d, 0x97, 0xab, 0x83, 0x56, 0x94, 0x4d, 0x6e, 0xcf, 0x9a,
-	0xb4, 0xec, 0x0b, 0x03, 0xfc, 0x1c, 0x94, 0x75, 0x94, 0xce, 0x7d, 0x44, 0xbd, 0x53, 0x8d, 0xbf,
-	0x28, 0xf1, 0xef, 0x75, 0x32, 0xdb, 0x1e, 0xc2, 0x1f, 0xf3, 0x74, 0xd0, 0xb2, 0x32, 0x7d, 0x2a,
-	0x2d, 0x47, 0xd4, 0x3b, 0x55, 0xe8, 0x1f, 0x83, 0xc5, 0xb8, 0x15, 0xf1, 0xd0, 0x65, 0x61, 0xd0,
-	0x5b, 0x02, 0x28, 0x97, 0xe0, 0x6e, 0x27, 0xb3, 0xcb, 0x0a, 0x76, 0xcc, 0xc5, 0x41, 0x45, 0xa9,
-	0xab, 0x85, 0x81, 0x4e, 0xc5, 0xa1, 0x61, 0x4e, 0x59, 0xd3, 0x87, 0x86, 0x39, 0x6d, 0x19, 0x87,
-	0x86, 0x39, 0x67, 0x99, 0xce, 0xd7, 0x93, 0x60, 0x78, 0x37, 0xc2, 0x3d, 0x30, 0xeb, 0xa5, 0x04,
-	0x73, 0x22, 0xcf, 0xc5, 0xdc, 0xce, 0xbd, 0xef, 0xd9, 0xd5, 0x27, 0xe7, 0x4d, 0x52, 0x31, 0xc4,
-	0xce, 0x46, 0x3a, 0x10, 0xfe, 0x1a, 0x18, 0x1e, 0x8e, 0xa2, 0xf2, 0xd4, 0x0f, 0x05, 0x90, 0x61,
-	0xce, 0x7f, 0x26, 0xc1, 0xe2, 0x98, 0x07, 0xf4, 0x40, 0x4e, 0x9f, 0x3a, 0xfc, 0xbc, 0xa9, 0x06,
-	0x57, 0xd8, 0xb9, 0xfb, 0x2e, 0x6c, 0x09, 0x7a, 0xff, 0x22, 0xb3, 0x41, 0x5f, 0xee, 0x64, 0x36,
-	0x54, 0xb9, 0x1a, 0x00, 0x72, 0x10, 0xc0, 0x3d, 0x0f, 0xe8, 0x81, 0xa5, 0xe1, 0xa3, 0xcd, 0x15,
-	0xb5, 0x54, 0x9e, 0x92, 0xe5, 0xf7, 0xf0, 0x22, 0xb3, 0x87, 0x07, 0x76, 0x14, 0x32, 0xde, 0xc9,
-	0xec, 0xd5, 0x21, 0xd4, 0xc1, 0x48, 0x07, 0x2d, 0xe2, 0xd1, 0x00, 0xe7, 0x7f, 0x05, 0x90, 0x1b,
-	0x38, 0xe1, 0xe1, 0xe7, 0xa0, 0xd8, 0xa0, 0x31, 0x61, 0x9c, 0x60, 0xdf, 0xad, 0x8b, 0x82, 0xd0,
-	0x2d, 0xe9, 0xe1, 0xbf, 0x33, 0x7b, 0xd9, 0xa3, 0x2c, 0xa6, 0x8c, 0xf9, 0xa7, 0x9b, 0x21, 0xdd,
-	0x8a, 0x31, 0x6f, 0x6c, 0x1e, 0x24, 0x82, 0x74, 0x45, 0x91, 0x8e, 0x44, 0x3a, 0xa8, 0xd0, 0xd3,
-	0x54, 0x84, 0x02, 0x36, 0x40, 0xc1, 0xc7, 0xd4, 0x7d, 0x49, 0xd3, 0x53, 0x0d, 0x3e, 0x25, 0xc1,
-	0x2b, 0xef, 0x04, 0xbf, 0xc8, 0xec, 0xfc, 0x93, 0xbd, 0x4f, 0x9e, 0xd2, 0xf4, 0x54, 0x42, 0x74,
-	0x32, 0x7b, 0x59, 0x91, 0x0d, 0x03, 0x39, 0x28, 0xef, 0x63, 0xda, 0x73, 0x83, 0x9f, 0x02, 0xab,
-	0xe7, 0xc0, 0x5a, 0xcd, 0x26, 0x4d, 0x79, 0x79, 0x5a, 0x56, 0xff, 0xcf, 0x2e, 0x32, 0xbb, 0xa0,
-	0x21, 0x6b, 0xca, 0xd2, 0xc9, 0xec, 0x5b, 0x23, 0xa0, 0x3a, 0xc6, 0x41, 0x05, 0x0d, 0xab, 0x5d,
-	0x61, 0x1d, 0xe4, 0x49, 0xd8, 0xdc, 0xde, 0x7d, 0xa0, 0x27, 0x60, 0xc8, 0x09, 0xfc, 0xf6, 0xba,
-	0x09, 0xe4, 0xaa, 0x07, 0xc7, 0xdb, 0xbb, 0x0f, 0xba, 0xe3, 0xd7, 0xcd, 0x73, 0x10, 0xc5, 0x41,
-	0x39, 0x25, 0xaa, 0xc1, 0x1f, 0x00, 0x2d, 0xba, 0x0d, 0xcc, 0x1a, 0xb2, 0x35, 0xcf, 0x57, 0x36,
-	0x44, 0x01, 0x29, 0xa4, 0x8f, 0x31, 0x6b, 0xf4, 0xb3, 0x5e, 0x3f, 0xff, 0x33, 0x4e, 0x78, 0xd8,
-	0x8a, 0xbb, 0x58, 0x40, 0x05, 0x0b, 0xaf, 0xde, 0x70, 0x77, 0xf5, 0x70, 0x67, 0x6f, 0x3a, 0xdc,
-	0xdd, 0xab, 0x86, 0xbb, 0x3b, 0x3c, 0x5c, 0xe5, 0xd3, 0xe3, 0x78, 0xa4, 0x39, 0xe6, 0x6e, 0xca,
-	0xf1, 0xe8, 0x2a, 0x8e, 0x47, 0xc3, 0x1c, 0xca, 0x47, 0xd4, 0xe5, 0xc8, 0x3c, 0xcb, 0xe6, 0x8d,
-	0xeb, 0x72, 0x2c, 0x43, 0x85, 0x9e, 0x46, 0xa1, 0x9f, 0x82, 0x92, 0x47, 0x13, 0xc6, 0x85, 0x2e,
-	0xa1, 0xcd, 0x88, 0x68, 0x8a, 0x79, 0x49, 0xf1, 0xe8, 0x3a, 0x0a, 0xdd, 0x43, 0xaf, 0x0a, 0x77,
-	0xd0, 0xd2, 0xb0, 0x5a, 0x91, 0xb9, 0xc0, 0x6a, 0x12, 0x4e, 0x52, 0x56, 0x6f, 0xa5, 0x81, 0x26,
-	0x02, 0x92, 0xe8, 0xc3, 0xeb, 0x88, 0x74, 0x85, 0x8e, 0x86, 0x3a, 0xa8, 0xd8, 0x57, 0x29, 0x82,
-	0xcf, 0x40, 0x21, 0x14, 0xac, 0xf5, 0x56, 0xa4, 0xe1, 0xd5, 0x55, 0x63, 0xe7, 0x3a, 0x78, 0xbd,
-	0xab, 0x86, 0x03, 0x1d, 0xb4, 0xd0, 0x55, 0x28, 0x68, 0x1f, 0xc0, 0xb8, 0x15, 0xa6, 0x6e, 0x10,
-	0x61, 0x2f, 0x24, 0xa9, 0x86, 0x57, 0xf7, 0x8c, 0x9f, 0x5f, 0x07, 0x7f, 0xbb, 0xdb, 0x18, 0x46,
-	0x83, 0x1d, 0x64, 0x09, 0xe5, 0xef, 0x95, 0x4e, 0xb1, 0xd4, 0x40, 0xbe, 0x4e, 0xd2, 0x28, 0x4c,
-	0x34, 0xbe, 0xba, 0x55, 0x3c, 0xb8, 0x0e, 0x5f, 0x57, 0xd0, 0x60, 0x98, 0x83, 0x72, 0x4a, 0xec,
-	0x81, 0x46, 0x34, 0xf1, 0x69, 0x17, 0x74, 0xf1, 0xc6, 0xa0, 0x83, 0x61, 0x0e, 0xca, 0x29, 0x51,
-	0x81, 0x06, 0x60, 0x09, 0xa7, 0x29, 0x7d, 0x35, 0x92, 0x10, 0xd5, 0x10, 0x7f, 0x71, 0x1d, 0x76,
-	0xf7, 0x9c, 0x1e, 0x8f, 0x16, 0xe7, 0xb4, 0xd0, 0x0e, 0xa5, 0xc4, 0x07, 0x30, 0x48, 0xf1, 0xf9,
-	0x08, 0x4f, 0xe9, 0xc6, 0x89, 0x1f, 0x0f, 0x76, 0x90, 0x25, 0x94, 0x43, 0x2c, 0x5f, 0x82, 0x52,
-	0x4c, 0xd2, 0x80, 0xb8, 0x09, 0xe1, 0xac, 0x19, 0x85, 0x5c, 0xf3, 0x2c, 0xdf, 0x78, 0x1f, 0x5c,
-	0x15, 0xee, 0x20, 0x28, 0xd5, 0xcf, 0xb5, 0xb6, 0x57, 0xa5, 0xac, 0x81, 0x93, 0xa0, 0x81, 0x43,
-	0xcd, 0xb2, 0x72, 0xe3, 0x2a, 0x1d, 0x0e, 0x74, 0xd0, 0x42, 0x57, 0xd1, 0x5b, 0x6a, 0x0f, 0x27,
-	0x5e, 0xab, 0xbb, 0xd4, 0xb7, 0x6e, 0xbc, 0xd4, 0x83, 0x61, 0xe2, 0x45, 0x23, 0x45, 0x09, 0x7a,
-	0x68, 0x98, 0x05, 0xab, 0x78, 0x68, 0x98, 0x45, 0xcb, 0x3a, 0x34, 0x4c, 0xcb, 0x5a, 0x3c, 0x34,
-	0xcc, 0x25, 0xab, 0x84, 0x16, 0xce, 0x69, 0x44, 0xdd, 0xf6, 0x43, 0x15, 0x84, 0x72, 0xe4, 0x15,
-	0x66, 0xfa, 0xa0, 0x41, 0x05, 0x0f, 0x73, 0x1c, 0x9d, 0x33, 0x9d, 0x08, 0x64, 0xa9, 0xf4, 0x0c,
-	0xb4, 0xad, 0x2d, 0x30, 0x23, 0x2f, 0xbf, 0xd0, 0x02, 0xd3, 0xa7, 0xe4, 0x5c, 0x35, 0x5b, 0x24,
-	0x3e, 0x61, 0x09, 0xcc, 0xb4, 0x71, 0xd4, 0x22, 0xaa, 0x47, 0x22, 0x25, 0x38, 0xc7, 0xa0, 0x78,
-	0x92, 0xe2, 0x84, 0x89, 0x57, 0x07, 0x4d, 0x8e, 0x68, 0xc0, 0x20, 0x04, 0x86, 0xec, 0x13, 0x2a,
-	0x56, 0x7e, 0xc3, 0x9f, 0x02, 0x23, 0xa2, 0x01, 0x93, 0xb7, 0x85, 0xdc, 0xce, 0xf2, 0xf8, 0xd5,
-	0xe4, 0x88, 0x06, 0x48, 0xba, 0x38, 0xff, 0x9c, 0x02, 0xd3, 0x47, 0x34, 0x80, 0x65, 0x30, 0xd7,
-	0xbd, 0xd0, 0x29, 0xa4, 0xae, 0x08, 0x57, 0xc0, 0x2c, 0xa7, 0xcd, 0xd0, 0x53, 0x70, 0xf3, 0x48,
-	0x4b, 0x82, 0xd8, 0xc7, 0x1c, 0xcb, 0xc6, 0x9a, 0x47, 0xf2, 0x5b, 0x3c, 0xe2, 0xe4, 0xcc, 0xdc,
-	0xa4, 0x15, 0xd7, 0x49, 0x2a, 0xfb, 0xa3, 0x51, 0x29, 0x5e, 0x66, 0x76, 0x4e, 0xea, 0x9f, 0x4b,
-	0x35, 0x1a, 0x14, 0xe0, 0x07, 0x60, 0x8e, 0x9f, 0x0d, 0xf6, 0xba, 0xa5, 0xcb, 0xcc, 0x2e, 0xf2,
-	0xfe, 0x34, 0x45, 0x2b, 0x43, 0xb3, 0xfc, 0x4c, 0xb6, 0xb4, 0x2d, 0x60, 0xf2, 0x33, 0x37, 0x4c,
-	0x7c, 0x72, 0x26, 0xdb, 0x99, 0x51, 0x29, 0x5d, 0x66, 0xb6, 0x35, 0xe0, 0x7e, 0x20, 0x6c, 0x68,
-	0x8e, 0x9f, 0xc9, 0x0f, 0xf8, 0x01, 0x00, 0x6a, 0x48, 0x92, 0x41, 0x75, 0xa7, 0x85, 0xcb, 0xcc,
-	0x9e, 0x97, 0x5a, 0x89, 0xdd, 0xff, 0x84, 0x0e, 0x98, 0x51, 0xd8, 0xa6, 0xc4, 0xce, 0x5f, 0x66,
-	0xb6, 0x19, 0xd1, 0x40, 0x61, 0x2a, 0x93, 0x48, 0x55, 0x4a, 0x62, 0xda, 0x26, 0xbe, 0x6c, 0x11,
-	0x26, 0xea, 0x8a, 0xce, 0x5f, 0xa7, 0x80, 0x79, 0x72, 0x86, 0x08, 0x6b, 0x45, 0x1c, 0x3e, 0x05,
-	0xd6, 0xd8, 0x73, 0x45, 0xdd, 0xa6, 0xee, 0xf4, 0x0f, 0xf4, 0xf1, 0xb7, 0x49, 0xd1, 0x1b, 0x79,
-	0x8a, 0x94, 0xc0, 0x4c, 0x3d, 0xa2, 0x34, 0x96, 0x95, 0x90, 0x47, 0x4a, 0x80, 0x48, 0x66, 0x4d,
-	0xae, 0xf2, 0xb4, 0xbc, 0xdc, 0xfe, 0x68, 0x7c, 0x95, 0x47, 0x4a, 0xa5, 0xb2, 0xa2, 0x1f, 0xf0,
-	0x05, 0xc5, 0xad, 0xe3, 0x1d, 0x91, 0x5b, 0x59, 0x4a, 0x16, 0x98, 0x4e, 0x09, 0x97, 0x8b, 0x96,
-	0x47, 0xe2, 0x13, 0xae, 0x02, 0x33, 0x25, 0x6d, 0x92, 0x72, 0xe2, 0xcb, 0xc5, 0x31, 0x51, 0x4f,
-	0x86, 0xb7, 0x81, 0x19, 0x60, 0xe6, 0xb6, 0x18, 0xf1, 0xd5, 0x4a, 0xa0, 0xb9, 0x00, 0xb3, 0x17,
-	0x8c, 0xf8, 0x8f, 0x8d, 0xaf, 0xbe, 0xb1, 0x27, 0x1c, 0x0c, 0x72, 0xfa, 0xca, 0xdb, 0x6a, 0x46,
-	0xe4, 0x9a, 0x0a, 0xdb, 0x01, 0x79, 0xc6, 0x69, 0x8a, 0x03, 0xe2, 0x9e, 0x92, 0x73, 0x5d, 0x67,
-	0xaa, 0x6a, 0xb4, 0xfe, 0x0f, 0xe4, 0x9c, 0xa1, 0x41, 0x41, 0x53, 0x7c, 0x63, 0x80, 0xdc, 0x49,
-	0x8a, 0x3d, 0xa2, 0x2f, 0xb0, 0xa2, 0x56, 0x85, 0x98, 0x6a, 0x0a, 0x2d, 0x09, 0x6e, 0x1e, 0xc6,
-	0x84, 0xb6, 0xb8, 0xde, 0x4f, 0x5d, 0x51, 0x44, 0xa4, 0x84, 0x9c, 0x11, 0x4f, 0xa6, 0xd1, 0x40,
-	0x5a, 0x82, 0xbb, 0x60, 0xc1, 0x0f, 0x99, 0x7c, 0x1f, 0x31, 0x8e, 0xbd, 0x53, 0x35, 0xfd, 0x8a,
-	0x75, 0x99, 0xd9, 0x79, 0x6d, 0xa8, 0x09, 0x3d, 0x1a, 0x92, 0xe0, 0x47, 0xa0, 0xd8, 0x0f, 0x93,
-	0xa3, 0x55, 0xbf, 0x79, 0x54, 0xe0, 0x65, 0x66, 0x17, 0x7a, 0xae, 0xd2, 0x82, 0x46, 0x64, 0xb1,
-	0xd2, 0x3e, 0xa9, 0xb7, 0x02, 0x59, 0x7c, 0x26, 0x52, 0x82, 0xd0, 0x46, 0x61, 0x1c, 0x72, 0x59,
-	0x6c, 0x33, 0x48, 0x09, 0xf0, 0x23, 0x30, 0x4f, 0xdb, 0x24, 0x4d, 0x43, 0x5f, 0xfe, 0x16, 0xf1,
-	0xfd, 0x3f, 0xdf, 0xa0, 0xbe, 0xbf, 0x98, 0x9c, 0x7e, 0xfb, 0xc5, 0x24, 0xa6, 0xe9, 0xb9, 0xbc,
-	0x22, 0xe8, 0xc9, 0x29, 0xc3, 0x33, 0xa9, 0x47, 0x43, 0x12, 0xac, 0x00, 0xa8, 0xc3, 0x52, 0xc2,
-	0x5b, 0x69, 0xe2, 0xca, 0xfd, 0x9f, 0x97, 0xb1, 0x72, 0x17, 0x2a, 0x2b, 0x92, 0xc6, 0x27, 0x98,
-	0x63, 0x34, 0xa6, 0x81, 0xbf, 0x01, 0x50, 0xad, 0x89, 0xfb, 0x25, 0xa3, 0xbd, 0xdf, 0x9f, 0x54,
-	0x8f, 0x97, 0xfc, 0xca, 0xaa, 0xc7, 0x6c, 0x29, 0xe9, 0x90, 0x51, 0x3d, 0x8b, 0x43, 0xc3, 0x34,
-	0xac, 0x19, 0xf5, 0x64, 0xec, 0xe5, 0x4f, 0xcf, 0x02, 0x2d, 0x75, 0xe5, 0x81, 0xe1, 0xbd, 0xff,
-	0x8f, 0x49, 0x30, 0xf0, 0xf2, 0x82, 0xbf, 0x02, 0xab, 0x7b, 0xfb, 0xfb, 0xd5, 0x5a, 0xcd, 0x3d,
-	0xf9, 0xec, 0xb8, 0xea, 0x1e, 0x57, 0xd1, 0xb3, 0x83, 0x5a, 0xed, 0xe0, 0x93, 0xe7, 0x47, 0xd5,
-	0x5a, 0xcd, 0x9a, 0x58, 0xbd, 0xfb, 0xfa, 0xcd, 0x7a, 0xb9, 0xef, 0x7f, 0x2c, 0xf2, 0xc9, 0x58,
-	0x48, 0x93, 0x48, 0x54, 0xea, 0x87, 0x60, 0x65, 0x30, 0x1a, 0x55, 0x6b, 0x27, 0xe8, 0x60, 0xff,
-	0xa4, 0xfa, 0xc4, 0x9a, 0x5c, 0x2d, 0xbf, 0x7e, 0xb3, 0x5e, 0xea, 0x47, 0x22, 0xc2, 0x78, 0x1a,
-	0x7a, 0x62, 0xa7, 0x3c, 0x02, 0xe5, 0xab, 0x39, 0xab, 0x4f, 0xac, 0xa9, 0xd5, 0xd5, 0xd7, 0x6f,
-	0xd6, 0x57, 0xae, 0x62, 0x24, 0xfe, 0xaa, 0xf1, 0xd5, 0xdf, 0xd7, 0x26, 0x2a, 0xbf, 0xfb, 0xf6,
-	0x62, 0x6d, 0xf2, 0xbb, 0x8b, 0xb5, 0xc9, 0xff, 0x5e, 0xac, 0x4d, 0xfe, 0xed, 0xed, 0xda, 0xc4,
-	0x77, 0x6f, 0xd7, 0x26, 0xfe, 0xf5, 0x76, 0x6d, 0xe2, 0x4f, 0x3f, 0x0e, 0x42, 0xde, 0x68, 0xd5,
-	0x37, 0x3d, 0x1a, 0x6f, 0x91, 0x76, 0x4c, 0x99, 0xfe, 0xdb, 0xde, 0xfe, 0xe5, 0xd6, 0x99, 0xfc,
-	0x7d, 0x52, 0xbc, 0x2c, 0x59, 0x7d, 0x56, 0xfe, 0xee, 0xf8, 0xf0, 0xff, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xec, 0x3c, 0x3e, 0x14, 0xbd, 0x14, 0x00, 0x00,
+	// 2378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcb, 0x6f, 0x1b, 0xc7,
+	0xfd, 0x17, 0x25, 0x4a, 0xa6, 0x86, 0x14, 0xb9, 0x1a, 0x51, 0xf2, 0x9a, 0x76, 0xb4, 0xcc, 0x3a,
+	0xbf, 0x5f, 0xd5, 0x20, 0x95, 0x62, 0x39, 0x6a, 0xdd, 0xa4, 0x69, 0x2b, 0x52, 0x4c, 0x22, 0x55,
+	0x71, 0xd4, 0xa1, 0xd2, 0x20, 0x45, 0x82, 0xc5, 0x70, 0x77, 0x4c, 0x6e, 0xb4, 0xbb, 0xc3, 0xee,
+	0x0c, 0x69, 0xb1, 0x40, 0x4f, 0xbd, 0x04, 0xe9, 0xa5, 0xfd, 0x03, 0x02, 0x04, 0xe8, 0x3f, 0xd2,
+	0x63, 0xd0, 0x53, 0x8e, 0x45, 0x81, 0x2e, 0x0a, 0xfa, 0xa6, 0x23, 0xef, 0x05, 0x8a, 0x79, 0xf0,
+	0x2d, 0x2b, 0xea, 0x85, 0x9c, 0xef, 0xeb, 0xf3, 0x7d, 0xcc, 0x77, 0x5e, 0x0b, 0x4a, 0x84, 0xb7,
+	0x48, 0x1c, 0xfa, 0x11, 0xdf, 0x23, 0xdd, 0x70, 0xaf, 0xfb, 0x48, 0xfc, 0xed, 0xb6, 0x63, 0xca,
+	0x29, 0x34, 0x46, 0xb2, 0x5d, 0xc1, 0xec, 0x3e, 0x2a, 0x15, 0x9b, 0xb4, 0x49, 0xa5, 0x70, 0x4f,
+	0x8c, 0x94, 0x9e, 0x9d, 0xac, 0x81, 0x95, 0x33, 0x1c, 0xe3, 0x90, 0xc1, 0x47, 0x60, 0x95, 0x74,
+	0x43, 0xc7, 0x23, 0x11, 0x0d, 0xcd, 0x54, 0x39, 0xb5, 0xb3, 0x5a, 0x29, 0x0e, 0x12, 0xcb, 0xe8,
+	0xe1, 0x30, 0x78, 0xdb, 0x1e, 0x89, 0x6c, 0x94, 0x21, 0xdd, 0xf0, 0x48, 0x0c, 0xe1, 0x21, 0x00,
+	0xe4, 0x92, 0xc7, 0xd8, 0x21, 0x7e, 0x9b, 0x99, 0xe9, 0xf2, 0xd2, 0xce, 0x6a, 0xc5, 0xee, 0x27,
+	0xd6, 0x6a, 0x4d, 0x70, 0x6b, 0xc7, 0x67, 0x6c, 0x90, 0x58, 0xeb, 0x1a, 0x60, 0xa4, 0x68, 0xa3,
+	0x55, 0x49, 0xd4, 0xfc, 0x36, 0x83, 0x9f, 0x83, 0x9c, 0xdb, 0xc2, 0x7e, 0xe4, 0xb8, 0x34, 0x7a,
+	0xe6, 0x37, 0xcd, 0xe5, 0x72, 0x6a, 0x27, 0xbb, 0xff, 0xca, 0xee, 0x6c, 0xfc, 0xbb, 0x55, 0xa1,
+	0x55, 0x95, 0x4a, 0x95, 0xfb, 0xdf, 0x26, 0xd6, 0xc2, 0x20, 0xb1, 0x36, 0x14, 0xf4, 0x24, 0x80,
+	0x8d, 0xb2, 0xee, 0x58, 0x13, 0xee, 0x83, 0x4d, 0x1c, 0x04, 0xf4, 0xb9, 0xd3, 0x89, 0x44, 0xc2,
+	0xc4, 0xe5, 0xc4, 0x73, 0xf8, 0x25, 0x33, 0x57, 0xca, 0xa9, 0x9d, 0x0c, 0xda, 0x90, 0xc2, 0x8f,
+	0xc7, 0xb2, 0xf3, 0x4b, 0x06, 0xf7, 0x41, 0x4e, 0x64, 0xeb, 0xb6, 0x70, 0x14, 0x91, 0x80, 0x99,
+	0x19, 0x99, 0x57, 0xa1, 0x9f, 0x58, 0xd9, 0xda, 0x6f, 0x3e, 0xac, 0x6a, 0x36, 0xca, 0x92, 0x6e,
+	0x38, 0x24, 0xe0, 0xe7, 0x20, 0x8f, 0x5d, 0x97, 0x30, 0x26, 0xc2, 0xe0, 0x31, 0x0d, 0xcc, 0x55,
+	0x99, 0x88, 0x35, 0x9f, 0xc8, 0xa1, 0xd4, 0xab, 0x2a, 0xb5, 0xca, 0xa6, 0x48, 0xa5, 0x9f, 0x58,
+	0x6b, 0x53, 0x6c, 0xb4, 0x86, 0x27, 0x49, 0xf8, 0x36, 0xb8, 0x87, 0x5d, 0xee, 0x77, 0x89, 0xc3,
+	0x38, 0xe6, 0xbe, 0xeb, 0xb4, 0x63, 0xe2, 0xd2, 0xb0, 0xed, 0x07, 0x84, 0x99, 0x40, 0xc4, 0x87,
+	0xee, 0x2a, 0x85, 0xba, 0x94, 0x9f, 0x8d, 0xc5, 0xb0, 0x05, 0x1e, 0xd0, 0x28, 0xf0, 0x23, 0xe2,
+	0x30, 0x12, 0x77, 0x49, 0xec, 0xb8, 0xb4, 0x13, 0x71, 0x15, 0x27, 0x76, 0xb9, 0x99, 0x95, 0x53,
+	0xfd, 0x83, 0x41, 0x62, 0x3d, 0x54, 0xe5, 0xbc, 0x49, 0xdb, 0x46, 0xf7, 0x94, 0xb8, 0x2e, 0xa5,
+	0x55, 0x21, 0xac, 0x6a, 0x19, 0x6c, 0x82, 0x62, 0xf4, 0x6c, 0xac, 0xeb, 0x60, 0xcf, 0x8b, 0x09,
+	0x63, 0x66, 0x4e, 0x7a, 0x38, 0xe8, 0x27, 0x16, 0x7c, 0xfa, 0xde, 0xf9, 0x50, 0xfd, 0x50, 0x49,
+	0x07, 0x89, 0x75, 0x5f, 0xf9, 0xbd, 0xce, 0xd6, 0x46, 0x30, 0x7a, 0xc6, 0x67, 0x4c, 0xe0, 0x05,
+	0x78, 0xe5, 0x39, 0x0e, 0x02, 0xc2, 0x65, 0x39, 0xc8, 0xbc, 0xc7, 0x35, 0xe9, 0x71, 0x67, 0x90,
+	0x58, 0xaf, 0x29, 0xec, 0x1b, 0xd5, 0x6d, 0x54, 0x52, 0x72, 0x51, 0x3c, 0x32, 0xeb, 0xec, 0x0f,
+	0xe0, 0x61, 0x17, 0x07, 0xbe, 0x87, 0x39, 0x8d, 0x1d, 0xdc, 0x6e, 0xc7, 0xb4, 0x8b, 0x83, 0x79,
+	0x97, 0x79, 0xe9, 0x72, 0x77, 0x90, 0x58, 0xaf, 0x2b, 0x97, 0xb7, 0x30, 0xb2, 0x51, 0x79, 0xa4,
+	0x75, 0xa8, 0x95, 0x66, 0xdd, 0x7f, 0x0c, 0x36, 0x9f, 0xb7, 0x7c, 0x4e, 0x02, 0x9f, 0x89, 0xde,
+	0xd5, 0x96, 0x84, 0x99, 0x05, 0xd9, 0x96, 0xe5, 0x41, 0x62, 0x3d, 0xd0, 0x39, 0x5e, 0xa7, 0x66,
+	0xa3, 0xe2, 0x04, 0xff, 0x70, 0xc8, 0x86, 0x0e, 0xb8, 0x47, 0x22, 0xdc, 0x08, 0x88, 0xa3, 0x56,
+	0x8f, 0xa8, 0x4c, 0x87, 0x39, 0x6e, 0x8b, 0xb8, 0x17, 0xa6, 0x21, 0x16, 0x47, 0xe5, 0xb5, 0x41,
+	0x62, 0x95, 0xf5, 0xe2, 0x7d, 0x99, 0xaa, 0x8d, 0xb6, 0x94, 0x4c, 0x2e, 0xcf, 0xba, 0x94, 0x54,
+	0x85, 0x00, 0x7e, 0x06, 0x4c, 0x6d, 0xa5, 0x6b, 0x1f, 0x50, 0xf7, 0x42, 0xe3, 0xaf, 0x4b, 0xfc,
+	0x87, 0x83, 0xc4, 0xb2, 0xa6, 0xf0, 0xe7, 0x34, 0x6d, 0xb4, 0xa9, 0x44, 0x9f, 0x48, 0xc9, 0x29,
+	0x75, 0x2f, 0x14, 0xfa, 0x07, 0x60, 0x3d, 0xec, 0x04, 0xdc, 0x77, 0x98, 0xdf, 0x1c, 0x4d, 0x01,
+	0x94, 0x53, 0xf0, 0x60, 0x90, 0x58, 0xa6, 0x82, 0x9d, 0x53, 0xb1, 0x51, 0x41, 0xf2, 0xea, 0x7e,
+	0x73, 0x58, 0xdf, 0x77, 0xc1, 0x9a, 0x8b, 0x83, 0x40, 0x6c, 0x6e, 0x3d, 0x51, 0x24, 0x73, 0x43,
+	0xd6, 0xd5, 0x1c, 0x24, 0x56, 0x51, 0x6f, 0x2f, 0x93, 0x62, 0x1b, 0xe5, 0x04, 0x7d, 0xa4, 0x49,
+	0x58, 0x05, 0x85, 0x98, 0x3c, 0xeb, 0x44, 0x9e, 0xf3, 0xbb, 0x0e, 0xe5, 0x3e, 0x89, 0xb8, 0x59,
+	0x2c, 0xa7, 0x76, 0xd2, 0x95, 0xd2, 0x20, 0xb1, 0xb6, 0x14, 0xc0, 0x8c, 0x82, 0x8d, 0xf2, 0x8a,
+	0xf3, 0x6b, 0xcd, 0x80, 0x0d, 0x50, 0x1a, 0xb5, 0x86, 0x1b, 0x13, 0xcc, 0x7d, 0x1a, 0x39, 0x8d,
+	0x1e, 0x27, 0x4e, 0x13, 0x33, 0x73, 0x53, 0xe2, 0xfd, 0xdf, 0x20, 0xb1, 0x5e, 0xd5, 0x01, 0xbd,
+	0x54, 0xd7, 0x46, 0x77, 0x87, 0xc2, 0xaa, 0x96, 0x55, 0x7a, 0x9c, 0xbc, 0x8f, 0x19, 0xfc, 0x63,
+	0x0a, 0x3c, 0x60, 0x3d, 0xc6, 0x49, 0xe8, 0xc8, 0x84, 0x9a, 0x98, 0x39, 0x9e, 0xcf, 0xd4, 0xf2,
+	0x6e, 0xb4, 0x99, 0xb9, 0x55, 0x4e, 0xed, 0xac, 0x55, 0xaa, 0xfd, 0xc4, 0x32, 0xeb, 0x52, 0xaf,
+	0x8a, 0x83, 0xe0, 0x7d, 0xcc, 0x8e, 0xb4, 0x52, 0xe5, 0xac, 0x3e, 0xde, 0x23, 0x6e, 0x42, 0xb2,
+	0x91, 0xc9, 0xae, 0x05, 0x90, 0xdb, 0xbd, 0xd9, 0x90, 0xd3, 0xdb, 0x8e, 0x69, 0x9b, 0x32, 0x12,
+	0x3b, 0x31, 0xe1, 0x24, 0x12, 0x81, 0x9a, 0x77, 0x65, 0x9e, 0x13, 0x5d, 0xf1, 0x32, 0x4d, 0x1b,
+	0x6d, 0x49, 0xd1, 0x99, 0x96, 0xa0, 0xa1, 0xe0, 0x24, 0x9d, 0x59, 0x34, 0x96, 0x4e, 0xd2, 0x99,
+	0x25, 0x23, 0x7d, 0x92, 0xce, 0xdc, 0x31, 0x32, 0xf6, 0x5f, 0x52, 0x60, 0x7a, 0x6b, 0x85, 0x87,
+	0x60, 0x45, 0xd6, 0x8d, 0xc8, 0x43, 0x2e, 0xbb, 0xff, 0xf0, 0x7b, 0xb6, 0xe8, 0xf3, 0x5e, 0x9b,
+	0x54, 0xd2, 0x62, 0x9b, 0x46, 0xda, 0x10, 0xbe, 0x0b, 0xd2, 0x22, 0x73, 0x73, 0xf1, 0x7f, 0x05,
+	0x90, 0x66, 0xf6, 0xbf, 0x52, 0x60, 0x7d, 0x4e, 0x03, 0xba, 0x20, 0xab, 0x8f, 0x10, 0xde, 0x6b,
+	0xab, 0xe0, 0xf2, 0xfb, 0x0f, 0x5e, 0x86, 0x2d, 0x41, 0x5f, 0xeb, 0x27, 0x16, 0x18, 0xd3, 0x83,
+	0xc4, 0x82, 0xaa, 0x72, 0x13, 0x40, 0x36, 0x02, 0x78, 0xa4, 0x01, 0x5d, 0xb0, 0x31, 0x7d, 0x4e,
+	0x39, 0xb2, 0xe7, 0x17, 0x65, 0xcf, 0x3f, 0xee, 0x27, 0xd6, 0x74, 0x60, 0xa7, 0x3e, 0xe3, 0x83,
+	0xc4, 0x2a, 0x4d, 0xa1, 0x4e, 0x5a, 0xda, 0x68, 0x1d, 0xcf, 0x1a, 0xd8, 0xff, 0xc9, 0x83, 0xec,
+	0xc4, 0x71, 0x0d, 0x3f, 0x03, 0x85, 0x16, 0x0d, 0x09, 0xe3, 0x04, 0x7b, 0x8e, 0x9c, 0x39, 0x7d,
+	0xbf, 0x78, 0xfc, 0xcf, 0xc4, 0xda, 0x74, 0x29, 0x0b, 0x29, 0x63, 0xde, 0xc5, 0xae, 0x4f, 0xf7,
+	0x42, 0xcc, 0x5b, 0xbb, 0xc7, 0x11, 0x1f, 0x2f, 0x9e, 0x19, 0x4b, 0x1b, 0xe5, 0x47, 0x9c, 0x8a,
+	0x60, 0xc0, 0x16, 0xc8, 0x7b, 0x98, 0x3a, 0xcf, 0x68, 0x7c, 0xa1, 0xc1, 0x17, 0x25, 0x78, 0xe5,
+	0xa5, 0xe0, 0xfd, 0xc4, 0xca, 0x1d, 0x1d, 0x7e, 0xf4, 0x1e, 0x8d, 0x2f, 0x24, 0xc4, 0x20, 0xb1,
+	0x36, 0x95, 0xb3, 0x69, 0x20, 0x1b, 0xe5, 0x3c, 0x4c, 0x47, 0x6a, 0xf0, 0x13, 0x60, 0x8c, 0x14,
+	0x58, 0xa7, 0xdd, 0xa6, 0x31, 0x37, 0x97, 0xe4, 0x56, 0xf6, 0xa3, 0x7e, 0x62, 0xe5, 0x35, 0x64,
+	0x5d, 0x49, 0x06, 0x89, 0x75, 0x77, 0x06, 0x54, 0xdb, 0xd8, 0x28, 0xaf, 0x61, 0xb5, 0x2a, 0x6c,
+	0x80, 0x1c, 0xf1, 0xdb, 0x8f, 0x0e, 0xde, 0xd4, 0x09, 0xa4, 0x65, 0x02, 0xbf, 0xb8, 0x29, 0x81,
+	0x6c, 0xed, 0xf8, 0xec, 0xd1, 0xc1, 0x9b, 0xc3, 0xf8, 0xf5, 0x4d, 0x68, 0x12, 0xc5, 0x46, 0x59,
+	0x45, 0xaa, 0xe0, 0x8f, 0x81, 0x26, 0x9d, 0x16, 0x66, 0x2d, 0x79, 0xcf, 0x5a, 0xad, 0xec, 0x88,
+	0x06, 0x52, 0x48, 0x1f, 0x60, 0xd6, 0x1a, 0x57, 0xbd, 0xd1, 0xfb, 0x3d, 0x8e, 0xb8, 0xdf, 0x09,
+	0x87, 0x58, 0x40, 0x19, 0x0b, 0xad, 0x51, 0xb8, 0x07, 0x3a, 0xdc, 0x95, 0xdb, 0x86, 0x7b, 0x70,
+	0x5d, 0xb8, 0x07, 0xd3, 0xe1, 0x2a, 0x9d, 0x91, 0x8f, 0x27, 0xda, 0xc7, 0x9d, 0xdb, 0xfa, 0x78,
+	0x72, 0x9d, 0x8f, 0x27, 0xd3, 0x3e, 0x94, 0x8e, 0xe8, 0xcb, 0x99, 0x3c, 0xcd, 0xcc, 0xad, 0xfb,
+	0x72, 0xae, 0x42, 0xf9, 0x11, 0x47, 0xa1, 0x5f, 0x80, 0xa2, 0x4b, 0x23, 0xc6, 0x05, 0x2f, 0xa2,
+	0xed, 0x80, 0x68, 0x17, 0xab, 0xd2, 0xc5, 0x93, 0x9b, 0x5c, 0xdc, 0x1f, 0xed, 0xf3, 0x73, 0xe6,
+	0x36, 0xda, 0x98, 0x66, 0x2b, 0x67, 0x0e, 0x30, 0xda, 0x84, 0x93, 0x98, 0x35, 0x3a, 0x71, 0x53,
+	0x3b, 0x02, 0xd2, 0xd1, 0x5b, 0x37, 0x39, 0xd2, 0x1d, 0x3a, 0x6b, 0x6a, 0xa3, 0xc2, 0x98, 0xa5,
+	0x1c, 0x7c, 0x0a, 0xf2, 0xbe, 0xf0, 0xda, 0xe8, 0x04, 0x1a, 0x5e, 0xdd, 0x1b, 0xf7, 0x6f, 0x82,
+	0xd7, 0xab, 0x6a, 0xda, 0xd0, 0x46, 0x6b, 0x43, 0x86, 0x82, 0xf6, 0x00, 0x0c, 0x3b, 0x7e, 0xec,
+	0x34, 0x03, 0xec, 0xfa, 0x24, 0xd6, 0xf0, 0xea, 0xd2, 0xf8, 0xe3, 0x9b, 0xe0, 0xef, 0x0d, 0x4f,
+	0xf9, 0x59, 0x63, 0x1b, 0x19, 0x82, 0xf9, 0xbe, 0xe2, 0x29, 0x2f, 0x75, 0x90, 0x6b, 0x90, 0x38,
+	0xf0, 0x23, 0x8d, 0xaf, 0xae, 0x88, 0x6f, 0xde, 0x84, 0xaf, 0x3b, 0x68, 0xd2, 0xcc, 0x46, 0x59,
+	0x45, 0x8e, 0x40, 0x03, 0x1a, 0x79, 0x74, 0x08, 0xba, 0x7e, 0x6b, 0xd0, 0x49, 0x33, 0x1b, 0x65,
+	0x15, 0xa9, 0x40, 0x9b, 0x60, 0x03, 0xc7, 0x31, 0x7d, 0x3e, 0x53, 0x10, 0x75, 0xbb, 0xf9, 0xc9,
+	0x4d, 0xd8, 0xc3, 0x7d, 0x7a, 0xde, 0x5a, 0xec, 0xd3, 0x82, 0x3b, 0x55, 0x12, 0x0f, 0xc0, 0x66,
+	0x8c, 0x7b, 0x33, 0x7e, 0x8a, 0xb7, 0x2e, 0xfc, 0xbc, 0xb1, 0x8d, 0x0c, 0xc1, 0x9c, 0xf2, 0xf2,
+	0x05, 0x28, 0x86, 0x24, 0x6e, 0x12, 0x27, 0x22, 0x9c, 0xb5, 0x03, 0x9f, 0x6b, 0x3f, 0x9b, 0xb7,
+	0x5e, 0x07, 0xd7, 0x99, 0xdb, 0x08, 0x4a, 0xf6, 0x53, 0xcd, 0x1d, 0x75, 0x29, 0x6b, 0xe1, 0xa8,
+	0xd9, 0xc2, 0xbe, 0xf6, 0xb2, 0x75, 0xeb, 0x2e, 0x9d, 0x36, 0xb4, 0xd1, 0xda, 0x90, 0x31, 0x9a,
+	0x6a, 0x17, 0x47, 0x6e, 0x67, 0x38, 0xd5, 0x77, 0x6f, 0x3d, 0xd5, 0x93, 0x66, 0xe2, 0x79, 0x2a,
+	0x49, 0x09, 0x7a, 0x92, 0xce, 0xe4, 0x8d, 0xc2, 0x49, 0x3a, 0x53, 0x30, 0x8c, 0x93, 0x74, 0xc6,
+	0x30, 0xd6, 0x4f, 0xd2, 0x99, 0x0d, 0xa3, 0x88, 0xd6, 0x7a, 0x34, 0xa0, 0x4e, 0xf7, 0xb1, 0x32,
+	0x42, 0x59, 0xf2, 0x1c, 0x33, 0xbd, 0xd1, 0xa0, 0xbc, 0x8b, 0x39, 0x0e, 0x7a, 0x4c, 0x17, 0x02,
+	0x19, 0xaa, 0x3c, 0x13, 0xc7, 0xd6, 0x1e, 0x58, 0x96, 0x2f, 0x19, 0x68, 0x80, 0xa5, 0x0b, 0xd2,
+	0x53, 0x87, 0x2d, 0x12, 0x43, 0x58, 0x04, 0xcb, 0x5d, 0x1c, 0x74, 0x88, 0x3a, 0x23, 0x91, 0x22,
+	0xec, 0x33, 0x50, 0x38, 0x8f, 0x71, 0xc4, 0xc4, 0x13, 0x92, 0x46, 0xa7, 0xb4, 0xc9, 0x20, 0x04,
+	0x69, 0x79, 0x4e, 0x28, 0x5b, 0x39, 0x86, 0x3f, 0x04, 0xe9, 0x80, 0x36, 0x99, 0xbc, 0x2d, 0x64,
+	0xf7, 0x37, 0xe7, 0xaf, 0x26, 0xa7, 0xb4, 0x89, 0xa4, 0x8a, 0xfd, 0xf7, 0x45, 0xb0, 0x74, 0x4a,
+	0x9b, 0xd0, 0x04, 0x77, 0x86, 0xb7, 0x73, 0x85, 0x34, 0x24, 0xe1, 0x16, 0x58, 0xe1, 0xb4, 0xed,
+	0xbb, 0x0a, 0x6e, 0x15, 0x69, 0x4a, 0x38, 0xf6, 0x30, 0xc7, 0xf2, 0x60, 0xcd, 0x21, 0x39, 0x16,
+	0x2f, 0x72, 0x75, 0x17, 0x8c, 0x3a, 0x61, 0x83, 0xc4, 0xf2, 0x7c, 0x4c, 0x57, 0x0a, 0x57, 0x89,
+	0x95, 0x95, 0xfc, 0xa7, 0x92, 0x8d, 0x26, 0x09, 0xf8, 0x06, 0xb8, 0xc3, 0x2f, 0x27, 0xcf, 0xba,
+	0x8d, 0xab, 0xc4, 0x2a, 0xf0, 0x71, 0x9a, 0xe2, 0x28, 0x43, 0x2b, 0xfc, 0x52, 0xfc, 0xc3, 0x3d,
+	0x90, 0xe1, 0x97, 0x8e, 0x1f, 0x79, 0xe4, 0x52, 0x1e, 0x67, 0xe9, 0x4a, 0xf1, 0x2a, 0xb1, 0x8c,
+	0x09, 0xf5, 0x63, 0x21, 0x43, 0x77, 0xf8, 0xa5, 0x1c, 0xc0, 0x37, 0x00, 0x50, 0x21, 0x49, 0x0f,
+	0xea, 0x74, 0x5a, 0xbb, 0x4a, 0xac, 0x55, 0xc9, 0x95, 0xd8, 0xe3, 0x21, 0xb4, 0xc1, 0xb2, 0xc2,
+	0xce, 0x48, 0xec, 0xdc, 0x55, 0x62, 0x65, 0x02, 0xda, 0x54, 0x98, 0x4a, 0x24, 0x4a, 0x15, 0x93,
+	0x90, 0x76, 0x89, 0x27, 0x8f, 0x88, 0x0c, 0x1a, 0x92, 0xf6, 0x9f, 0x16, 0x41, 0xe6, 0xfc, 0x12,
+	0x11, 0xd6, 0x09, 0x38, 0x7c, 0x0f, 0x18, 0x73, 0x6f, 0x4f, 0x75, 0x9b, 0xba, 0x3f, 0xde, 0xd0,
+	0xe7, 0x1f, 0x9a, 0x05, 0x77, 0xe6, 0x5d, 0x59, 0x04, 0xcb, 0x8d, 0x80, 0xd2, 0x50, 0x76, 0x42,
+	0x0e, 0x29, 0x02, 0x22, 0x59, 0x35, 0x39, 0xcb, 0x4b, 0xf2, 0x72, 0xfb, 0xea, 0xfc, 0x2c, 0xcf,
+	0xb4, 0x4a, 0x65, 0x4b, 0x7f, 0x8d, 0xc9, 0x2b, 0xdf, 0xda, 0xde, 0x16, 0xb5, 0x95, 0xad, 0x64,
+	0x80, 0xa5, 0x98, 0x70, 0x39, 0x69, 0x39, 0x24, 0x86, 0xb0, 0x04, 0x32, 0x31, 0xe9, 0x92, 0x98,
+	0x13, 0x4f, 0x4e, 0x4e, 0x06, 0x8d, 0x68, 0x78, 0x0f, 0x64, 0xc4, 0x83, 0xa2, 0xc3, 0x88, 0xa7,
+	0x66, 0x02, 0xdd, 0x69, 0x62, 0xf6, 0x31, 0x23, 0xde, 0xdb, 0xe9, 0x2f, 0xbf, 0xb1, 0x16, 0x6c,
+	0x0c, 0xb2, 0xfa, 0xca, 0xdb, 0x69, 0x07, 0xe4, 0x86, 0x0e, 0xdb, 0x07, 0x39, 0xc6, 0x69, 0x8c,
+	0x9b, 0xc4, 0xb9, 0x20, 0x3d, 0xdd, 0x67, 0xaa, 0x6b, 0x34, 0xff, 0x57, 0xa4, 0xc7, 0xd0, 0x24,
+	0xa1, 0x5d, 0x7c, 0x93, 0x06, 0xd9, 0xf3, 0x18, 0xbb, 0x44, 0x5f, 0x60, 0x45, 0xaf, 0x0a, 0x32,
+	0xd6, 0x2e, 0x34, 0x25, 0x7c, 0x73, 0x3f, 0x24, 0xb4, 0xc3, 0xf5, 0x7a, 0x1a, 0x92, 0xc2, 0x22,
+	0x26, 0xe4, 0x92, 0xb8, 0xb2, 0x8c, 0x69, 0xa4, 0x29, 0x78, 0x00, 0xd6, 0x3c, 0x9f, 0xc9, 0xc7,
+	0x2e, 0xe3, 0xd8, 0xbd, 0x50, 0xe9, 0x57, 0x8c, 0xab, 0xc4, 0xca, 0x69, 0x41, 0x5d, 0xf0, 0xd1,
+	0x14, 0x05, 0xdf, 0x01, 0x85, 0xb1, 0x99, 0x8c, 0x56, 0x7d, 0xc0, 0xaa, 0xc0, 0xab, 0xc4, 0xca,
+	0x8f, 0x54, 0xa5, 0x04, 0xcd, 0xd0, 0x62, 0xa6, 0x3d, 0xd2, 0xe8, 0x34, 0x65, 0xf3, 0x65, 0x90,
+	0x22, 0x04, 0x37, 0xf0, 0x43, 0x9f, 0xcb, 0x66, 0x5b, 0x46, 0x8a, 0x80, 0xef, 0x80, 0x55, 0xda,
+	0x25, 0x71, 0xec, 0x7b, 0xf2, 0xc3, 0xd2, 0xf7, 0x7f, 0x8b, 0x43, 0x63, 0x7d, 0x91, 0x9c, 0x7e,
+	0xc8, 0x87, 0x24, 0xa4, 0x71, 0xcf, 0xcc, 0x8e, 0x93, 0x53, 0x82, 0x0f, 0x25, 0x1f, 0x4d, 0x51,
+	0xb0, 0x02, 0xa0, 0x36, 0x8b, 0x09, 0xef, 0xc4, 0x91, 0x23, 0xd7, 0x7f, 0x4e, 0xda, 0xca, 0x55,
+	0xa8, 0xa4, 0x48, 0x0a, 0x8f, 0x30, 0xc7, 0x68, 0x8e, 0x03, 0x7f, 0x0e, 0xa0, 0x9a, 0x13, 0xe7,
+	0x0b, 0x46, 0x47, 0x1f, 0x13, 0xd5, 0x19, 0x2f, 0xfd, 0x2b, 0xa9, 0x8e, 0xd9, 0x50, 0xd4, 0x09,
+	0xa3, 0x3a, 0x8b, 0x93, 0x74, 0x26, 0x6d, 0x2c, 0xab, 0x27, 0xe3, 0xa8, 0x7e, 0x3a, 0x0b, 0xb4,
+	0x31, 0xa4, 0x27, 0xc2, 0x7b, 0xfd, 0x6f, 0x29, 0x30, 0xf1, 0xf2, 0x82, 0x3f, 0x03, 0xa5, 0xc3,
+	0x6a, 0xb5, 0x56, 0xaf, 0x3b, 0xe7, 0x9f, 0x9e, 0xd5, 0x9c, 0xb3, 0x1a, 0xfa, 0xf0, 0xb8, 0x5e,
+	0x3f, 0xfe, 0xe8, 0xe9, 0x69, 0xad, 0x5e, 0x37, 0x16, 0x4a, 0x0f, 0xbe, 0xfa, 0xba, 0x6c, 0x8e,
+	0xf5, 0xcf, 0x44, 0x3d, 0x19, 0xf3, 0x69, 0x14, 0x88, 0x4e, 0x7d, 0x0b, 0x6c, 0x4d, 0x5a, 0xa3,
+	0x5a, 0xfd, 0x1c, 0x1d, 0x57, 0xcf, 0x6b, 0x47, 0x46, 0xaa, 0x64, 0x7e, 0xf5, 0x75, 0xb9, 0x38,
+	0xb6, 0x44, 0x84, 0xf1, 0xd8, 0x17, 0x9f, 0x2a, 0xe1, 0x13, 0x60, 0x5e, 0xef, 0xb3, 0x76, 0x64,
+	0x2c, 0x96, 0x4a, 0x5f, 0x7d, 0x5d, 0xde, 0xba, 0xce, 0x23, 0xf1, 0x4a, 0xe9, 0x2f, 0xff, 0xba,
+	0xbd, 0x50, 0xf9, 0xe5, 0xb7, 0xfd, 0xed, 0xd4, 0x77, 0xfd, 0xed, 0xd4, 0xbf, 0xfb, 0xdb, 0xa9,
+	0x3f, 0xbf, 0xd8, 0x5e, 0xf8, 0xee, 0xc5, 0xf6, 0xc2, 0x3f, 0x5e, 0x6c, 0x2f, 0xfc, 0xf6, 0xff,
+	0x9b, 0x3e, 0x6f, 0x75, 0x1a, 0xbb, 0x2e, 0x0d, 0xc5, 0x37, 0x65, 0xca, 0xf4, 0x6f, 0xf7, 0xd1,
+	0x4f, 0xf7, 0x2e, 0xc5, 0x78, 0x4f, 0xbc, 0x2c, 0x59, 0x63, 0x45, 0x7e, 0x44, 0x7e, 0xfc, 0xdf,
+	0x01, 0x00, 0x14, 0xa3, 0x97, 0x11, 0x8a, 0x16, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1081,6 +1143,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockProposerRetention != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockProposerRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.SystemCallGasDiscountBPS != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.SystemCallGasDiscountBPS))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.ContractCreationByteGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ContractCreationByteGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.RefundQuotient != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.RefundQuotient))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.CallDenylist) > 0 {
+		for iNdEx := len(m.CallDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CallDenylist[iNdEx])
+			copy(dAtA[i:], m.CallDenylist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.CallDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
 	if len(m.MultiSigAddress) > 0 {
 		i -= len(m.MultiSigAddress)
 		copy(dAtA[i:], m.MultiSigAddress)
@@ -2022,6 +2123,24 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovEvm(uint64(l))
 	}
+	if len(m.CallDenylist) > 0 {
+		for _, s := range m.CallDenylist {
+			l = len(s)
+			n += 2 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.RefundQuotient != 0 {
+		n += 2 + sovEvm(uint64(m.RefundQuotient))
+	}
+	if m.ContractCreationByteGas != 0 {
+		n += 2 + sovEvm(uint64(m.ContractCreationByteGas))
+	}
+	if m.SystemCallGasDiscountBPS != 0 {
+		n += 2 + sovEvm(uint64(m.SystemCallGasDiscountBPS))
+	}
+	if m.BlockProposerRetention != 0 {
+		n += 2 + sovEvm(uint64(m.BlockProposerRetention))
+	}
 	return n
 }
 
@@ -2794,6 +2913,114 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MultiSigAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallDenylist = append(m.CallDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundQuotient", wireType)
+			}
+			m.RefundQuotient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundQuotient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCreationByteGas", wireType)
+			}
+			m.ContractCreationByteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractCreationByteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemCallGasDiscountBPS", wireType)
+			}
+			m.SystemCallGasDiscountBPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SystemCallGasDiscountBPS |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProposerRetention", wireType)
+			}
+			m.BlockProposerRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockProposerRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
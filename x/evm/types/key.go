@@ -3,6 +3,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -21,6 +22,10 @@ const (
 
 	// RouterKey uses module name for routing
 	RouterKey = ModuleName
+
+	// MaxBlockProposerQueryRange is the maximum number of blocks that can be
+	// aggregated in a single validator block counts query.
+	MaxBlockProposerQueryRange = 100_000
 )
 
 // prefix bytes for the EVM persistent store
@@ -28,6 +33,8 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixBlockProposer
+	prefixAcceptedFeeGranter
 )
 
 // prefix bytes for the EVM transient store
@@ -36,21 +43,25 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeePayer
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode    = []byte{prefixCode}
-	KeyPrefixStorage = []byte{prefixStorage}
-	KeyPrefixParams  = []byte{prefixParams}
+	KeyPrefixCode               = []byte{prefixCode}
+	KeyPrefixStorage            = []byte{prefixStorage}
+	KeyPrefixParams             = []byte{prefixParams}
+	KeyPrefixBlockProposer      = []byte{prefixBlockProposer}
+	KeyPrefixAcceptedFeeGranter = []byte{prefixAcceptedFeeGranter}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom    = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex  = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize  = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed  = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeePayer = []byte{prefixTransientFeePayer}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -62,3 +73,21 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// BlockProposerKey defines the key under which the proposer of the block at
+// the given height is stored.
+func BlockProposerKey(height int64) []byte {
+	return append(KeyPrefixBlockProposer, sdk.Uint64ToBigEndian(uint64(height))...) // #nosec G701 -- block heights are always positive
+}
+
+// AcceptedFeeGranterKey defines the key under which the fee granter accepted
+// by the given grantee is stored.
+func AcceptedFeeGranterKey(grantee common.Address) []byte {
+	return append(KeyPrefixAcceptedFeeGranter, grantee.Bytes()...)
+}
+
+// TransientFeePayerKey defines the key under which the account that paid the
+// fees of the transaction with the given hash is stored.
+func TransientFeePayerKey(txHash common.Hash) []byte {
+	return append(KeyPrefixTransientFeePayer, txHash.Bytes()...)
+}
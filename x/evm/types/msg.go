@@ -24,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var (
@@ -31,6 +32,8 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgAddPermissionedAddress{}
+	_ sdk.Msg    = &MsgRemovePermissionedAddress{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -39,6 +42,12 @@ var (
 const (
 	// TypeMsgEthereumTx defines the type string of an Ethereum transaction
 	TypeMsgEthereumTx = "ethereum_tx"
+	// TypeMsgUpdateParams defines the type string of a params update
+	TypeMsgUpdateParams = "update_params"
+	// TypeMsgAddPermissionedAddress defines the type string of an access control list addition
+	TypeMsgAddPermissionedAddress = "add_permissioned_address"
+	// TypeMsgRemovePermissionedAddress defines the type string of an access control list removal
+	TypeMsgRemovePermissionedAddress = "remove_permissioned_address"
 )
 
 // NewTx returns a reference to a new Ethereum transaction message.
@@ -77,6 +86,22 @@ func newMsgEthereumTx(
 	}
 
 	switch {
+	case tx.AuthorizationList != nil:
+		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
+		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
+
+		txData = &SetCodeTx{
+			ChainID:           cid,
+			Amount:            amt,
+			To:                toAddr,
+			GasTipCap:         &gtc,
+			GasFeeCap:         &gfc,
+			Nonce:             tx.Nonce,
+			GasLimit:          tx.GasLimit,
+			Data:              tx.Input,
+			Accesses:          NewAccessList(tx.Accesses),
+			AuthorizationList: tx.AuthorizationList,
+		}
 	case tx.GasFeeCap != nil:
 		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
 		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
@@ -120,7 +145,7 @@ func newMsgEthereumTx(
 	}
 
 	msg := MsgEthereumTx{Data: dataAny}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.ComputeHash().Hex()
 	return &msg
 }
 
@@ -183,7 +208,7 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 	}
 
 	// Validate Hash field after validated txData to avoid panic
-	txHash := msg.AsTransaction().Hash().Hex()
+	txHash := msg.ComputeHash().Hex()
 	if msg.Hash != txHash {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid tx hash %s, expected: %s", msg.Hash, txHash)
 	}
@@ -237,6 +262,15 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 		return fmt.Errorf("sender address not defined for message")
 	}
 
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return msg.signSetCodeTx(setCodeTx, ethSigner, keyringSigner)
+	}
+
 	tx := msg.AsTransaction()
 	txHash := ethSigner.Hash(tx)
 
@@ -253,6 +287,41 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 	return msg.FromEthereumTx(tx)
 }
 
+// signSetCodeTx signs the set code transaction over its EIP-7702 signing hash.
+func (msg *MsgEthereumTx) signSetCodeTx(txData *SetCodeTx, ethSigner ethtypes.Signer, keyringSigner keyring.Signer) error {
+	chainID := txData.GetChainID()
+	if chainID == nil || ethSigner.ChainID() == nil || chainID.Cmp(ethSigner.ChainID()) != 0 {
+		return ethtypes.ErrInvalidChainId
+	}
+
+	sigHash := txData.SigHash()
+	sig, _, err := keyringSigner.SignByAddress(msg.GetFrom(), sigHash.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if len(sig) != crypto.SignatureLength {
+		return fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+	}
+
+	signed := txData.Copy()
+	signed.SetSignatureValues(
+		nil,
+		new(big.Int).SetBytes(sig[64:]),
+		new(big.Int).SetBytes(sig[:32]),
+		new(big.Int).SetBytes(sig[32:64]),
+	)
+
+	anyTxData, err := PackTxData(signed)
+	if err != nil {
+		return err
+	}
+
+	msg.Data = anyTxData
+	msg.Hash = msg.ComputeHash().Hex()
+	return nil
+}
+
 // GetGas implements the GasTx interface. It returns the GasLimit of the transaction.
 func (msg MsgEthereumTx) GetGas() uint64 {
 	txData, err := UnpackTxData(msg.Data)
@@ -300,15 +369,49 @@ func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	return ethtypes.NewTx(txData.AsEthereumData())
 }
 
+// ComputeHash returns the Ethereum hash of the transaction. Unlike the hash of
+// AsTransaction, it is also valid for set code transactions.
+func (msg MsgEthereumTx) ComputeHash() common.Hash {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return common.Hash{}
+	}
+
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return setCodeTx.Hash()
+	}
+
+	return ethtypes.NewTx(txData.AsEthereumData()).Hash()
+}
+
 // AsMessage creates an Ethereum core.Message from the msg fields
 func (msg MsgEthereumTx) AsMessage(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return setCodeTx.AsMessage(signer, baseFee)
+	}
+
 	return msg.AsTransaction().AsMessage(signer, baseFee)
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (msg *MsgEthereumTx) GetSender(chainID *big.Int) (common.Address, error) {
-	signer := ethtypes.LatestSignerForChainID(chainID)
-	from, err := signer.Sender(msg.AsTransaction())
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var from common.Address
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		from, err = setCodeTx.Sender()
+	} else {
+		signer := ethtypes.LatestSignerForChainID(chainID)
+		from, err = signer.Sender(msg.AsTransaction())
+	}
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -324,6 +427,22 @@ func (msg MsgEthereumTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 
 // UnmarshalBinary decodes the canonical encoding of transactions.
 func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] == SetCodeTxType {
+		txData, err := newSetCodeTxFromBinary(b)
+		if err != nil {
+			return err
+		}
+
+		anyTxData, err := PackTxData(txData)
+		if err != nil {
+			return err
+		}
+
+		msg.Data = anyTxData
+		msg.Hash = txData.Hash().Hex()
+		return nil
+	}
+
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(b); err != nil {
 		return err
@@ -368,6 +487,12 @@ func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.
 	return tx, nil
 }
 
+// Route returns the name of the module
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
@@ -388,3 +513,67 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// Route returns the name of the module
+func (m MsgAddPermissionedAddress) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAddPermissionedAddress
+func (m MsgAddPermissionedAddress) Type() string { return TypeMsgAddPermissionedAddress }
+
+// GetSigners returns the expected signers for a MsgAddPermissionedAddress message.
+func (m MsgAddPermissionedAddress) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgAddPermissionedAddress) ValidateBasic() error {
+	return validatePermissionedAddressMsg(m.Authority, m.Operation, m.Address)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddPermissionedAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns the name of the module
+func (m MsgRemovePermissionedAddress) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgRemovePermissionedAddress
+func (m MsgRemovePermissionedAddress) Type() string { return TypeMsgRemovePermissionedAddress }
+
+// GetSigners returns the expected signers for a MsgRemovePermissionedAddress message.
+func (m MsgRemovePermissionedAddress) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemovePermissionedAddress) ValidateBasic() error {
+	return validatePermissionedAddressMsg(m.Authority, m.Operation, m.Address)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemovePermissionedAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// validatePermissionedAddressMsg validates the fields of the access control list
+// update messages.
+func validatePermissionedAddressMsg(authority string, operation PermissionOperation, address string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if operation != PermissionOperationCreate && operation != PermissionOperationCall {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid permission operation: %s", operation)
+	}
+
+	if !common.IsHexAddress(address) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid address: %s", address)
+	}
+
+	return nil
+}
@@ -473,6 +473,50 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgAddPermissionedAddress_ValidateBasic() {
+	authority := sdk.AccAddress(suite.from.Bytes()).String()
+
+	testCases := []struct {
+		msg      string
+		msgAdd   types.MsgAddPermissionedAddress
+		expError bool
+	}{
+		{
+			"pass",
+			types.MsgAddPermissionedAddress{Authority: authority, Operation: types.PermissionOperationCreate, Address: suite.to.Hex()},
+			false,
+		},
+		{
+			"invalid authority",
+			types.MsgAddPermissionedAddress{Authority: "foobar", Operation: types.PermissionOperationCreate, Address: suite.to.Hex()},
+			true,
+		},
+		{
+			"unspecified operation",
+			types.MsgAddPermissionedAddress{Authority: authority, Operation: types.PermissionOperationUnspecified, Address: suite.to.Hex()},
+			true,
+		},
+		{
+			"invalid address",
+			types.MsgAddPermissionedAddress{Authority: authority, Operation: types.PermissionOperationCall, Address: invalidAddress},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msgAdd.ValidateBasic()
+		msgRemove := types.MsgRemovePermissionedAddress(tc.msgAdd)
+		removeErr := msgRemove.ValidateBasic()
+		if tc.expError {
+			suite.Require().Error(err, tc.msg)
+			suite.Require().Error(removeErr, tc.msg)
+		} else {
+			suite.Require().NoError(err, tc.msg)
+			suite.Require().NoError(removeErr, tc.msg)
+		}
+	}
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasicAdvanced() {
 	hundredInt := big.NewInt(100)
 	evmTx := &types.EvmTxArgs{
@@ -0,0 +1,102 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// OnlineServerThreshold is the minimum number of online servers reported by the
+// OnlineServerCount contract for the chain to be open for transactions.
+const OnlineServerThreshold = 1000
+
+// WalletLockStatus defines the lock status of a wallet as reported by the
+// WalletState contract.
+type WalletLockStatus uint8
+
+const (
+	// WalletLockNone indicates that the wallet is not locked (No_Lock).
+	WalletLockNone WalletLockStatus = iota
+	// WalletLockAmount indicates that part of the wallet balance is locked (Amount_Lock).
+	WalletLockAmount
+	// WalletLockAbsolute indicates that the whole wallet is locked (Absolute_Lock).
+	WalletLockAbsolute
+)
+
+// AmountLockTolerance is the amount (0.0001 NXQ) by which a transfer can exceed
+// the unlocked balance of an amount locked wallet and still be accepted.
+var AmountLockTolerance = big.NewInt(1e14)
+
+// IsChainOpenForServerCount returns true if the given online server count
+// reaches the threshold required for the chain to be open.
+func IsChainOpenForServerCount(count *big.Int) bool {
+	return count.Cmp(big.NewInt(OnlineServerThreshold)) >= 0
+}
+
+// UnpackWalletLock unpacks the (LockStatus, lockValue, lockCode) values returned
+// by the getWalletLock(address) function of the WalletState contract into the
+// lock status and the locked amount.
+func UnpackWalletLock(ret []byte) (WalletLockStatus, *big.Int, error) {
+	if len(ret) < 96 {
+		return 0, nil, fmt.Errorf("invalid response length")
+	}
+
+	status := WalletLockStatus(new(big.Int).SetBytes(ret[:32]).Uint64() % 256) // #nosec G701 -- value is reduced modulo 256
+	return status, new(big.Int).SetBytes(ret[32:64]), nil
+}
+
+// WalletLock defines the lock state of a wallet together with its balance.
+type WalletLock struct {
+	// Status is the lock status of the wallet
+	Status WalletLockStatus
+	// LockedAmount is the amount locked when the status is WalletLockAmount
+	LockedAmount *big.Int
+	// Balance is the EVM denomination balance of the wallet
+	Balance *big.Int
+}
+
+// MaxTransferable returns the maximum amount that can be transferred from the
+// wallet without taking the tolerance into account.
+func (wl WalletLock) MaxTransferable() *big.Int {
+	switch wl.Status {
+	case WalletLockNone:
+		return new(big.Int).Set(wl.Balance)
+	case WalletLockAmount:
+		if wl.Balance.Cmp(wl.LockedAmount) < 0 {
+			return big.NewInt(0)
+		}
+		return new(big.Int).Sub(wl.Balance, wl.LockedAmount)
+	default:
+		return big.NewInt(0)
+	}
+}
+
+// ValidateTransfer returns an error if the given amount cannot be transferred
+// from the wallet according to its lock status.
+func (wl WalletLock) ValidateTransfer(amount *big.Int) error {
+	switch wl.Status {
+	case WalletLockNone:
+		return nil
+	case WalletLockAmount:
+		if wl.Balance.Cmp(wl.LockedAmount) < 0 {
+			return fmt.Errorf("locked amount exceeds wallet balance")
+		}
+
+		maxAllowed := wl.MaxTransferable()
+		if amount.Cmp(maxAllowed) <= 0 {
+			return nil
+		}
+
+		// allow transfers that exceed the unlocked balance within the tolerance
+		diff := new(big.Int).Sub(amount, maxAllowed)
+		if diff.Cmp(AmountLockTolerance) > 0 {
+			return fmt.Errorf("exceeds limit")
+		}
+		return nil
+	case WalletLockAbsolute:
+		return fmt.Errorf("wallet is fully locked")
+	default:
+		return fmt.Errorf("unknown lock status")
+	}
+}
@@ -34,6 +34,11 @@ var (
 		"0x0000000000000000000000000000000000000802", // ICS20 transfer precompile
 		"0x0000000000000000000000000000000000000803", // Vesting precompile
 		"0x0000000000000000000000000000000000000804", // Bank precompile
+		"0x0000000000000000000000000000000000000805", // Gov precompile
+		"0x0000000000000000000000000000000000000806", // Slashing precompile
+		"0x0000000000000000000000000000000000000807", // Feegrant precompile
+		"0x0000000000000000000000000000000000000900", // Network state precompile
+		"0x0000000000000000000000000000000000000901", // Inflation precompile
 	}
 	// DefaultExtraEIPs defines the default extra EIPs to be included
 	// On v15, EIP 3855 was enabled
@@ -75,6 +80,26 @@ var (
 	DefaultEnableWalletLockCheck = false
 	// DefaultMultiSigAddress is empty during bootstrap (must be set via governance)
 	DefaultMultiSigAddress = ""
+	// DefaultCallDenylist is empty, no address is denied
+	DefaultCallDenylist = []string{}
+	// DefaultRefundQuotient is zero, the refund quotient of the fork rules applies
+	DefaultRefundQuotient uint64
+	// DefaultContractCreationByteGas is zero, no surcharge on contract creation
+	DefaultContractCreationByteGas uint64
+	// DefaultSystemCallGasDiscountBPS is zero, no discount on system contract calls
+	DefaultSystemCallGasDiscountBPS uint32
+	// DefaultBlockProposerRetention keeps the proposers of the blocks covered by the
+	// largest ValidatorBlockCounts query range
+	DefaultBlockProposerRetention uint64 = MaxBlockProposerQueryRange
+)
+
+const (
+	// MaxGasDiscountBPS is the gas discount in basis points of a free call (i.e 100%).
+	// The discounts must be strictly lower, so that every transaction pays for some gas.
+	MaxGasDiscountBPS = 10_000
+	// MinRefundQuotient is the lowest non-zero refund quotient, which refunds up to
+	// half of the gas used.
+	MinRefundQuotient = 2
 )
 
 // NewParams creates a new Params instance
@@ -117,6 +142,11 @@ func DefaultParams() Params {
 		EnableChainStatusCheck:           DefaultEnableChainStatusCheck,
 		EnableWalletLockCheck:            DefaultEnableWalletLockCheck,
 		MultiSigAddress:                  DefaultMultiSigAddress,
+		CallDenylist:                     DefaultCallDenylist,
+		RefundQuotient:                   DefaultRefundQuotient,
+		ContractCreationByteGas:          DefaultContractCreationByteGas,
+		SystemCallGasDiscountBPS:         DefaultSystemCallGasDiscountBPS,
+		BlockProposerRetention:           DefaultBlockProposerRetention,
 	}
 }
 
@@ -189,6 +219,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCallDenylist(p.CallDenylist); err != nil {
+		return err
+	}
+
+	if err := validateRefundQuotient(p.RefundQuotient); err != nil {
+		return fmt.Errorf("invalid refund quotient: %w", err)
+	}
+
+	if err := validateGasDiscountBPS(p.SystemCallGasDiscountBPS); err != nil {
+		return fmt.Errorf("invalid system call gas discount: %w", err)
+	}
+
 	// Validate multi-sig address (allows empty during bootstrap)
 	if err := validateMultiSigAddress(p.MultiSigAddress); err != nil {
 		return fmt.Errorf("invalid multi-sig address: %w", err)
@@ -213,6 +255,12 @@ func (p Params) EIPs() []string {
 	return eips
 }
 
+// IsSetCodeEnabled returns true if the EIP-7702 set code transactions are
+// enabled through the ExtraEIPs.
+func (p Params) IsSetCodeEnabled() bool {
+	return slices.Contains(p.ExtraEIPs, SetCodeEIP)
+}
+
 // GetActiveStaticPrecompilesAddrs is a util function that the Active Precompiles
 // as a slice of addresses.
 func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
@@ -304,6 +352,7 @@ func validateEIPs(i interface{}) error {
 	}
 
 	uniqueEIPs := make(map[string]struct{})
+	policyEnabled := false
 
 	for _, eip := range eips {
 		if !vm.ExistsEipActivator(eip) {
@@ -319,6 +368,11 @@ func validateEIPs(i interface{}) error {
 		}
 		uniqueEIPs[eip] = struct{}{}
 
+		if slices.Contains(PolicyEIPs, eip) {
+			policyEnabled = true
+		} else if policyEnabled {
+			return fmt.Errorf("EIP %s must be enabled before the policy EIPs %s", eip, PolicyEIPs)
+		}
 	}
 
 	return nil
@@ -374,6 +428,31 @@ func (p Params) IsBootstrapMode() bool {
 	return !p.EnableChainStatusCheck && !p.EnableWalletLockCheck
 }
 
+// IsWhitelistedAddress returns true if the address is exempt from the chain
+// status and wallet lock checks
+func (p Params) IsWhitelistedAddress(address common.Address) bool {
+	return containsAddress(p.WhitelistedAddresses, address)
+}
+
+// IsCallDenylisted returns true if the address cannot be called under the call
+// denylist policy
+func (p Params) IsCallDenylisted(address common.Address) bool {
+	return slices.ContainsFunc(p.CallDenylist, func(addr string) bool {
+		return common.HexToAddress(addr) == address
+	})
+}
+
+// IsSystemContract returns true if the address is the online server count or
+// the wallet state contract
+func (p Params) IsSystemContract(address common.Address) bool {
+	for _, contract := range []string{p.OnlineServerCountContract, p.WalletStateContractAddress} {
+		if IsContractSet(contract) && common.HexToAddress(contract) == address {
+			return true
+		}
+	}
+	return false
+}
+
 // IsContractSet checks if a contract address is properly set (not zero)
 func IsContractSet(addr string) bool {
 	return addr != "" && addr != ZeroAddress
@@ -414,6 +493,56 @@ func validateWhitelistedAddresses(i interface{}) error {
 	return nil
 }
 
+// validateCallDenylist validates the list of denylisted call targets
+func validateCallDenylist(i interface{}) error {
+	addresses, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Address]struct{}, len(addresses))
+	for idx, addr := range addresses {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid call denylist address at index %d: %s", idx, addr)
+		}
+		if _, ok := seen[common.HexToAddress(addr)]; ok {
+			return fmt.Errorf("duplicate call denylist address: %s", addr)
+		}
+		seen[common.HexToAddress(addr)] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateGasDiscountBPS validates a gas discount expressed in basis points
+func validateGasDiscountBPS(i interface{}) error {
+	bps, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if bps >= MaxGasDiscountBPS {
+		return fmt.Errorf("discount must be lower than %d basis points: %d", MaxGasDiscountBPS, bps)
+	}
+
+	return nil
+}
+
+// validateRefundQuotient validates the gas refund quotient. A quotient of one
+// would refund the whole gas used, so it must either be zero or at least two.
+func validateRefundQuotient(i interface{}) error {
+	quotient, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if quotient != 0 && quotient < MinRefundQuotient {
+		return fmt.Errorf("refund quotient must be zero or at least %d: %d", MinRefundQuotient, quotient)
+	}
+
+	return nil
+}
+
 // validateMultiSigAddress validates a bech32 address, allowing empty during bootstrap
 func validateMultiSigAddress(i interface{}) error {
 	addr, ok := i.(string)
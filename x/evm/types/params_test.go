@@ -1,8 +1,10 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v19/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
@@ -336,6 +338,184 @@ func TestValidateWhitelistedAddresses(t *testing.T) {
 	}
 }
 
+func TestValidateCallDenylist(t *testing.T) {
+	testCases := []struct {
+		name        string
+		addresses   []string
+		expectedErr bool
+	}{
+		{
+			name:        "Empty list - valid",
+			addresses:   []string{},
+			expectedErr: false,
+		},
+		{
+			name: "Multiple valid addresses",
+			addresses: []string{
+				"0x1234567890123456789012345678901234567890",
+				"0x2234567890123456789012345678901234567890",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "One invalid address in list",
+			addresses: []string{
+				"0x1234567890123456789012345678901234567890",
+				"invalid-address",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "Duplicate address with different case",
+			addresses: []string{
+				"0xabcdef7890123456789012345678901234567890",
+				"0xABCDEF7890123456789012345678901234567890",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.CallDenylist = tc.addresses
+
+			err := params.Validate()
+
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSystemCallGasDiscount(t *testing.T) {
+	testCases := []struct {
+		name        string
+		discountBPS uint32
+		expectedErr bool
+	}{
+		{
+			name:        "No discount - valid",
+			discountBPS: 0,
+			expectedErr: false,
+		},
+		{
+			name:        "Highest discount - valid",
+			discountBPS: types.MaxGasDiscountBPS - 1,
+			expectedErr: false,
+		},
+		{
+			name:        "Full discount",
+			discountBPS: types.MaxGasDiscountBPS,
+			expectedErr: true,
+		},
+		{
+			name:        "Discount greater than 100%",
+			discountBPS: types.MaxGasDiscountBPS + 1,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.SystemCallGasDiscountBPS = tc.discountBPS
+
+			err := params.Validate()
+
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRefundQuotient(t *testing.T) {
+	testCases := []struct {
+		name        string
+		quotient    uint64
+		expectedErr bool
+	}{
+		{
+			name:        "Fork rules - valid",
+			quotient:    0,
+			expectedErr: false,
+		},
+		{
+			name:        "Full refund",
+			quotient:    1,
+			expectedErr: true,
+		},
+		{
+			name:        "Half refund - valid",
+			quotient:    types.MinRefundQuotient,
+			expectedErr: false,
+		},
+		{
+			name:        "EIP-3529 quotient - valid",
+			quotient:    5,
+			expectedErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.RefundQuotient = tc.quotient
+
+			err := params.Validate()
+
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsSystemContract(t *testing.T) {
+	walletState := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	onlineServerCount := common.HexToAddress("0x2234567890123456789012345678901234567890")
+
+	params := types.DefaultParams()
+	require.False(t, params.IsSystemContract(common.Address{}), "unset contracts are not system contracts")
+
+	params.WalletStateContractAddress = walletState.Hex()
+	params.OnlineServerCountContract = onlineServerCount.Hex()
+	require.True(t, params.IsSystemContract(walletState))
+	require.True(t, params.IsSystemContract(onlineServerCount))
+	require.False(t, params.IsSystemContract(common.HexToAddress("0x3234567890123456789012345678901234567890")))
+}
+
+func TestIsWhitelistedAddress(t *testing.T) {
+	whitelisted := common.HexToAddress("0xAbCdEf0123456789aBcDeF0123456789AbCdEf01")
+
+	testCases := []struct {
+		name      string
+		whitelist []string
+		expResult bool
+	}{
+		{"empty whitelist", nil, false},
+		{"checksummed entry", []string{whitelisted.Hex()}, true},
+		{"lowercase entry", []string{strings.ToLower(whitelisted.Hex())}, true},
+		{"other address", []string{"0x1234567890123456789012345678901234567890"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.WhitelistedAddresses = tc.whitelist
+			require.Equal(t, tc.expResult, params.IsWhitelistedAddress(whitelisted))
+		})
+	}
+}
+
 func TestBootstrapToProductionTransition(t *testing.T) {
 	// Start with bootstrap params
 	params := types.DefaultParams()
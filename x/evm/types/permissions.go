@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"slices"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v19/x/evm/core/vm"
 )
@@ -59,7 +61,7 @@ func (p RestrictedPermissionPolicy) GetCallHook(signer common.Address) CallHook
 		if p.CanCall(signer, caller, recipient) {
 			return nil
 		}
-		return fmt.Errorf("caller address %s does not have permission to perform a call", caller)
+		return errorsmod.Wrapf(ErrCallNotPermitted, "caller address %s does not have permission to perform a call", caller)
 	}
 }
 
@@ -69,7 +71,7 @@ func (p RestrictedPermissionPolicy) GetCreateHook(signer common.Address) CreateH
 		if p.CanCreate(signer, caller) {
 			return nil
 		}
-		return fmt.Errorf("caller address %s does not have permission to deploy contracts", caller)
+		return errorsmod.Wrapf(ErrCreateNotPermitted, "caller address %s does not have permission to deploy contracts", caller)
 	}
 }
 
@@ -124,21 +126,59 @@ func getCanCallFn(accessControl *AccessControl, signer common.Address) callerFn
 // permissionlessCheckFn returns a callerFn that returns true unless the signer or the caller is
 // within the addresses slice.
 func permissionlessCheckFn(addresses []string, signer common.Address) callerFn {
-	strSigner := signer.String()
-	isSignerBlocked := !slices.Contains(addresses, strSigner)
+	isSignerBlocked := !containsAddress(addresses, signer)
 	return func(caller common.Address) bool {
-		strCaller := caller.String()
-		return isSignerBlocked && !slices.Contains(addresses, strCaller)
+		return isSignerBlocked && !containsAddress(addresses, caller)
 	}
 }
 
 // permissionedCheckFn returns a callerFn that returns true if the signer or caller
 // is within the addresses slice.
 func permissionedCheckFn(addresses []string, signer common.Address) callerFn {
-	strSigner := signer.String()
-	isSignerAllowed := slices.Contains(addresses, strSigner)
+	isSignerAllowed := containsAddress(addresses, signer)
 	return func(caller common.Address) bool {
-		strCaller := caller.String()
-		return isSignerAllowed || slices.Contains(addresses, strCaller)
+		return isSignerAllowed || containsAddress(addresses, caller)
+	}
+}
+
+// containsAddress returns true if the address is within the addresses slice. The
+// addresses are compared parsed, so that the check doesn't depend on their casing.
+func containsAddress(addresses []string, address common.Address) bool {
+	return slices.ContainsFunc(addresses, func(addr string) bool {
+		return common.HexToAddress(addr) == address
+	})
+}
+
+// GetAccessControlType returns the access control policy of the operation.
+func (ac *AccessControl) GetAccessControlType(operation PermissionOperation) (*AccessControlType, error) {
+	switch operation {
+	case PermissionOperationCreate:
+		return &ac.Create, nil
+	case PermissionOperationCall:
+		return &ac.Call, nil
+	default:
+		return nil, fmt.Errorf("invalid permission operation: %s", operation)
+	}
+}
+
+// AddAddress adds the address in checksum format to the access control list.
+// It returns false if the address is already in the list.
+func (act *AccessControlType) AddAddress(address common.Address) bool {
+	if containsAddress(act.AccessControlList, address) {
+		return false
+	}
+	act.AccessControlList = append(slices.Clone(act.AccessControlList), address.Hex())
+	return true
+}
+
+// RemoveAddress removes the address from the access control list. It returns false
+// if the address is not in the list.
+func (act *AccessControlType) RemoveAddress(address common.Address) bool {
+	if !containsAddress(act.AccessControlList, address) {
+		return false
 	}
+	act.AccessControlList = slices.DeleteFunc(slices.Clone(act.AccessControlList), func(addr string) bool {
+		return common.HexToAddress(addr) == address
+	})
+	return true
 }
@@ -4,6 +4,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -213,6 +214,38 @@ func (suite *UnitTestSuite) TestAccessControl() {
 			caller:    keyring.GetAddr(0),
 			recipient: keyring.GetAddr(0),
 		},
+		{
+			name: "should allow call and create with permissioned policy and lowercase address in AccessControlList",
+			getAccessControl: func() types.AccessControl {
+				p := types.DefaultParams().AccessControl
+				p.Create.AccessType = types.AccessTypePermissioned
+				p.Create.AccessControlList = []string{strings.ToLower(keyring.GetAddr(1).String())}
+				p.Call.AccessType = types.AccessTypePermissioned
+				p.Call.AccessControlList = []string{strings.ToLower(keyring.GetAddr(1).String())}
+				return p
+			},
+			canCall:   true,
+			canCreate: true,
+			signer:    keyring.GetAddr(0),
+			caller:    keyring.GetAddr(1),
+			recipient: keyring.GetAddr(0),
+		},
+		{
+			name: "should not allow call and create with permissionless policy and lowercase signer in AccessControlList",
+			getAccessControl: func() types.AccessControl {
+				p := types.DefaultParams().AccessControl
+				p.Create.AccessType = types.AccessTypePermissionless
+				p.Create.AccessControlList = []string{strings.ToLower(keyring.GetAddr(0).String())}
+				p.Call.AccessType = types.AccessTypePermissionless
+				p.Call.AccessControlList = []string{strings.ToLower(keyring.GetAddr(0).String())}
+				return p
+			},
+			canCall:   false,
+			canCreate: false,
+			signer:    keyring.GetAddr(0),
+			caller:    keyring.GetAddr(1),
+			recipient: keyring.GetAddr(1),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -231,3 +264,29 @@ func (suite *UnitTestSuite) TestAccessControl() {
 		})
 	}
 }
+
+func (suite *UnitTestSuite) TestAccessControlListUpdate() {
+	keyring := testkeyring.New(2)
+	addr := keyring.GetAddr(0)
+
+	accessControl := types.DefaultParams().AccessControl
+	create, err := accessControl.GetAccessControlType(types.PermissionOperationCreate)
+	suite.Require().NoError(err)
+	_, err = accessControl.GetAccessControlType(types.PermissionOperationUnspecified)
+	suite.Require().Error(err)
+
+	suite.Require().True(create.AddAddress(addr))
+	suite.Require().False(create.AddAddress(addr), "address should not be added twice")
+	suite.Require().Equal([]string{addr.String()}, accessControl.Create.AccessControlList)
+	suite.Require().Empty(accessControl.Call.AccessControlList, "call list should not be modified")
+
+	suite.Require().False(create.RemoveAddress(keyring.GetAddr(1)))
+	suite.Require().True(create.RemoveAddress(addr))
+	suite.Require().Empty(accessControl.Create.AccessControlList)
+
+	// addresses set with a different casing are still matched
+	create.AccessControlList = []string{strings.ToLower(addr.String())}
+	suite.Require().False(create.AddAddress(addr), "lowercase address should be found")
+	suite.Require().True(create.RemoveAddress(addr))
+	suite.Require().Empty(accessControl.Create.AccessControlList)
+}
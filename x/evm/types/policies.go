@@ -0,0 +1,51 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+)
+
+// Names of the custom EIPs enforcing the opcode policies. The policies are
+// enabled by adding their names to the ExtraEIPs parameter.
+const (
+	// CallDenylistEIP rejects the calls to the addresses of the CallDenylist parameter.
+	CallDenylistEIP = "nexqloud_0"
+	// DeployerWhitelistEIP restricts the contract creation to the WhitelistedAddresses
+	// while the chain status check is enabled.
+	DeployerWhitelistEIP = "nexqloud_1"
+	// SelfdestructBanEIP reverts the execution of the SELFDESTRUCT opcode.
+	SelfdestructBanEIP = "nexqloud_2"
+)
+
+// PolicyEIPs are the custom EIPs enforcing the opcode policies. The EIPs are
+// activated in the ExtraEIPs order, so the policy EIPs have to come after the
+// other EIPs, whose activators could otherwise replace the policed opcodes.
+var PolicyEIPs = []string{CallDenylistEIP, DeployerWhitelistEIP, SelfdestructBanEIP}
+
+// GetCallDenylistHook returns a CallHook that reverts the calls to the addresses
+// of the call denylist.
+func GetCallDenylistHook(params Params) CallHook {
+	return func(_ *vm.EVM, _, recipient common.Address) error {
+		if !params.IsCallDenylisted(recipient) {
+			return nil
+		}
+		return &vm.HookRevertError{Err: errorsmod.Wrapf(ErrCallDenylisted, "%s", recipient)}
+	}
+}
+
+// GetDeployerWhitelistHook returns a CreateHook that reverts the contract creation
+// unless the caller is whitelisted. The transaction sender is the caller of a
+// top-level creation, while a factory contract has to be whitelisted itself, so
+// that a whitelisted sender can't deploy through any third-party factory.
+func GetDeployerWhitelistHook(params Params) CreateHook {
+	return func(_ *vm.EVM, caller common.Address) error {
+		if params.IsWhitelistedAddress(caller) {
+			return nil
+		}
+		return &vm.HookRevertError{Err: errorsmod.Wrapf(ErrDeployerNotWhitelisted, "%s", caller)}
+	}
+}
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v19/x/evm/core/vm"
+	"github.com/evmos/evmos/v19/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallDenylistHook(t *testing.T) {
+	denied := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	allowed := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	params := types.DefaultParams()
+	params.CallDenylist = []string{denied.Hex()}
+	hook := types.GetCallDenylistHook(params)
+
+	require.NoError(t, hook(&vm.EVM{}, denied, allowed))
+
+	err := hook(&vm.EVM{}, allowed, denied)
+	require.ErrorIs(t, err, types.ErrCallDenylisted)
+
+	var revertErr *vm.HookRevertError
+	require.True(t, errors.As(err, &revertErr))
+}
+
+func TestDeployerWhitelistHook(t *testing.T) {
+	whitelisted := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	params := types.DefaultParams()
+	params.WhitelistedAddresses = []string{whitelisted.Hex()}
+	hook := types.GetDeployerWhitelistHook(params)
+
+	testCases := []struct {
+		name   string
+		origin common.Address
+		caller common.Address
+		expErr bool
+	}{
+		{"whitelisted sender", whitelisted, whitelisted, false},
+		{"factory called by a whitelisted sender", whitelisted, other, true},
+		{"whitelisted factory", other, whitelisted, false},
+		{"not whitelisted", other, other, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evm := &vm.EVM{TxContext: vm.TxContext{Origin: tc.origin}}
+			err := hook(evm, tc.caller)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrDeployerNotWhitelisted)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
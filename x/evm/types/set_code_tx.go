@@ -0,0 +1,646 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/evmos/evmos/v19/types"
+)
+
+const (
+	// SetCodeTxType is the EIP-2718 type of the EIP-7702 set code transactions.
+	SetCodeTxType = 0x04
+
+	// SetCodeEIP is the name of the extra EIP that enables the set code transactions.
+	SetCodeEIP = "ethereum_7702"
+
+	// SetCodeAuthorizationMagic is the prefix of the payload signed by an authority.
+	SetCodeAuthorizationMagic = 0x05
+
+	// PerEmptyAccountCost is the intrinsic gas charged for every authorization in the list.
+	PerEmptyAccountCost uint64 = 25000
+	// PerAuthBaseCost is the cost of an authorization whose authority already exists. The
+	// difference with PerEmptyAccountCost is refunded during execution.
+	PerAuthBaseCost uint64 = 12500
+)
+
+// SetCodeMessage is the core.Message of a set code transaction. It carries the
+// authorization list, which is applied before the message execution.
+type SetCodeMessage struct {
+	ethtypes.Message
+
+	AuthList []SetCodeAuthorization
+}
+
+// setCodeTxRLP is the consensus encoding of the set code transactions.
+type setCodeTxRLP struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	AuthList   []setCodeAuthorizationRLP
+	V, R, S    *big.Int
+}
+
+// setCodeAuthorizationRLP is the consensus encoding of the authorization tuples.
+type setCodeAuthorizationRLP struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V       uint8
+	R, S    *big.Int
+}
+
+// TxType returns the tx type
+func (tx *SetCodeTx) TxType() uint8 {
+	return SetCodeTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *SetCodeTx) Copy() TxData {
+	authList := make([]SetCodeAuthorization, len(tx.AuthorizationList))
+	for i, auth := range tx.AuthorizationList {
+		authList[i] = auth.copy()
+	}
+
+	return &SetCodeTx{
+		ChainID:           tx.ChainID,
+		Nonce:             tx.Nonce,
+		GasTipCap:         tx.GasTipCap,
+		GasFeeCap:         tx.GasFeeCap,
+		GasLimit:          tx.GasLimit,
+		To:                tx.To,
+		Amount:            tx.Amount,
+		Data:              common.CopyBytes(tx.Data),
+		Accesses:          tx.Accesses,
+		V:                 common.CopyBytes(tx.V),
+		R:                 common.CopyBytes(tx.R),
+		S:                 common.CopyBytes(tx.S),
+		AuthorizationList: authList,
+	}
+}
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *SetCodeTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *SetCodeTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *SetCodeTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetValue returns the tx amount.
+func (tx *SetCodeTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *SetCodeTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *SetCodeTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns a DynamicFeeTx transaction with the same fields as the
+// SetCodeTx, since the go-ethereum version in use has no set code transaction type.
+// The authorization list is dropped, so the hash and sender of the returned
+// transaction differ from the ones of the set code transaction. Use Hash and
+// Sender instead.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.DynamicFeeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GetGas(),
+		To:         tx.GetTo(),
+		Value:      tx.GetValue(),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return rawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *SetCodeTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields.
+func (tx SetCodeTx) Validate() error {
+	if tx.GasTipCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas tip cap cannot nil")
+	}
+
+	if tx.GasFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas fee cap cannot nil")
+	}
+
+	if tx.GasTipCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas tip cap cannot be negative %s", tx.GasTipCap)
+	}
+
+	if tx.GasFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas fee cap cannot be negative %s", tx.GasFeeCap)
+	}
+
+	if !types.IsValidInt256(tx.GetGasTipCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetGasFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if tx.GasFeeCap.LT(*tx.GasTipCap) {
+		return errorsmod.Wrapf(
+			ErrInvalidGasCap, "max priority fee per gas higher than max fee per gas (%s > %s)",
+			tx.GasTipCap, tx.GasFeeCap,
+		)
+	}
+
+	if !types.IsValidInt256(tx.Fee()) {
+		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
+	}
+
+	amount := tx.GetValue()
+	// Amount can be 0
+	if amount != nil && amount.Sign() == -1 {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be negative %s", amount)
+	}
+	if !types.IsValidInt256(amount) {
+		return errorsmod.Wrap(ErrInvalidAmount, "out of bound")
+	}
+
+	// set code transactions cannot deploy contracts
+	if tx.To == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "recipient must be present on SetCode txs")
+	}
+
+	if err := types.ValidateAddress(tx.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if tx.GetChainID() == nil {
+		return errorsmod.Wrap(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be present on SetCode txs",
+		)
+	}
+
+	if len(tx.AuthorizationList) == 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "authorization list cannot be empty")
+	}
+
+	for i, auth := range tx.AuthorizationList {
+		if err := auth.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "authorization %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Fee returns gasprice * gaslimit.
+func (tx SetCodeTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GasLimit)
+}
+
+// Cost returns amount + gasprice * gaslimit.
+func (tx SetCodeTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *SetCodeTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GasLimit)
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+// AuthorizationListGas returns the intrinsic gas charged for the authorization list.
+func AuthorizationListGas(authList []SetCodeAuthorization) uint64 {
+	return uint64(len(authList)) * PerEmptyAccountCost
+}
+
+// SigHash returns the hash signed by the sender of the transaction.
+func (tx *SetCodeTx) SigHash() common.Hash {
+	enc := tx.toRLP()
+	return prefixedRLPHash(SetCodeTxType, []interface{}{
+		enc.ChainID,
+		enc.Nonce,
+		enc.GasTipCap,
+		enc.GasFeeCap,
+		enc.Gas,
+		enc.To,
+		enc.Value,
+		enc.Data,
+		enc.AccessList,
+		enc.AuthList,
+	})
+}
+
+// Hash returns the Ethereum transaction hash of the signed set code transaction.
+func (tx *SetCodeTx) Hash() common.Hash {
+	return prefixedRLPHash(SetCodeTxType, tx.toRLP())
+}
+
+// Sender recovers the address of the transaction signer.
+func (tx *SetCodeTx) Sender() (common.Address, error) {
+	v, r, s := tx.GetRawSignatureValues()
+	if v == nil {
+		// a zero y parity is stored as empty bytes
+		v = new(big.Int)
+	}
+	return recoverAddress(tx.SigHash(), v, r, s)
+}
+
+// AsMessage returns the set code transaction as a core.Message, checking that
+// it was signed for the chain of the given signer.
+func (tx *SetCodeTx) AsMessage(signer ethtypes.Signer, baseFee *big.Int) (SetCodeMessage, error) {
+	chainID := tx.GetChainID()
+	if chainID == nil || signer.ChainID() == nil || chainID.Cmp(signer.ChainID()) != 0 {
+		return SetCodeMessage{}, ethtypes.ErrInvalidChainId
+	}
+
+	from, err := tx.Sender()
+	if err != nil {
+		return SetCodeMessage{}, err
+	}
+
+	gasPrice := tx.GetGasFeeCap()
+	if baseFee != nil {
+		gasPrice = math.BigMin(new(big.Int).Add(tx.GetGasTipCap(), baseFee), gasPrice)
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		tx.GetTo(),
+		tx.GetNonce(),
+		tx.GetValue(),
+		tx.GetGas(),
+		gasPrice,
+		tx.GetGasFeeCap(),
+		tx.GetGasTipCap(),
+		tx.GetData(),
+		tx.GetAccessList(),
+		false,
+	)
+
+	return SetCodeMessage{Message: msg, AuthList: tx.AuthorizationList}, nil
+}
+
+// MarshalBinary returns the canonical EIP-2718 encoding of the transaction.
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(SetCodeTxType)
+	if err := rlp.Encode(&buf, tx.toRLP()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// newSetCodeTxFromBinary decodes the canonical EIP-2718 encoding of a set code transaction.
+func newSetCodeTxFromBinary(b []byte) (*SetCodeTx, error) {
+	if len(b) <= 1 || b[0] != SetCodeTxType {
+		return nil, ethtypes.ErrTxTypeNotSupported
+	}
+
+	var dec setCodeTxRLP
+	if err := rlp.DecodeBytes(b[1:], &dec); err != nil {
+		return nil, err
+	}
+
+	txData := &SetCodeTx{
+		Nonce:             dec.Nonce,
+		GasLimit:          dec.Gas,
+		To:                dec.To.Hex(),
+		Data:              dec.Data,
+		Accesses:          NewAccessList(&dec.AccessList),
+		AuthorizationList: make([]SetCodeAuthorization, len(dec.AuthList)),
+	}
+
+	for _, field := range []struct {
+		value *big.Int
+		dest  **sdkmath.Int
+	}{
+		{dec.Value, &txData.Amount},
+		{dec.GasTipCap, &txData.GasTipCap},
+		{dec.GasFeeCap, &txData.GasFeeCap},
+	} {
+		if field.value == nil {
+			continue
+		}
+		valueInt, err := types.SafeNewIntFromBigInt(field.value)
+		if err != nil {
+			return nil, err
+		}
+		*field.dest = &valueInt
+	}
+
+	for i, auth := range dec.AuthList {
+		chainID, err := types.SafeNewIntFromBigInt(auth.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		txData.AuthorizationList[i] = SetCodeAuthorization{
+			ChainID: &chainID,
+			Address: auth.Address.Hex(),
+			Nonce:   auth.Nonce,
+			V:       new(big.Int).SetUint64(uint64(auth.V)).Bytes(),
+			R:       auth.R.Bytes(),
+			S:       auth.S.Bytes(),
+		}
+	}
+
+	if !types.IsValidInt256(dec.ChainID) {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidChainID, "out of bound")
+	}
+	txData.SetSignatureValues(dec.ChainID, dec.V, dec.R, dec.S)
+	return txData, nil
+}
+
+func (tx *SetCodeTx) toRLP() setCodeTxRLP {
+	v, r, s := tx.GetRawSignatureValues()
+	authList := make([]setCodeAuthorizationRLP, len(tx.AuthorizationList))
+	for i, auth := range tx.AuthorizationList {
+		authList[i] = auth.toRLP()
+	}
+
+	enc := setCodeTxRLP{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.Nonce,
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GasLimit,
+		Value:      tx.GetValue(),
+		Data:       tx.Data,
+		AccessList: tx.GetAccessList(),
+		AuthList:   authList,
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+	if to := tx.GetTo(); to != nil {
+		enc.To = *to
+	}
+	if enc.AccessList == nil {
+		enc.AccessList = ethtypes.AccessList{}
+	}
+	return enc
+}
+
+// NewSetCodeAuthorization creates an unsigned authorization delegating the code of
+// the signer to the given address.
+func NewSetCodeAuthorization(chainID *big.Int, address common.Address, nonce uint64) SetCodeAuthorization {
+	chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+	return SetCodeAuthorization{
+		ChainID: &chainIDInt,
+		Address: address.Hex(),
+		Nonce:   nonce,
+	}
+}
+
+// SignSetCodeAuthorization signs the authorization with the given private key,
+// which becomes the authority of the delegation.
+func SignSetCodeAuthorization(prv *ecdsa.PrivateKey, auth SetCodeAuthorization) (SetCodeAuthorization, error) {
+	sighash := auth.SigHash()
+	sig, err := crypto.Sign(sighash[:], prv)
+	if err != nil {
+		return SetCodeAuthorization{}, err
+	}
+
+	signed := auth.copy()
+	signed.R = new(big.Int).SetBytes(sig[:32]).Bytes()
+	signed.S = new(big.Int).SetBytes(sig[32:64]).Bytes()
+	signed.V = new(big.Int).SetUint64(uint64(sig[64])).Bytes()
+	return signed, nil
+}
+
+// GetChainID returns the chain id the authorization is valid on. A zero chain id
+// makes the authorization valid on every chain.
+func (auth SetCodeAuthorization) GetChainID() *big.Int {
+	if auth.ChainID == nil {
+		return new(big.Int)
+	}
+	return auth.ChainID.BigInt()
+}
+
+// GetAddress returns the address the authority delegates its code to.
+func (auth SetCodeAuthorization) GetAddress() common.Address {
+	return common.HexToAddress(auth.Address)
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the authorization.
+func (auth SetCodeAuthorization) GetRawSignatureValues() (v, r, s *big.Int) {
+	return rawSignatureValues(auth.V, auth.R, auth.S)
+}
+
+// Validate performs a stateless validation of the authorization fields. The
+// signature itself is only checked when the authorization is applied.
+func (auth SetCodeAuthorization) Validate() error {
+	if auth.ChainID != nil && auth.ChainID.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "chain id cannot be negative %s", auth.ChainID)
+	}
+
+	if !types.IsValidInt256(auth.GetChainID()) {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "chain id out of bound")
+	}
+
+	if err := types.ValidateAddress(auth.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid authorization address")
+	}
+
+	v, r, s := auth.GetRawSignatureValues()
+	if v != nil && v.Cmp(big.NewInt(1)) > 0 {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "invalid signature y parity %s", v)
+	}
+
+	if !types.IsValidInt256(r) || !types.IsValidInt256(s) {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "signature values out of bound")
+	}
+
+	return nil
+}
+
+// SigHash returns the hash signed by the authority.
+func (auth SetCodeAuthorization) SigHash() common.Hash {
+	return prefixedRLPHash(SetCodeAuthorizationMagic, []interface{}{
+		auth.GetChainID(),
+		auth.GetAddress(),
+		auth.Nonce,
+	})
+}
+
+// Authority recovers the address of the account that signed the authorization.
+func (auth SetCodeAuthorization) Authority() (common.Address, error) {
+	v, r, s := auth.GetRawSignatureValues()
+	if v == nil {
+		// a zero y parity is stored as empty bytes
+		v = new(big.Int)
+	}
+	return recoverAddress(auth.SigHash(), v, r, s)
+}
+
+func (auth SetCodeAuthorization) copy() SetCodeAuthorization {
+	return SetCodeAuthorization{
+		ChainID: auth.ChainID,
+		Address: auth.Address,
+		Nonce:   auth.Nonce,
+		V:       common.CopyBytes(auth.V),
+		R:       common.CopyBytes(auth.R),
+		S:       common.CopyBytes(auth.S),
+	}
+}
+
+func (auth SetCodeAuthorization) toRLP() setCodeAuthorizationRLP {
+	v, r, s := auth.GetRawSignatureValues()
+	enc := setCodeAuthorizationRLP{
+		ChainID: auth.GetChainID(),
+		Address: auth.GetAddress(),
+		Nonce:   auth.Nonce,
+		R:       r,
+		S:       s,
+	}
+	if v != nil {
+		enc.V = uint8(v.Uint64()) //#nosec G115 -- the y parity is validated to be 0 or 1
+	}
+	return enc
+}
+
+// prefixedRLPHash returns the keccak256 hash of the type prefixed RLP encoding of x.
+func prefixedRLPHash(prefix byte, x interface{}) (h common.Hash) {
+	hasher := crypto.NewKeccakState()
+	hasher.Write([]byte{prefix})
+	if err := rlp.Encode(hasher, x); err != nil {
+		return common.Hash{}
+	}
+	hasher.Read(h[:]) //#nosec G104 -- the keccak state never returns an error
+	return h
+}
+
+// recoverAddress returns the address that produced the given typed transaction
+// signature, where v is the y parity of the signature.
+func recoverAddress(sighash common.Hash, v, r, s *big.Int) (common.Address, error) {
+	if v == nil || r == nil || s == nil || v.BitLen() > 8 {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	yParity := byte(v.Uint64())
+	if !crypto.ValidateSignatureValues(yParity, r, s, true) {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = yParity
+
+	pub, err := crypto.Ecrecover(sighash[:], sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(pub) == 0 || pub[0] != 4 {
+		return common.Address{}, errors.New("invalid public key")
+	}
+
+	var addr common.Address
+	copy(addr[:], crypto.Keccak256(pub[1:])[12:])
+	return addr, nil
+}
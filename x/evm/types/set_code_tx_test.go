@@ -0,0 +1,163 @@
+package types_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/evm/types"
+)
+
+func (suite *MsgsTestSuite) newSetCodeAuthorization(chainID *big.Int, nonce uint64) (types.SetCodeAuthorization, common.Address) {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+
+	auth, err := types.SignSetCodeAuthorization(key, types.NewSetCodeAuthorization(chainID, suite.to, nonce))
+	suite.Require().NoError(err)
+	return auth, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func (suite *MsgsTestSuite) TestSetCodeAuthorization_Authority() {
+	auth, authority := suite.newSetCodeAuthorization(suite.chainID, 3)
+
+	recovered, err := auth.Authority()
+	suite.Require().NoError(err)
+	suite.Require().Equal(authority, recovered)
+	suite.Require().NoError(auth.Validate())
+
+	// tampering with the signed fields changes the recovered authority
+	auth.Nonce++
+	recovered, err = auth.Authority()
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(authority, recovered)
+
+	auth.S = nil
+	_, err = auth.Authority()
+	suite.Require().Error(err)
+}
+
+func (suite *MsgsTestSuite) TestSetCodeTx_Validate() {
+	auth, _ := suite.newSetCodeAuthorization(suite.chainID, 0)
+	negative := sdkmath.NewInt(-1)
+
+	testCases := []struct {
+		name     string
+		malleate func(tx *types.SetCodeTx)
+		expPass  bool
+	}{
+		{"pass", func(*types.SetCodeTx) {}, true},
+		{"pass - any chain authorization", func(tx *types.SetCodeTx) {
+			anyChainAuth, _ := suite.newSetCodeAuthorization(big.NewInt(0), 0)
+			tx.AuthorizationList = []types.SetCodeAuthorization{anyChainAuth}
+		}, true},
+		{"fail - contract creation", func(tx *types.SetCodeTx) { tx.To = "" }, false},
+		{"fail - empty authorization list", func(tx *types.SetCodeTx) { tx.AuthorizationList = nil }, false},
+		{"fail - nil chain id", func(tx *types.SetCodeTx) { tx.ChainID = nil }, false},
+		{"fail - negative authorization chain id", func(tx *types.SetCodeTx) { tx.AuthorizationList[0].ChainID = &negative }, false},
+		{"fail - invalid authorization address", func(tx *types.SetCodeTx) { tx.AuthorizationList[0].Address = invalidAddress }, false},
+		{"fail - invalid authorization y parity", func(tx *types.SetCodeTx) { tx.AuthorizationList[0].V = []byte{2} }, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			txData, err := (&types.EvmTxArgs{
+				ChainID:           suite.chainID,
+				To:                &suite.to,
+				GasLimit:          100000,
+				GasFeeCap:         suite.hundredBigInt,
+				GasTipCap:         big.NewInt(1),
+				AuthorizationList: []types.SetCodeAuthorization{auth},
+			}).ToTxData()
+			suite.Require().NoError(err)
+
+			tx, ok := txData.(*types.SetCodeTx)
+			suite.Require().True(ok)
+			tc.malleate(tx)
+
+			err = tx.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestSetCodeTx_SignAndEncode() {
+	auth, _ := suite.newSetCodeAuthorization(suite.chainID, 0)
+	msg := types.NewTx(&types.EvmTxArgs{
+		ChainID:           suite.chainID,
+		Nonce:             1,
+		To:                &suite.to,
+		Amount:            big.NewInt(10),
+		GasLimit:          100000,
+		GasFeeCap:         suite.hundredBigInt,
+		GasTipCap:         big.NewInt(1),
+		Input:             []byte("test"),
+		Accesses:          &ethtypes.AccessList{{Address: suite.to, StorageKeys: []common.Hash{{1}}}},
+		AuthorizationList: []types.SetCodeAuthorization{auth},
+	})
+	msg.From = suite.from.Hex()
+
+	// the signer must match the chain id of the transaction
+	err := msg.Sign(ethtypes.NewLondonSigner(big.NewInt(2)), suite.signer)
+	suite.Require().ErrorIs(err, ethtypes.ErrInvalidChainId)
+
+	err = msg.Sign(ethtypes.NewLondonSigner(suite.chainID), suite.signer)
+	suite.Require().NoError(err)
+	suite.Require().NoError(msg.ValidateBasic())
+
+	sender, err := msg.GetSender(suite.chainID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, sender)
+
+	// the hash of the set code transaction differs from its go-ethereum representation
+	suite.Require().Equal(msg.Hash, msg.ComputeHash().Hex())
+	suite.Require().NotEqual(msg.AsTransaction().Hash(), msg.ComputeHash())
+
+	txData, err := types.UnpackTxData(msg.Data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint8(types.SetCodeTxType), txData.TxType())
+	bz, err := txData.(*types.SetCodeTx).MarshalBinary()
+	suite.Require().NoError(err)
+	suite.Require().Equal(byte(types.SetCodeTxType), bz[0])
+
+	decoded := &types.MsgEthereumTx{}
+	suite.Require().NoError(decoded.UnmarshalBinary(bz))
+	suite.Require().Equal(msg.Hash, decoded.Hash)
+	suite.Require().NoError(decoded.ValidateBasic())
+
+	decodedSender, err := decoded.GetSender(suite.chainID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, decodedSender)
+
+	coreMsg, err := decoded.AsMessage(ethtypes.NewLondonSigner(suite.chainID), big.NewInt(10))
+	suite.Require().NoError(err)
+	setCodeMsg, ok := coreMsg.(types.SetCodeMessage)
+	suite.Require().True(ok)
+	suite.Require().Equal(suite.from, setCodeMsg.From())
+	suite.Require().Equal(big.NewInt(11), setCodeMsg.GasPrice())
+	suite.Require().Len(setCodeMsg.AuthList, 1)
+	suite.Require().Equal(auth.SigHash(), setCodeMsg.AuthList[0].SigHash())
+
+	// a different signer key produces a different sender
+	other := types.NewTx(&types.EvmTxArgs{
+		ChainID:           suite.chainID,
+		To:                &suite.to,
+		GasLimit:          100000,
+		GasFeeCap:         suite.hundredBigInt,
+		GasTipCap:         big.NewInt(1),
+		AuthorizationList: []types.SetCodeAuthorization{auth},
+	})
+	otherFrom, otherKey := utiltx.NewAddrKey()
+	other.From = otherFrom.Hex()
+	suite.Require().NoError(other.Sign(ethtypes.NewLondonSigner(suite.chainID), utiltx.NewSigner(otherKey)))
+	otherSender, err := other.GetSender(suite.chainID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(otherFrom, otherSender)
+}
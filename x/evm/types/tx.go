@@ -13,7 +13,7 @@ import (
 )
 
 // EvmTxArgs encapsulates all possible params to create all EVM txs types.
-// This includes LegacyTx, DynamicFeeTx and AccessListTx
+// This includes LegacyTx, DynamicFeeTx, AccessListTx and SetCodeTx
 type EvmTxArgs struct {
 	Nonce     uint64
 	GasLimit  uint64
@@ -25,10 +25,16 @@ type EvmTxArgs struct {
 	GasTipCap *big.Int
 	To        *common.Address
 	Accesses  *ethtypes.AccessList
+	// AuthorizationList is only used by SetCodeTx
+	AuthorizationList []SetCodeAuthorization
 }
 
 // ToTxData converts the EvmTxArgs to TxData
 func (args *EvmTxArgs) ToTxData() (TxData, error) {
+	if args.AuthorizationList != nil {
+		// set code txs cannot be represented as go-ethereum transactions
+		return UnpackTxData(NewTx(args).Data)
+	}
 	ethTx := NewTx(args).AsTransaction()
 	return NewTxDataFromTx(ethTx)
 }
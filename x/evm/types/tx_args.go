@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -38,6 +39,49 @@ type TransactionArgs struct {
 	// Introduced by AccessListTxType transaction.
 	AccessList *ethtypes.AccessList `json:"accessList,omitempty"`
 	ChainID    *hexutil.Big         `json:"chainId,omitempty"`
+
+	// Introduced by SetCodeTxType transaction.
+	AuthorizationList []SetCodeAuthorizationArgs `json:"authorizationList,omitempty"`
+}
+
+// SetCodeAuthorizationArgs is the JSON-RPC representation of an EIP-7702
+// authorization tuple.
+type SetCodeAuthorizationArgs struct {
+	ChainID hexutil.Big    `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	V       hexutil.Uint64 `json:"yParity"`
+	R       hexutil.Big    `json:"r"`
+	S       hexutil.Big    `json:"s"`
+}
+
+// NewSetCodeAuthorizationArgs returns the JSON-RPC representation of the authorization.
+func NewSetCodeAuthorizationArgs(auth SetCodeAuthorization) SetCodeAuthorizationArgs {
+	v, r, s := auth.GetRawSignatureValues()
+	args := SetCodeAuthorizationArgs{
+		ChainID: hexutil.Big(*auth.GetChainID()),
+		Address: auth.GetAddress(),
+		Nonce:   hexutil.Uint64(auth.Nonce),
+	}
+	if v != nil {
+		args.V = hexutil.Uint64(v.Uint64())
+	}
+	if r != nil {
+		args.R = hexutil.Big(*r)
+	}
+	if s != nil {
+		args.S = hexutil.Big(*s)
+	}
+	return args
+}
+
+// ToAuthorization converts the JSON-RPC arguments to a SetCodeAuthorization.
+func (args SetCodeAuthorizationArgs) ToAuthorization() SetCodeAuthorization {
+	auth := NewSetCodeAuthorization(args.ChainID.ToInt(), args.Address, uint64(args.Nonce))
+	auth.V = new(big.Int).SetUint64(uint64(args.V)).Bytes()
+	auth.R = args.R.ToInt().Bytes()
+	auth.S = args.S.ToInt().Bytes()
+	return auth
 }
 
 // String return the struct in a string format
@@ -98,6 +142,24 @@ func (args *TransactionArgs) ToTransaction() *MsgEthereumTx {
 
 	var data TxData
 	switch {
+	case args.AuthorizationList != nil:
+		al := AccessList{}
+		if args.AccessList != nil {
+			al = NewAccessList(args.AccessList)
+		}
+
+		data = &SetCodeTx{
+			To:                to,
+			ChainID:           &chainID,
+			Nonce:             nonce,
+			GasLimit:          gas,
+			GasFeeCap:         &maxFeePerGas,
+			GasTipCap:         &maxPriorityFeePerGas,
+			Amount:            &value,
+			Data:              args.GetData(),
+			Accesses:          al,
+			AuthorizationList: args.GetAuthorizationList(),
+		}
 	case args.MaxFeePerGas != nil:
 		al := AccessList{}
 		if args.AccessList != nil {
@@ -150,7 +212,7 @@ func (args *TransactionArgs) ToTransaction() *MsgEthereumTx {
 		Data: anyData,
 		From: from,
 	}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.ComputeHash().Hex()
 	return &msg
 }
 
@@ -230,6 +292,28 @@ func (args *TransactionArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (e
 	return msg, nil
 }
 
+// WithAuthorizations wraps the message with the authorization list of the
+// arguments, so that the delegations are applied before the message execution.
+// The message is returned as is when no authorization list is set.
+func (args *TransactionArgs) WithAuthorizations(msg ethtypes.Message) core.Message {
+	if args.AuthorizationList == nil {
+		return msg
+	}
+	return SetCodeMessage{Message: msg, AuthList: args.GetAuthorizationList()}
+}
+
+// GetAuthorizationList retrieves the set code authorizations of the transaction.
+func (args *TransactionArgs) GetAuthorizationList() []SetCodeAuthorization {
+	if args.AuthorizationList == nil {
+		return nil
+	}
+	authList := make([]SetCodeAuthorization, len(args.AuthorizationList))
+	for i, auth := range args.AuthorizationList {
+		authList[i] = auth.ToAuthorization()
+	}
+	return authList
+}
+
 // GetFrom retrieves the transaction sender address.
 func (args *TransactionArgs) GetFrom() common.Address {
 	if args.From == nil {
@@ -9,6 +9,15 @@ import (
 
 var _ sdk.Msg = &MsgUpdateParams{}
 
+// TypeMsgUpdateParams defines the type string of a params update
+const TypeMsgUpdateParams = "update_params"
+
+// Route returns the name of the module
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.Authority)
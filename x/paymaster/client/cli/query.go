@@ -0,0 +1,164 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+// GetQueryCmd returns the parent command for all paymaster CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the paymaster module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetParamsCmd(),
+		GetSponsorshipsCmd(),
+		GetSponsorshipCmd(),
+		GetSponsorshipUsageCmd(),
+	)
+	return cmd
+}
+
+// GetParamsCmd queries paymaster module params
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets paymaster params",
+		Long:  "Gets paymaster params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSponsorshipsCmd queries all registered sponsorships
+func GetSponsorshipsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsorships",
+		Short: "Gets registered sponsorships",
+		Long:  "Gets registered sponsorships",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySponsorshipsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Sponsorships(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sponsorships")
+	return cmd
+}
+
+// GetSponsorshipCmd queries the sponsorship of a contract
+func GetSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsorship CONTRACT_ADDRESS",
+		Short: "Get the sponsorship of a contract and its deposit",
+		Long:  "Get the sponsorship of a contract and its deposit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySponsorshipRequest{
+				ContractAddress: args[0],
+			}
+
+			res, err := queryClient.Sponsorship(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSponsorshipUsageCmd queries the fees sponsored for a sender on the current day
+func GetSponsorshipUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage CONTRACT_ADDRESS SENDER",
+		Short: "Get the fees sponsored for a sender on the current day",
+		Long:  "Get the fees sponsored for a hex sender address on the current day and its remaining daily allowance",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySponsorshipUsageRequest{
+				ContractAddress: args[0],
+				Sender:          args[1],
+			}
+
+			res, err := queryClient.SponsorshipUsage(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,186 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package cli
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	evmostypes "github.com/evmos/evmos/v19/types"
+	"github.com/evmos/evmos/v19/utils"
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+const (
+	// FlagMethodSelectors defines the flag for the sponsored method selectors
+	FlagMethodSelectors = "method-selectors"
+	// FlagDailyCap defines the flag for the daily cap per user
+	FlagDailyCap = "daily-cap"
+	// FlagMaxGasPrice defines the flag for the maximum sponsored gas price
+	FlagMaxGasPrice = "max-gas-price"
+)
+
+// NewTxCmd returns a root CLI command handler for paymaster transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "paymaster subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterSponsorshipCmd(),
+		NewDepositSponsorshipCmd(),
+		NewDeregisterSponsorshipCmd(),
+	)
+	return txCmd
+}
+
+// NewRegisterSponsorshipCmd returns a CLI command handler for registering the
+// sponsorship of a contract
+func NewRegisterSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register CONTRACT_ADDRESS DEPOSIT",
+		Short: "Sponsor the fees of the transactions calling a contract owned by the sender, funded with the given deposit",
+		Example: fmt.Sprintf(
+			"$ %s tx %s register <contract-address> 100000000000000000000%s --%s=0xa9059cbb,0x095ea7b3 --%s=1000000000000000000 --%s=100000000000 --from=<key_or_address>",
+			version.AppName, types.ModuleName, utils.BaseDenom, FlagMethodSelectors, FlagDailyCap, FlagMaxGasPrice,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := evmostypes.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid contract address %w", err)
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			selectors, err := cmd.Flags().GetStringSlice(FlagMethodSelectors)
+			if err != nil {
+				return err
+			}
+
+			dailyCapStr, err := cmd.Flags().GetString(FlagDailyCap)
+			if err != nil {
+				return err
+			}
+			dailyCap, ok := math.NewIntFromString(dailyCapStr)
+			if !ok {
+				return fmt.Errorf("invalid daily cap %s", dailyCapStr)
+			}
+
+			maxGasPriceStr, err := cmd.Flags().GetString(FlagMaxGasPrice)
+			if err != nil {
+				return err
+			}
+			maxGasPrice, ok := math.NewIntFromString(maxGasPriceStr)
+			if !ok {
+				return fmt.Errorf("invalid max gas price %s", maxGasPriceStr)
+			}
+
+			msg := types.NewMsgRegisterSponsorship(
+				cliCtx.GetFromAddress(), common.HexToAddress(contract), selectors, dailyCap, maxGasPrice, deposit,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagMethodSelectors, []string{}, "hex encoded 4-byte selectors of the sponsored methods, all methods are sponsored if empty")
+	cmd.Flags().String(FlagDailyCap, "0", "maximum fees sponsored per sender and day, zero for no cap")
+	cmd.Flags().String(FlagMaxGasPrice, "", "maximum effective gas price sponsored per transaction")
+	if err := cmd.MarkFlagRequired(FlagMaxGasPrice); err != nil {
+		panic(err)
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDepositSponsorshipCmd returns a CLI command handler for adding funds to
+// a sponsorship deposit
+func NewDepositSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit CONTRACT_ADDRESS AMOUNT",
+		Short: "Add funds to the deposit of a sponsorship owned by the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := evmostypes.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid contract address %w", err)
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositSponsorship(cliCtx.GetFromAddress(), common.HexToAddress(contract), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDeregisterSponsorshipCmd returns a CLI command handler for removing a
+// sponsorship and refunding its deposit
+func NewDeregisterSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister CONTRACT_ADDRESS",
+		Short: "Remove a sponsorship owned by the sender and refund the remaining deposit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := evmostypes.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid contract address %w", err)
+			}
+
+			msg := types.NewMsgDeregisterSponsorship(cliCtx.GetFromAddress(), common.HexToAddress(contract))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
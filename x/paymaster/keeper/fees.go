@@ -0,0 +1,79 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+// SponsorFees returns the account of the sponsorship that pays the fees of an
+// EVM transaction sent by sender to the given contract. It does not modify the
+// state: the fees must be added to the daily usage of the sender with
+// RecordSponsoredFees once they have been deducted from the account.
+//
+// It returns false and the sender pays the fees when:
+//   - the paymaster is disabled or the transaction creates a contract
+//   - no sponsorship is registered for the contract or the called method
+//   - the effective gas price exceeds the maximum gas price of the sponsorship
+//   - the fees exceed the remaining daily allowance of the sender
+//   - the sponsorship deposit cannot cover the fees
+func (k Keeper) SponsorFees(
+	ctx sdk.Context,
+	sender common.Address,
+	to *common.Address,
+	input []byte,
+	gasPrice math.Int,
+	fee sdk.Coin,
+) (sdk.AccAddress, bool) {
+	if to == nil || !fee.IsPositive() || !k.GetParams(ctx).EnablePaymaster {
+		return nil, false
+	}
+
+	sponsorship, found := k.GetSponsorship(ctx, *to)
+	if !found || !sponsorship.IsMethodSponsored(input) || !sponsorship.IsGasPriceSponsored(gasPrice) {
+		return nil, false
+	}
+
+	if sponsorship.HasDailyCap() {
+		usage, _ := k.GetSponsorshipUsage(ctx, *to, sender)
+		if sponsorship.RemainingAllowance(usage, types.UsageDay(ctx.BlockTime())).LT(fee.Amount) {
+			return nil, false
+		}
+	}
+
+	account := sponsorship.GetAccount()
+	if k.bankKeeper.SpendableCoins(ctx, account).AmountOf(fee.Denom).LT(fee.Amount) {
+		return nil, false
+	}
+
+	return account, true
+}
+
+// RecordSponsoredFees adds the fees paid by the sponsorship of the given
+// contract to the daily usage of the sender. The fees are charged up front, so
+// the gas refunded after the execution is not deducted from the daily usage.
+func (k Keeper) RecordSponsoredFees(
+	ctx sdk.Context,
+	sender common.Address,
+	contract common.Address,
+	fee sdk.Coin,
+) {
+	day := types.UsageDay(ctx.BlockTime())
+	usage, _ := k.GetSponsorshipUsage(ctx, contract, sender)
+	k.SetSponsorshipUsage(ctx, types.NewSponsorshipUsage(contract, sender, day, usage.AmountOnDay(day).Add(fee.Amount)))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSponsorFees,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+			sdk.NewAttribute(types.AttributeKeySponsor, types.SponsorshipAccount(contract).String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.Hex()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+}
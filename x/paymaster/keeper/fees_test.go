@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+func (suite *KeeperTestSuite) TestSponsorFees() {
+	var (
+		contract common.Address
+		to       *common.Address
+		input    []byte
+		gasPrice math.Int
+		fee      sdk.Coin
+	)
+	sender := utiltx.GenerateAddress()
+	transfer := hexutil.MustDecode("0xa9059cbb0000000000000000000000000000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		expUsage int64
+	}{
+		{
+			"ok - sponsored method",
+			func() {},
+			true,
+			0,
+		},
+		{
+			"ok - gas price equal to the max gas price",
+			func() {
+				gasPrice = math.NewInt(maxGasPrice)
+			},
+			true,
+			0,
+		},
+		{
+			"ok - daily cap reset on the next day",
+			func() {
+				suite.keeper.SetSponsorshipUsage(suite.ctx, types.NewSponsorshipUsage(contract, sender, types.UsageDay(suite.ctx.BlockTime()), math.NewInt(100)))
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24 * time.Hour))
+			},
+			true,
+			0,
+		},
+		{
+			"ok - fees within the remaining daily allowance",
+			func() {
+				suite.keeper.SetSponsorshipUsage(suite.ctx, types.NewSponsorshipUsage(contract, sender, types.UsageDay(suite.ctx.BlockTime()), math.NewInt(60)))
+			},
+			true,
+			60,
+		},
+		{
+			"fail - contract creation",
+			func() {
+				to = nil
+			},
+			false,
+			0,
+		},
+		{
+			"fail - zero fee",
+			func() {
+				fee.Amount = math.ZeroInt()
+			},
+			false,
+			0,
+		},
+		{
+			"fail - paymaster disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnablePaymaster = false
+				suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+			},
+			false,
+			0,
+		},
+		{
+			"fail - contract not sponsored",
+			func() {
+				other := utiltx.GenerateAddress()
+				to = &other
+			},
+			false,
+			0,
+		},
+		{
+			"fail - method not sponsored",
+			func() {
+				input = hexutil.MustDecode("0x095ea7b3")
+			},
+			false,
+			0,
+		},
+		{
+			"fail - gas price above the max gas price",
+			func() {
+				gasPrice = math.NewInt(maxGasPrice + 1)
+			},
+			false,
+			0,
+		},
+		{
+			"fail - gas price above the max gas price without daily cap",
+			func() {
+				gasPrice = math.NewInt(maxGasPrice + 1)
+				sponsorship, _ := suite.keeper.GetSponsorship(suite.ctx, contract)
+				sponsorship.DailyCapPerUser = math.ZeroInt()
+				suite.keeper.SetSponsorship(suite.ctx, sponsorship)
+			},
+			false,
+			0,
+		},
+		{
+			"fail - daily cap reached",
+			func() {
+				suite.keeper.SetSponsorshipUsage(suite.ctx, types.NewSponsorshipUsage(contract, sender, types.UsageDay(suite.ctx.BlockTime()), math.NewInt(61)))
+			},
+			false,
+			61,
+		},
+		{
+			"fail - insufficient deposit",
+			func() {
+				fee.Amount = math.NewInt(100)
+				sponsorship, _ := suite.keeper.GetSponsorship(suite.ctx, contract)
+				sponsorship.DailyCapPerUser = math.ZeroInt()
+				suite.keeper.SetSponsorship(suite.ctx, sponsorship)
+				err := suite.app.BankKeeper.SendCoins(suite.ctx, sponsorship.GetAccount(), sender.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 950)))
+				suite.Require().NoError(err)
+			},
+			false,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			owner := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			contract = suite.registerSponsorship(owner, []string{"0xa9059cbb"}, 100, 1000)
+			to = &contract
+			input = transfer
+			gasPrice = math.NewInt(2)
+			fee = sdk.NewInt64Coin(suite.denom, 40)
+
+			tc.malleate()
+
+			sponsor, ok := suite.keeper.SponsorFees(suite.ctx, sender, to, input, gasPrice, fee)
+			suite.Require().Equal(tc.expPass, ok)
+			if tc.expPass {
+				suite.Require().Equal(types.SponsorshipAccount(contract), sponsor)
+			} else {
+				suite.Require().Nil(sponsor)
+			}
+
+			// the usage is only recorded once the fees are deducted
+			usage, _ := suite.keeper.GetSponsorshipUsage(suite.ctx, contract, sender)
+			day := types.UsageDay(suite.ctx.BlockTime())
+			suite.Require().Equal(tc.expUsage, usage.AmountOnDay(day).Int64())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRecordSponsoredFees() {
+	contract := utiltx.GenerateAddress()
+	sender := utiltx.GenerateAddress()
+	fee := sdk.NewInt64Coin(suite.denom, 40)
+
+	testCases := []struct {
+		name     string
+		malleate func(day uint64)
+		expUsage int64
+	}{
+		{
+			"first usage",
+			func(uint64) {},
+			40,
+		},
+		{
+			"added to the usage of the day",
+			func(day uint64) {
+				suite.keeper.SetSponsorshipUsage(suite.ctx, types.NewSponsorshipUsage(contract, sender, day, math.NewInt(60)))
+			},
+			100,
+		},
+		{
+			"usage of the previous day discarded",
+			func(day uint64) {
+				suite.keeper.SetSponsorshipUsage(suite.ctx, types.NewSponsorshipUsage(contract, sender, day-1, math.NewInt(60)))
+			},
+			40,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			day := types.UsageDay(suite.ctx.BlockTime())
+
+			tc.malleate(day)
+
+			suite.keeper.RecordSponsoredFees(suite.ctx, sender, contract, fee)
+
+			usage, found := suite.keeper.GetSponsorshipUsage(suite.ctx, contract, sender)
+			suite.Require().True(found)
+			suite.Require().Equal(day, usage.Day)
+			suite.Require().Equal(tc.expUsage, usage.Amount.Int64())
+
+			events := suite.ctx.EventManager().Events()
+			suite.Require().Equal(types.EventTypeSponsorFees, events[len(events)-1].Type)
+		})
+	}
+}
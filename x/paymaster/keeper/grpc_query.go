@@ -0,0 +1,116 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	evmostypes "github.com/evmos/evmos/v19/types"
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the params of the paymaster module
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Sponsorships returns all the registered sponsorships
+func (k Keeper) Sponsorships(c context.Context, req *types.QuerySponsorshipsRequest) (*types.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var sponsorships []types.Sponsorship
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var sponsorship types.Sponsorship
+		if err := k.cdc.Unmarshal(value, &sponsorship); err != nil {
+			return err
+		}
+		sponsorships = append(sponsorships, sponsorship)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySponsorshipsResponse{
+		Sponsorships: sponsorships,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// Sponsorship returns the sponsorship of a contract and its deposit
+func (k Keeper) Sponsorship(c context.Context, req *types.QuerySponsorshipRequest) (*types.QuerySponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.ContractAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sponsorship, found := k.GetSponsorship(ctx, common.HexToAddress(req.ContractAddress))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "sponsorship for contract '%s' not found", req.ContractAddress)
+	}
+
+	account := sponsorship.GetAccount()
+	return &types.QuerySponsorshipResponse{
+		Sponsorship: sponsorship,
+		Account:     account.String(),
+		Deposit:     k.bankKeeper.GetAllBalances(ctx, account),
+	}, nil
+}
+
+// SponsorshipUsage returns the fees sponsored for a sender on the current day
+func (k Keeper) SponsorshipUsage(c context.Context, req *types.QuerySponsorshipUsageRequest) (*types.QuerySponsorshipUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.ContractAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := evmostypes.ValidateAddress(req.Sender); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contract := common.HexToAddress(req.ContractAddress)
+	sender := common.HexToAddress(req.Sender)
+
+	sponsorship, found := k.GetSponsorship(ctx, contract)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "sponsorship for contract '%s' not found", req.ContractAddress)
+	}
+
+	day := types.UsageDay(ctx.BlockTime())
+	usage, _ := k.GetSponsorshipUsage(ctx, contract, sender)
+
+	res := &types.QuerySponsorshipUsageResponse{
+		Usage: types.NewSponsorshipUsage(contract, sender, day, usage.AmountOnDay(day)),
+	}
+	if sponsorship.HasDailyCap() {
+		remaining := sponsorship.RemainingAllowance(usage, day)
+		res.Remaining = &remaining
+	}
+	return res, nil
+}
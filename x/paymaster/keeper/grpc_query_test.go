@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	res, err := suite.queryClient.Params(suite.ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQuerySponsorships() {
+	owner := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	suite.registerSponsorship(owner, nil, 0, 1000)
+	suite.registerSponsorship(owner, nil, 0, 1000)
+
+	res, err := suite.queryClient.Sponsorships(suite.ctx, &types.QuerySponsorshipsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Sponsorships, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestQuerySponsorship() {
+	owner := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := suite.registerSponsorship(owner, []string{"0xa9059cbb"}, 100, 1000)
+
+	testCases := []struct {
+		name    string
+		req     *types.QuerySponsorshipRequest
+		expPass bool
+	}{
+		{"fail - empty address", &types.QuerySponsorshipRequest{}, false},
+		{"fail - invalid address", &types.QuerySponsorshipRequest{ContractAddress: "0x0"}, false},
+		{"fail - not found", &types.QuerySponsorshipRequest{ContractAddress: utiltx.GenerateAddress().String()}, false},
+		{"ok", &types.QuerySponsorshipRequest{ContractAddress: contract.String()}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.Sponsorship(suite.ctx, tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(owner.String(), res.Sponsorship.Owner)
+			suite.Require().Equal(types.SponsorshipAccount(contract).String(), res.Account)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1000)), res.Deposit)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerySponsorshipUsage() {
+	owner := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	capped := suite.registerSponsorship(owner, nil, 100, 1000)
+	uncapped := suite.registerSponsorship(owner, nil, 0, 1000)
+	sender := utiltx.GenerateAddress()
+	day := types.UsageDay(suite.ctx.BlockTime())
+	suite.keeper.SetSponsorshipUsage(suite.ctx, types.NewSponsorshipUsage(capped, sender, day, math.NewInt(30)))
+
+	testCases := []struct {
+		name         string
+		req          *types.QuerySponsorshipUsageRequest
+		expPass      bool
+		expAmount    int64
+		expRemaining *math.Int
+	}{
+		{
+			"fail - invalid sender",
+			&types.QuerySponsorshipUsageRequest{ContractAddress: capped.String(), Sender: "0x0"},
+			false, 0, nil,
+		},
+		{
+			"fail - sponsorship not found",
+			&types.QuerySponsorshipUsageRequest{ContractAddress: utiltx.GenerateAddress().String(), Sender: sender.String()},
+			false, 0, nil,
+		},
+		{
+			"ok - capped",
+			&types.QuerySponsorshipUsageRequest{ContractAddress: capped.String(), Sender: sender.String()},
+			true, 30, func() *math.Int { i := math.NewInt(70); return &i }(),
+		},
+		{
+			"ok - no usage",
+			&types.QuerySponsorshipUsageRequest{ContractAddress: capped.String(), Sender: utiltx.GenerateAddress().String()},
+			true, 0, func() *math.Int { i := math.NewInt(100); return &i }(),
+		},
+		{
+			"ok - uncapped",
+			&types.QuerySponsorshipUsageRequest{ContractAddress: uncapped.String(), Sender: sender.String()},
+			true, 0, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.SponsorshipUsage(suite.ctx, tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expAmount, res.Usage.AmountOnDay(day).Int64())
+			suite.Require().Equal(tc.expRemaining, res.Remaining)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// RegisterSponsorship implements the gRPC MsgServer interface. It registers
+// the sponsorship of a contract when:
+//   - the sender is the address returned by the owner() method of the contract
+//   - the contract is not sponsored yet
+//   - the sender funds the sponsorship with a deposit in the EVM denomination
+func (k Keeper) RegisterSponsorship(
+	goCtx context.Context,
+	msg *types.MsgRegisterSponsorship,
+) (*types.MsgRegisterSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnablePaymaster {
+		return nil, errorsmod.Wrap(types.ErrPaymasterDisabled, "registration is currently disabled by governance")
+	}
+
+	if len(msg.MethodSelectors) > int(params.MaxMethodSelectors) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTooManyMethodSelectors, "%d method selectors exceed the maximum %d",
+			len(msg.MethodSelectors), params.MaxMethodSelectors,
+		)
+	}
+
+	// Error checked during msg validation
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	if _, found := k.GetSponsorship(ctx, contract); found {
+		return nil, errorsmod.Wrapf(types.ErrSponsorshipAlreadyExists, "contract %s", contract)
+	}
+
+	if err := k.verifyContractOwner(ctx, contract, owner); err != nil {
+		return nil, err
+	}
+
+	if err := k.verifyDepositDenom(ctx, msg.Deposit); err != nil {
+		return nil, err
+	}
+
+	sponsorship := types.NewSponsorship(contract, owner, msg.MethodSelectors, msg.DailyCapPerUser, msg.MaxGasPrice)
+	if err := k.bankKeeper.SendCoins(ctx, owner, sponsorship.GetAccount(), sdk.Coins{msg.Deposit}); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to fund sponsorship deposit")
+	}
+
+	k.SetSponsorship(ctx, sponsorship)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterSponsorship,
+			sdk.NewAttribute(types.AttributeKeyContract, sponsorship.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsorship.GetAccount().String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, msg.Deposit.String()),
+		),
+	)
+
+	return &types.MsgRegisterSponsorshipResponse{}, nil
+}
+
+// DepositSponsorship implements the gRPC MsgServer interface. It adds the
+// deposited amount to the account of the sponsorship.
+func (k Keeper) DepositSponsorship(
+	goCtx context.Context,
+	msg *types.MsgDepositSponsorship,
+) (*types.MsgDepositSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnablePaymaster {
+		return nil, errorsmod.Wrap(types.ErrPaymasterDisabled, "deposits are currently disabled by governance")
+	}
+
+	sponsorship, err := k.getOwnedSponsorship(ctx, msg.Owner, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.verifyDepositDenom(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
+	if err := k.bankKeeper.SendCoins(ctx, owner, sponsorship.GetAccount(), sdk.Coins{msg.Amount}); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to fund sponsorship deposit")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositSponsorship,
+			sdk.NewAttribute(types.AttributeKeyContract, sponsorship.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyDeposit, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgDepositSponsorshipResponse{}, nil
+}
+
+// DeregisterSponsorship implements the gRPC MsgServer interface. It removes
+// the sponsorship and refunds the remaining deposit to the owner. It is
+// allowed while the paymaster is disabled so that the deposits can be
+// withdrawn.
+func (k Keeper) DeregisterSponsorship(
+	goCtx context.Context,
+	msg *types.MsgDeregisterSponsorship,
+) (*types.MsgDeregisterSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsorship, err := k.getOwnedSponsorship(ctx, msg.Owner, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
+	account := sponsorship.GetAccount()
+	refund := k.bankKeeper.GetAllBalances(ctx, account)
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, account, owner, refund); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to refund sponsorship deposit")
+		}
+	}
+
+	k.DeleteSponsorship(ctx, sponsorship.GetContract())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterSponsorship,
+			sdk.NewAttribute(types.AttributeKeyContract, sponsorship.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+	)
+
+	return &types.MsgDeregisterSponsorshipResponse{Refund: refund}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the parameters in the keeper only if the requested authority
+// is the Cosmos SDK governance module account
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// verifyContractOwner checks that the address is a contract owned by the sender.
+func (k Keeper) verifyContractOwner(ctx sdk.Context, contract common.Address, sender sdk.AccAddress) error {
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress, "address %s is not a contract", contract,
+		)
+	}
+
+	owner, err := k.GetContractOwner(ctx, contract)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(owner.Bytes(), sender.Bytes()) {
+		return errorsmod.Wrapf(
+			types.ErrNotContractOwner, "sender %s is not the contract owner %s", common.BytesToAddress(sender), owner,
+		)
+	}
+	return nil
+}
+
+// verifyDepositDenom checks that the deposit is in the EVM denomination used
+// to pay the transaction fees.
+func (k Keeper) verifyDepositDenom(ctx sdk.Context, deposit sdk.Coin) error {
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	if deposit.Denom != evmDenom {
+		return errorsmod.Wrapf(
+			types.ErrInvalidSponsorshipDeposit, "expected denomination %s, got %s", evmDenom, deposit.Denom,
+		)
+	}
+	return nil
+}
+
+// getOwnedSponsorship returns the sponsorship of the contract if it was
+// registered by the given owner.
+func (k Keeper) getOwnedSponsorship(ctx sdk.Context, owner, contractAddress string) (types.Sponsorship, error) {
+	contract := common.HexToAddress(contractAddress)
+	sponsorship, found := k.GetSponsorship(ctx, contract)
+	if !found {
+		return types.Sponsorship{}, errorsmod.Wrapf(types.ErrSponsorshipNotFound, "contract %s", contract)
+	}
+
+	if sponsorship.Owner != owner {
+		return types.Sponsorship{}, errorsmod.Wrapf(
+			types.ErrNotSponsorshipOwner, "sender %s, owner %s", owner, sponsorship.Owner,
+		)
+	}
+	return sponsorship, nil
+}
@@ -0,0 +1,314 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterSponsorship() {
+	var (
+		owner    sdk.AccAddress
+		contract common.Address
+		msg      *types.MsgRegisterSponsorship
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		expErr   error
+	}{
+		{
+			"fail - paymaster disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnablePaymaster = false
+				suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+			},
+			false,
+			types.ErrPaymasterDisabled,
+		},
+		{
+			"fail - too many method selectors",
+			func() {
+				params := types.DefaultParams()
+				params.MaxMethodSelectors = 1
+				suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+				msg.MethodSelectors = []string{"0xa9059cbb", "0x095ea7b3"}
+			},
+			false,
+			types.ErrTooManyMethodSelectors,
+		},
+		{
+			"fail - not a contract",
+			func() {
+				delete(suite.evmKeeper.owners, contract)
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - sender is not the contract owner",
+			func() {
+				suite.evmKeeper.owners[contract] = utiltx.GenerateAddress()
+			},
+			false,
+			types.ErrNotContractOwner,
+		},
+		{
+			"fail - contract already sponsored",
+			func() {
+				suite.keeper.SetSponsorship(suite.ctx, types.NewSponsorship(contract, owner, nil, math.ZeroInt(), math.NewInt(1000)))
+			},
+			false,
+			types.ErrSponsorshipAlreadyExists,
+		},
+		{
+			"fail - deposit not in the EVM denomination",
+			func() {
+				msg.Deposit = sdk.NewInt64Coin("uatom", 1000)
+			},
+			false,
+			types.ErrInvalidSponsorshipDeposit,
+		},
+		{
+			"fail - insufficient funds",
+			func() {
+				msg.Deposit.Amount = math.NewInt(2000)
+			},
+			false,
+			nil,
+		},
+		{
+			"ok",
+			func() {},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			owner = utiltx.GenerateAddress().Bytes()
+			contract = utiltx.GenerateAddress()
+			suite.evmKeeper.owners[contract] = common.BytesToAddress(owner)
+			suite.fund(owner, 1000)
+			msg = types.NewMsgRegisterSponsorship(
+				owner, contract, []string{"0xA9059CBB"}, math.NewInt(100), math.NewInt(1000), sdk.NewInt64Coin(suite.denom, 1000),
+			)
+
+			tc.malleate()
+
+			_, err := suite.keeper.RegisterSponsorship(suite.ctx, msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				if tc.expErr != nil {
+					suite.Require().ErrorIs(err, tc.expErr)
+				}
+				return
+			}
+
+			suite.Require().NoError(err)
+			sponsorship, found := suite.keeper.GetSponsorship(suite.ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(owner.String(), sponsorship.Owner)
+			suite.Require().Equal([]string{"0xa9059cbb"}, sponsorship.MethodSelectors)
+			suite.Require().Equal(math.NewInt(100), sponsorship.DailyCapPerUser)
+			suite.Require().Equal(math.NewInt(1000), sponsorship.MaxGasPrice)
+
+			deposit := suite.app.BankKeeper.GetBalance(suite.ctx, types.SponsorshipAccount(contract), suite.denom)
+			suite.Require().Equal(int64(1000), deposit.Amount.Int64())
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, owner, suite.denom).IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDepositSponsorship() {
+	var (
+		owner    sdk.AccAddress
+		contract common.Address
+		msg      *types.MsgDepositSponsorship
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"fail - paymaster disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnablePaymaster = false
+				suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+			},
+			types.ErrPaymasterDisabled,
+		},
+		{
+			"fail - sponsorship not found",
+			func() {
+				msg.ContractAddress = utiltx.GenerateAddress().String()
+			},
+			types.ErrSponsorshipNotFound,
+		},
+		{
+			"fail - sender is not the sponsorship owner",
+			func() {
+				other := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+				suite.fund(other, 500)
+				msg.Owner = other.String()
+			},
+			types.ErrNotSponsorshipOwner,
+		},
+		{
+			"fail - deposit not in the EVM denomination",
+			func() {
+				msg.Amount = sdk.NewInt64Coin("uatom", 500)
+			},
+			types.ErrInvalidSponsorshipDeposit,
+		},
+		{
+			"ok",
+			func() {},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			owner = utiltx.GenerateAddress().Bytes()
+			contract = suite.registerSponsorship(owner, nil, 0, 1000)
+			suite.fund(owner, 500)
+			msg = types.NewMsgDepositSponsorship(owner, contract, sdk.NewInt64Coin(suite.denom, 500))
+
+			tc.malleate()
+
+			_, err := suite.keeper.DepositSponsorship(suite.ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			deposit := suite.app.BankKeeper.GetBalance(suite.ctx, types.SponsorshipAccount(contract), suite.denom)
+			suite.Require().Equal(int64(1500), deposit.Amount.Int64())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeregisterSponsorship() {
+	var (
+		owner    sdk.AccAddress
+		contract common.Address
+		msg      *types.MsgDeregisterSponsorship
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"fail - sponsorship not found",
+			func() {
+				msg.ContractAddress = utiltx.GenerateAddress().String()
+			},
+			types.ErrSponsorshipNotFound,
+		},
+		{
+			"fail - sender is not the sponsorship owner",
+			func() {
+				msg.Owner = sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+			},
+			types.ErrNotSponsorshipOwner,
+		},
+		{
+			"ok - paymaster disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnablePaymaster = false
+				suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+			},
+			nil,
+		},
+		{
+			"ok",
+			func() {},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			owner = utiltx.GenerateAddress().Bytes()
+			contract = suite.registerSponsorship(owner, nil, 100, 1000)
+			sender := utiltx.GenerateAddress()
+			suite.keeper.SetSponsorshipUsage(suite.ctx, types.NewSponsorshipUsage(contract, sender, 1, math.NewInt(10)))
+			msg = types.NewMsgDeregisterSponsorship(owner, contract)
+
+			tc.malleate()
+
+			res, err := suite.keeper.DeregisterSponsorship(suite.ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1000)), res.Refund)
+			suite.Require().Equal(int64(1000), suite.app.BankKeeper.GetBalance(suite.ctx, owner, suite.denom).Amount.Int64())
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.SponsorshipAccount(contract)).IsZero())
+
+			_, found := suite.keeper.GetSponsorship(suite.ctx, contract)
+			suite.Require().False(found)
+			_, found = suite.keeper.GetSponsorshipUsage(suite.ctx, contract, sender)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdateParams{Authority: "foobar"},
+			expectErr: true,
+		},
+		{
+			name: "pass - valid Update msg",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(false, 8),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run("MsgUpdateParams", func() {
+			_, err := suite.keeper.UpdateParams(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.request.Params, suite.keeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
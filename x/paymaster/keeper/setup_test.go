@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v19/app"
+	"github.com/evmos/evmos/v19/testutil"
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/utils"
+	erc20types "github.com/evmos/evmos/v19/x/erc20/types"
+	evmkeeper "github.com/evmos/evmos/v19/x/evm/keeper"
+	"github.com/evmos/evmos/v19/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	"github.com/evmos/evmos/v19/x/paymaster/keeper"
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+// fakeEVMKeeper emulates Ownable contracts on top of the EVM keeper of the app.
+type fakeEVMKeeper struct {
+	*evmkeeper.Keeper
+	owners map[common.Address]common.Address
+}
+
+func (f fakeEVMKeeper) GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account {
+	if _, ok := f.owners[addr]; ok {
+		return &statedb.Account{CodeHash: crypto.Keccak256([]byte("code"))}
+	}
+	return f.Keeper.GetAccountWithoutBalance(ctx, addr)
+}
+
+func (f fakeEVMKeeper) CallEVM(
+	_ sdk.Context, _ abi.ABI, _, contract common.Address, _ bool, method string, _ ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	ret, err := erc20types.OwnableABI.Methods[method].Outputs.Pack(f.owners[contract])
+	if err != nil {
+		return nil, err
+	}
+	return &evmtypes.MsgEthereumTxResponse{Ret: ret}, nil
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.Evmos
+	keeper      keeper.Keeper
+	queryClient types.QueryClient
+	evmKeeper   fakeEVMKeeper
+	denom       string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	chainID := utils.TestnetChainID + "-1"
+	suite.app = app.Setup(false, nil, chainID)
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), chainID, sdk.ConsAddress{}, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+
+	suite.evmKeeper = fakeEVMKeeper{
+		Keeper: suite.app.EvmKeeper,
+		owners: make(map[common.Address]common.Address),
+	}
+	suite.keeper = keeper.NewKeeper(
+		suite.app.GetKey(types.StoreKey), suite.app.AppCodec(), authtypes.NewModuleAddress(govtypes.ModuleName),
+		suite.app.BankKeeper, suite.evmKeeper,
+	)
+	suite.denom = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.keeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+// fund mints the given amount of the EVM denomination to the account.
+func (suite *KeeperTestSuite) fund(addr sdk.AccAddress, amount int64) {
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, amount)))
+	suite.Require().NoError(err)
+}
+
+// maxGasPrice is the maximum gas price of the sponsorships registered in the tests
+const maxGasPrice = 1000
+
+// registerSponsorship registers the sponsorship of a new Ownable contract owned
+// by the given account and funded with the given deposit. The sponsored gas
+// price is capped at maxGasPrice.
+func (suite *KeeperTestSuite) registerSponsorship(
+	owner sdk.AccAddress, selectors []string, dailyCap, deposit int64,
+) common.Address {
+	contract := utiltx.GenerateAddress()
+	suite.evmKeeper.owners[contract] = common.BytesToAddress(owner)
+	suite.fund(owner, deposit)
+
+	msg := types.NewMsgRegisterSponsorship(owner, contract, selectors, sdk.NewInt(dailyCap), sdk.NewInt(maxGasPrice), sdk.NewInt64Coin(suite.denom, deposit))
+	_, err := suite.keeper.RegisterSponsorship(suite.ctx, msg)
+	suite.Require().NoError(err)
+	return contract
+}
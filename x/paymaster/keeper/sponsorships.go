@@ -0,0 +1,131 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/evmos/evmos/v19/x/erc20/types"
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+// GetSponsorship returns the sponsorship of the given contract.
+func (k Keeper) GetSponsorship(ctx sdk.Context, contract common.Address) (types.Sponsorship, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.Sponsorship{}, false
+	}
+
+	var sponsorship types.Sponsorship
+	k.cdc.MustUnmarshal(bz, &sponsorship)
+	return sponsorship, true
+}
+
+// GetSponsorships returns all the registered sponsorships.
+func (k Keeper) GetSponsorships(ctx sdk.Context) []types.Sponsorship {
+	sponsorships := []types.Sponsorship{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sponsorship types.Sponsorship
+		k.cdc.MustUnmarshal(iterator.Value(), &sponsorship)
+		sponsorships = append(sponsorships, sponsorship)
+	}
+
+	return sponsorships
+}
+
+// SetSponsorship stores a sponsorship.
+func (k Keeper) SetSponsorship(ctx sdk.Context, sponsorship types.Sponsorship) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	bz := k.cdc.MustMarshal(&sponsorship)
+	store.Set(sponsorship.GetContract().Bytes(), bz)
+}
+
+// DeleteSponsorship removes the sponsorship of the given contract along with
+// the usages of its senders.
+func (k Keeper) DeleteSponsorship(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	store.Delete(contract.Bytes())
+
+	usageStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SponsorshipUsagePrefix(contract))
+	iterator := usageStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		usageStore.Delete(key)
+	}
+}
+
+// GetSponsorshipUsage returns the fees sponsored for the sender by the
+// sponsorship of the given contract.
+func (k Keeper) GetSponsorshipUsage(ctx sdk.Context, contract, sender common.Address) (types.SponsorshipUsage, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SponsorshipUsageKey(contract, sender))
+	if len(bz) == 0 {
+		return types.SponsorshipUsage{}, false
+	}
+
+	var usage types.SponsorshipUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage, true
+}
+
+// GetSponsorshipUsages returns the usages of all the sponsorships.
+func (k Keeper) GetSponsorshipUsages(ctx sdk.Context) []types.SponsorshipUsage {
+	usages := []types.SponsorshipUsage{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorshipUsage)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.SponsorshipUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		usages = append(usages, usage)
+	}
+
+	return usages
+}
+
+// SetSponsorshipUsage stores the usage of a sponsorship by a sender.
+func (k Keeper) SetSponsorshipUsage(ctx sdk.Context, usage types.SponsorshipUsage) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&usage)
+	store.Set(types.SponsorshipUsageKey(usage.GetContract(), usage.GetSenderAddress()), bz)
+}
+
+// GetContractOwner returns the address returned by the owner() method of the
+// given contract.
+func (k Keeper) GetContractOwner(ctx sdk.Context, contract common.Address) (common.Address, error) {
+	res, err := k.evmKeeper.CallEVM(ctx, erc20types.OwnableABI, types.ModuleAddress, contract, false, "owner")
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(
+			types.ErrNotContractOwner, "failed to query contract owner: %s", err.Error(),
+		)
+	}
+
+	unpacked, err := erc20types.OwnableABI.Unpack("owner", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return common.Address{}, errorsmod.Wrap(types.ErrNotContractOwner, "failed to unpack owner")
+	}
+
+	owner, ok := unpacked[0].(common.Address)
+	if !ok {
+		return common.Address{}, errorsmod.Wrap(types.ErrNotContractOwner, "invalid owner type")
+	}
+
+	return owner, nil
+}
@@ -0,0 +1,20 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrPaymasterDisabled         = errorsmod.Register(ModuleName, 2, "paymaster module is disabled")
+	ErrSponsorshipNotFound       = errorsmod.Register(ModuleName, 3, "sponsorship not found")
+	ErrSponsorshipAlreadyExists  = errorsmod.Register(ModuleName, 4, "sponsorship already exists")
+	ErrNotContractOwner          = errorsmod.Register(ModuleName, 5, "sender is not the contract owner")
+	ErrNotSponsorshipOwner       = errorsmod.Register(ModuleName, 6, "sender is not the sponsorship owner")
+	ErrInvalidMethodSelector     = errorsmod.Register(ModuleName, 7, "invalid method selector")
+	ErrTooManyMethodSelectors    = errorsmod.Register(ModuleName, 8, "too many method selectors")
+	ErrInvalidSponsorshipDeposit = errorsmod.Register(ModuleName, 9, "invalid sponsorship deposit")
+)
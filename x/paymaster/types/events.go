@@ -0,0 +1,19 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// paymaster events
+const (
+	EventTypeRegisterSponsorship   = "register_sponsorship"
+	EventTypeDepositSponsorship    = "deposit_sponsorship"
+	EventTypeDeregisterSponsorship = "deregister_sponsorship"
+	EventTypeSponsorFees           = "sponsor_fees"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyOwner    = "owner"
+	AttributeKeySponsor  = "sponsor"
+	AttributeKeyDeposit  = "deposit"
+	AttributeKeyRefund   = "refund"
+	AttributeKeyFee      = "fee"
+)
@@ -0,0 +1,66 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, sponsorships []Sponsorship, usages []SponsorshipUsage) GenesisState {
+	return GenesisState{
+		Params:       params,
+		Sponsorships: sponsorships,
+		Usages:       usages,
+	}
+}
+
+// DefaultGenesisState sets default paymaster genesis state with no
+// sponsorships and the default params.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenContract := make(map[string]bool)
+	for _, s := range gs.Sponsorships {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		contract := s.GetContract().Hex()
+		if seenContract[contract] {
+			return fmt.Errorf("sponsorship duplicated on genesis '%s'", s.ContractAddress)
+		}
+		if len(s.MethodSelectors) > int(gs.Params.MaxMethodSelectors) {
+			return fmt.Errorf("sponsorship '%s' exceeds the maximum number of method selectors", s.ContractAddress)
+		}
+		seenContract[contract] = true
+	}
+
+	seenUsage := make(map[string]bool)
+	for _, u := range gs.Usages {
+		if err := u.Validate(); err != nil {
+			return err
+		}
+
+		contract := u.GetContract().Hex()
+		if !seenContract[contract] {
+			return fmt.Errorf("usage of unregistered sponsorship '%s'", u.ContractAddress)
+		}
+
+		key := contract + u.GetSenderAddress().Hex()
+		if seenUsage[key] {
+			return fmt.Errorf("usage of sponsorship '%s' by '%s' duplicated on genesis", u.ContractAddress, u.Sender)
+		}
+		seenUsage[key] = true
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params on genesis: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	owner := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+	sender := utiltx.GenerateAddress()
+	sponsorship := types.NewSponsorship(contract, owner, []string{"0xa9059cbb"}, math.NewInt(100), math.NewInt(1000))
+	usage := types.NewSponsorshipUsage(contract, sender, 1, math.NewInt(10))
+
+	newGen := types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{sponsorship}, []types.SponsorshipUsage{usage})
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "valid genesis constructor",
+			genState: &newGen,
+			expPass:  true,
+		},
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			name: "invalid genesis - duplicated sponsorship",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Sponsorships: []types.Sponsorship{sponsorship, sponsorship},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid sponsorship",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Sponsorships: []types.Sponsorship{types.NewSponsorship(contract, owner, []string{"0x1234"}, math.ZeroInt(), math.NewInt(1000))},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - zero max gas price",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Sponsorships: []types.Sponsorship{types.NewSponsorship(contract, owner, nil, math.ZeroInt(), math.ZeroInt())},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - too many method selectors",
+			genState: &types.GenesisState{
+				Params:       types.NewParams(true, 0),
+				Sponsorships: []types.Sponsorship{sponsorship},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - usage of unregistered sponsorship",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Usages: []types.SponsorshipUsage{usage},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated usage",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Sponsorships: []types.Sponsorship{sponsorship},
+				Usages:       []types.SponsorshipUsage{usage, usage},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - negative usage",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Sponsorships: []types.Sponsorship{sponsorship},
+				Usages:       []types.SponsorshipUsage{types.NewSponsorshipUsage(contract, sender, 1, math.NewInt(-1))},
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "paymaster"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the native module address for the paymaster
+var ModuleAddress common.Address
+
+func init() {
+	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
+}
+
+// prefix bytes for the paymaster persistent store
+const (
+	prefixParams = iota + 1
+	prefixSponsorship
+	prefixSponsorshipUsage
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixParams           = []byte{prefixParams}
+	KeyPrefixSponsorship      = []byte{prefixSponsorship}
+	KeyPrefixSponsorshipUsage = []byte{prefixSponsorshipUsage}
+)
+
+// SponsorshipUsagePrefix returns the prefix to iterate over the usages of a
+// sponsorship.
+func SponsorshipUsagePrefix(contract common.Address) []byte {
+	return append(KeyPrefixSponsorshipUsage, contract.Bytes()...)
+}
+
+// SponsorshipUsageKey defines the key under which the usage of a sponsorship
+// by a sender is stored.
+func SponsorshipUsageKey(contract, sender common.Address) []byte {
+	return append(SponsorshipUsagePrefix(contract), sender.Bytes()...)
+}
@@ -0,0 +1,190 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v19/types"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterSponsorship{}
+	_ sdk.Msg = &MsgDepositSponsorship{}
+	_ sdk.Msg = &MsgDeregisterSponsorship{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+const (
+	TypeMsgRegisterSponsorship   = "register_sponsorship"
+	TypeMsgDepositSponsorship    = "deposit_sponsorship"
+	TypeMsgDeregisterSponsorship = "deregister_sponsorship"
+	TypeMsgUpdateParams          = "update_params"
+)
+
+// NewMsgRegisterSponsorship creates a new instance of MsgRegisterSponsorship
+func NewMsgRegisterSponsorship(
+	owner sdk.AccAddress,
+	contract common.Address,
+	methodSelectors []string,
+	dailyCap, maxGasPrice math.Int,
+	deposit sdk.Coin,
+) *MsgRegisterSponsorship { //nolint: interfacer
+	return &MsgRegisterSponsorship{
+		Owner:           owner.String(),
+		ContractAddress: contract.String(),
+		MethodSelectors: methodSelectors,
+		DailyCapPerUser: dailyCap,
+		MaxGasPrice:     maxGasPrice,
+		Deposit:         deposit,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterSponsorship) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterSponsorship) Type() string { return TypeMsgRegisterSponsorship }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+	if err := types.ValidateNonZeroAddress(msg.ContractAddress); err != nil {
+		return err
+	}
+	if err := ValidateMethodSelectors(msg.MethodSelectors); err != nil {
+		return err
+	}
+	if err := ValidateDailyCap(msg.DailyCapPerUser); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateMaxGasPrice(msg.MaxGasPrice); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	return validateDeposit(msg.Deposit)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterSponsorship) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgDepositSponsorship creates a new instance of MsgDepositSponsorship
+func NewMsgDepositSponsorship(owner sdk.AccAddress, contract common.Address, amount sdk.Coin) *MsgDepositSponsorship { //nolint: interfacer
+	return &MsgDepositSponsorship{
+		Owner:           owner.String(),
+		ContractAddress: contract.String(),
+		Amount:          amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDepositSponsorship) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDepositSponsorship) Type() string { return TypeMsgDepositSponsorship }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDepositSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+	if err := types.ValidateNonZeroAddress(msg.ContractAddress); err != nil {
+		return err
+	}
+	return validateDeposit(msg.Amount)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDepositSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDepositSponsorship) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgDeregisterSponsorship creates a new instance of MsgDeregisterSponsorship
+func NewMsgDeregisterSponsorship(owner sdk.AccAddress, contract common.Address) *MsgDeregisterSponsorship { //nolint: interfacer
+	return &MsgDeregisterSponsorship{
+		Owner:           owner.String(),
+		ContractAddress: contract.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDeregisterSponsorship) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDeregisterSponsorship) Type() string { return TypeMsgDeregisterSponsorship }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDeregisterSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+	return types.ValidateNonZeroAddress(msg.ContractAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeregisterSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeregisterSponsorship) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// Route returns the name of the module
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// validateDeposit checks that the deposited coin is valid and positive
+func validateDeposit(deposit sdk.Coin) error {
+	if err := deposit.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidSponsorshipDeposit, err.Error())
+	}
+	if !deposit.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidSponsorshipDeposit, "deposit must be positive: %s", deposit)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterSponsorshipGetters() {
+	msg := types.NewMsgRegisterSponsorship(
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		utiltx.GenerateAddress(),
+		nil,
+		math.ZeroInt(),
+		math.NewInt(1000),
+		sdk.NewInt64Coin("anxq", 1),
+	)
+	suite.Require().Equal(types.RouterKey, msg.Route())
+	suite.Require().Equal(types.TypeMsgRegisterSponsorship, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterSponsorship() {
+	owner := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	contract := utiltx.GenerateAddress().String()
+	deposit := sdk.NewInt64Coin("anxq", 1000)
+	maxGasPrice := math.NewInt(1000)
+
+	testCases := []struct {
+		msg        types.MsgRegisterSponsorship
+		expectPass bool
+	}{
+		{
+			types.MsgRegisterSponsorship{Owner: "invalid", ContractAddress: contract, DailyCapPerUser: math.ZeroInt(), MaxGasPrice: maxGasPrice, Deposit: deposit},
+			false,
+		},
+		{
+			types.MsgRegisterSponsorship{Owner: owner, ContractAddress: common.Address{}.String(), DailyCapPerUser: math.ZeroInt(), MaxGasPrice: maxGasPrice, Deposit: deposit},
+			false,
+		},
+		{
+			types.MsgRegisterSponsorship{Owner: owner, ContractAddress: contract, MethodSelectors: []string{"0xa9059c"}, DailyCapPerUser: math.ZeroInt(), MaxGasPrice: maxGasPrice, Deposit: deposit},
+			false,
+		},
+		{
+			types.MsgRegisterSponsorship{Owner: owner, ContractAddress: contract, MethodSelectors: []string{"0xa9059cbb", "0xA9059CBB"}, DailyCapPerUser: math.ZeroInt(), MaxGasPrice: maxGasPrice, Deposit: deposit},
+			false,
+		},
+		{
+			types.MsgRegisterSponsorship{Owner: owner, ContractAddress: contract, DailyCapPerUser: math.NewInt(-1), MaxGasPrice: maxGasPrice, Deposit: deposit},
+			false,
+		},
+		{
+			types.MsgRegisterSponsorship{Owner: owner, ContractAddress: contract, DailyCapPerUser: math.ZeroInt(), MaxGasPrice: maxGasPrice, Deposit: sdk.NewInt64Coin("anxq", 0)},
+			false,
+		},
+		{
+			types.MsgRegisterSponsorship{Owner: owner, ContractAddress: contract, DailyCapPerUser: math.ZeroInt(), Deposit: deposit},
+			false,
+		},
+		{
+			types.MsgRegisterSponsorship{Owner: owner, ContractAddress: contract, DailyCapPerUser: math.ZeroInt(), MaxGasPrice: math.ZeroInt(), Deposit: deposit},
+			false,
+		},
+		{
+			types.MsgRegisterSponsorship{Owner: owner, ContractAddress: contract, MethodSelectors: []string{"0xa9059cbb"}, DailyCapPerUser: math.NewInt(100), MaxGasPrice: maxGasPrice, Deposit: deposit},
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDepositSponsorship() {
+	owner := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		msg        *types.MsgDepositSponsorship
+		expectPass bool
+	}{
+		{&types.MsgDepositSponsorship{Owner: "invalid", ContractAddress: contract.String(), Amount: sdk.NewInt64Coin("anxq", 1)}, false},
+		{types.NewMsgDepositSponsorship(owner, common.Address{}, sdk.NewInt64Coin("anxq", 1)), false},
+		{types.NewMsgDepositSponsorship(owner, contract, sdk.NewInt64Coin("anxq", 0)), false},
+		{types.NewMsgDepositSponsorship(owner, contract, sdk.NewInt64Coin("anxq", 1)), true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDeregisterSponsorship() {
+	owner := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		msg        *types.MsgDeregisterSponsorship
+		expectPass bool
+	}{
+		{&types.MsgDeregisterSponsorship{Owner: "invalid", ContractAddress: contract.String()}, false},
+		{types.NewMsgDeregisterSponsorship(owner, common.Address{}), false},
+		{types.NewMsgDeregisterSponsorship(owner, contract), true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpdateParams{Authority: "invalid", Params: types.DefaultParams()},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.DefaultParams(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import "fmt"
+
+// DefaultMaxMethodSelectors defines the default maximum number of method
+// selectors of a sponsorship
+const DefaultMaxMethodSelectors uint32 = 32
+
+// NewParams creates a new Params object
+func NewParams(enablePaymaster bool, maxMethodSelectors uint32) Params {
+	return Params{
+		EnablePaymaster:    enablePaymaster,
+		MaxMethodSelectors: maxMethodSelectors,
+	}
+}
+
+// DefaultParams returns the default paymaster module parameters
+func DefaultParams() Params {
+	return Params{
+		EnablePaymaster:    true,
+		MaxMethodSelectors: DefaultMaxMethodSelectors,
+	}
+}
+
+// Validate performs a basic validation of the paymaster parameters
+func (p Params) Validate() error {
+	if err := validateBool(p.EnablePaymaster); err != nil {
+		return err
+	}
+	return validateUint32(p.MaxMethodSelectors)
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateUint32(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
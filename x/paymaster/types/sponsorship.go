@@ -0,0 +1,203 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v19/types"
+)
+
+// secondsPerDay is the length of the period covered by the daily caps
+const secondsPerDay = 24 * 60 * 60
+
+// NewSponsorship returns a new sponsorship of the given contract. The method
+// selectors are stored in lowercase.
+func NewSponsorship(
+	contract common.Address,
+	owner sdk.AccAddress,
+	methodSelectors []string,
+	dailyCap, maxGasPrice math.Int,
+) Sponsorship {
+	selectors := make([]string, len(methodSelectors))
+	for i, selector := range methodSelectors {
+		selectors[i] = strings.ToLower(selector)
+	}
+
+	return Sponsorship{
+		ContractAddress: contract.String(),
+		Owner:           owner.String(),
+		MethodSelectors: selectors,
+		DailyCapPerUser: dailyCap,
+		MaxGasPrice:     maxGasPrice,
+	}
+}
+
+// SponsorshipAccount returns the address of the account holding the deposit of
+// the sponsorship of the given contract. Nobody holds the key of the account,
+// its funds can only be spent on the sponsored fees or refunded to the owner.
+func SponsorshipAccount(contract common.Address) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/%s", ModuleName, contract.Hex()))
+}
+
+// GetContract returns the sponsored contract address
+func (s Sponsorship) GetContract() common.Address {
+	return common.HexToAddress(s.ContractAddress)
+}
+
+// GetAccount returns the address of the account holding the sponsorship deposit
+func (s Sponsorship) GetAccount() sdk.AccAddress {
+	return SponsorshipAccount(s.GetContract())
+}
+
+// Validate performs a stateless validation of the sponsorship
+func (s Sponsorship) Validate() error {
+	if err := types.ValidateNonZeroAddress(s.ContractAddress); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+	if err := ValidateMethodSelectors(s.MethodSelectors); err != nil {
+		return err
+	}
+	if err := ValidateDailyCap(s.DailyCapPerUser); err != nil {
+		return err
+	}
+	return ValidateMaxGasPrice(s.MaxGasPrice)
+}
+
+// IsMethodSponsored returns true if the call with the given input is covered
+// by the method selectors of the sponsorship.
+func (s Sponsorship) IsMethodSponsored(input []byte) bool {
+	if len(s.MethodSelectors) == 0 {
+		return true
+	}
+	if len(input) < 4 {
+		return false
+	}
+
+	selector := hexutil.Encode(input[:4])
+	for _, sponsored := range s.MethodSelectors {
+		if strings.EqualFold(sponsored, selector) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsGasPriceSponsored returns true if the effective gas price of a transaction
+// does not exceed the maximum gas price of the sponsorship.
+func (s Sponsorship) IsGasPriceSponsored(gasPrice math.Int) bool {
+	return !gasPrice.IsNil() && gasPrice.LTE(s.MaxGasPrice)
+}
+
+// HasDailyCap returns true if the fees sponsored per sender and day are limited
+func (s Sponsorship) HasDailyCap() bool {
+	return s.DailyCapPerUser.IsPositive()
+}
+
+// RemainingAllowance returns the fees that can still be sponsored for the
+// sender of the given usage on the given day.
+func (s Sponsorship) RemainingAllowance(usage SponsorshipUsage, day uint64) math.Int {
+	remaining := s.DailyCapPerUser.Sub(usage.AmountOnDay(day))
+	if remaining.IsNegative() {
+		return math.ZeroInt()
+	}
+	return remaining
+}
+
+// ValidateMethodSelectors checks that the selectors are unique hex encoded
+// 4-byte method selectors
+func ValidateMethodSelectors(selectors []string) error {
+	seen := make(map[string]bool, len(selectors))
+	for _, selector := range selectors {
+		bz, err := hexutil.Decode(selector)
+		if err != nil || len(bz) != 4 {
+			return errorsmod.Wrapf(ErrInvalidMethodSelector, "'%s' is not a hex encoded 4-byte selector", selector)
+		}
+
+		normalized := strings.ToLower(selector)
+		if seen[normalized] {
+			return errorsmod.Wrapf(ErrInvalidMethodSelector, "duplicate method selector %s", selector)
+		}
+		seen[normalized] = true
+	}
+	return nil
+}
+
+// ValidateDailyCap checks that the daily cap per user is not negative
+func ValidateDailyCap(dailyCap math.Int) error {
+	if dailyCap.IsNil() || dailyCap.IsNegative() {
+		return fmt.Errorf("daily cap per user cannot be nil or negative: %s", dailyCap)
+	}
+	return nil
+}
+
+// ValidateMaxGasPrice checks that the maximum sponsored gas price is positive
+func ValidateMaxGasPrice(maxGasPrice math.Int) error {
+	if maxGasPrice.IsNil() || !maxGasPrice.IsPositive() {
+		return fmt.Errorf("max gas price must be positive: %s", maxGasPrice)
+	}
+	return nil
+}
+
+// UsageDay returns the number of days elapsed since the unix epoch at the given
+// time. The daily caps are reset when the day changes.
+func UsageDay(t time.Time) uint64 {
+	return uint64(t.Unix() / secondsPerDay) // #nosec G701 -- block times are after the unix epoch
+}
+
+// NewSponsorshipUsage returns a new usage of the sponsorship of the given
+// contract by the sender.
+func NewSponsorshipUsage(contract, sender common.Address, day uint64, amount math.Int) SponsorshipUsage {
+	return SponsorshipUsage{
+		ContractAddress: contract.String(),
+		Sender:          sender.String(),
+		Day:             day,
+		Amount:          amount,
+	}
+}
+
+// GetContract returns the sponsored contract address
+func (u SponsorshipUsage) GetContract() common.Address {
+	return common.HexToAddress(u.ContractAddress)
+}
+
+// GetSenderAddress returns the address of the sponsored sender
+func (u SponsorshipUsage) GetSenderAddress() common.Address {
+	return common.HexToAddress(u.Sender)
+}
+
+// AmountOnDay returns the fees sponsored on the given day. The usage of a
+// previous day does not count towards the daily cap.
+func (u SponsorshipUsage) AmountOnDay(day uint64) math.Int {
+	if u.Day != day || u.Amount.IsNil() {
+		return math.ZeroInt()
+	}
+	return u.Amount
+}
+
+// Validate performs a stateless validation of the sponsorship usage
+func (u SponsorshipUsage) Validate() error {
+	if err := types.ValidateNonZeroAddress(u.ContractAddress); err != nil {
+		return err
+	}
+	if err := types.ValidateAddress(u.Sender); err != nil {
+		return err
+	}
+	if u.Amount.IsNil() || u.Amount.IsNegative() {
+		return fmt.Errorf("sponsored amount cannot be nil or negative: %s", u.Amount)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v19/testutil/tx"
+	"github.com/evmos/evmos/v19/x/paymaster/types"
+)
+
+func TestIsMethodSponsored(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+	owner := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	transfer := hexutil.MustDecode("0xa9059cbb0000000000000000000000000000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name      string
+		selectors []string
+		input     []byte
+		expPass   bool
+	}{
+		{"all methods sponsored", nil, transfer, true},
+		{"all methods sponsored - no input", nil, nil, true},
+		{"sponsored method", []string{"0x095ea7b3", "0xA9059CBB"}, transfer, true},
+		{"method not sponsored", []string{"0x095ea7b3"}, transfer, false},
+		{"input shorter than a selector", []string{"0xa9059cbb"}, transfer[:3], false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sponsorship := types.NewSponsorship(contract, owner, tc.selectors, math.ZeroInt(), math.NewInt(1000))
+			require.Equal(t, tc.expPass, sponsorship.IsMethodSponsored(tc.input))
+		})
+	}
+}
+
+func TestRemainingAllowance(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+	sender := utiltx.GenerateAddress()
+	sponsorship := types.NewSponsorship(contract, sdk.AccAddress(sender.Bytes()), nil, math.NewInt(100), math.NewInt(1000))
+	require.True(t, sponsorship.HasDailyCap())
+
+	usage := types.NewSponsorshipUsage(contract, sender, 10, math.NewInt(30))
+	require.Equal(t, math.NewInt(70), sponsorship.RemainingAllowance(usage, 10))
+	require.Equal(t, math.NewInt(100), sponsorship.RemainingAllowance(usage, 11))
+	require.Equal(t, math.NewInt(100), sponsorship.RemainingAllowance(types.SponsorshipUsage{}, 10))
+
+	usage.Amount = math.NewInt(150)
+	require.True(t, sponsorship.RemainingAllowance(usage, 10).IsZero())
+
+	sponsorship.DailyCapPerUser = math.ZeroInt()
+	require.False(t, sponsorship.HasDailyCap())
+}
+
+func TestIsGasPriceSponsored(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+	owner := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	sponsorship := types.NewSponsorship(contract, owner, nil, math.ZeroInt(), math.NewInt(1000))
+
+	require.True(t, sponsorship.IsGasPriceSponsored(math.NewInt(999)))
+	require.True(t, sponsorship.IsGasPriceSponsored(math.NewInt(1000)))
+	require.False(t, sponsorship.IsGasPriceSponsored(math.NewInt(1001)))
+	require.False(t, sponsorship.IsGasPriceSponsored(math.Int{}))
+}
+
+func TestUsageDay(t *testing.T) {
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, types.UsageDay(day), types.UsageDay(day.Add(24*time.Hour-time.Nanosecond)))
+	require.Equal(t, types.UsageDay(day)+1, types.UsageDay(day.Add(24*time.Hour)))
+}
+
+func TestSponsorshipAccount(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+	require.Len(t, types.SponsorshipAccount(contract), 20)
+	require.Equal(t, types.SponsorshipAccount(contract), types.SponsorshipAccount(contract))
+	require.NotEqual(t, types.SponsorshipAccount(contract), types.SponsorshipAccount(utiltx.GenerateAddress()))
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	evmtypes "github.com/evmos/evmos/v19/x/evm/types"
+	stakingtypes "github.com/evmos/evmos/v19/x/staking/types"
 	vestingtypes "github.com/evmos/evmos/v19/x/vesting/types"
 	"golang.org/x/crypto/sha3"
 )
@@ -88,7 +89,7 @@ func (k msgServer) customValidatorChecks(ctx sdk.Context, msg *types.MsgCreateVa
 	valEvmAddr := common.BytesToAddress(valAccAddr)
 
 	// Check if the validator is approved (with simplified error handling)
-	isApproved, err := k.isApprovedValidator(ctx, valEvmAddr)
+	isApproved, err := k.IsApprovedValidator(ctx, valEvmAddr)
 	if err != nil {
 		log.Printf("WARNING: Failed to check if validator is approved: %v, assuming not approved", err)
 		return errorsmod.Wrap(err, "failed to check if validator is approved")
@@ -100,16 +101,10 @@ func (k msgServer) customValidatorChecks(ctx sdk.Context, msg *types.MsgCreateVa
 	}
 
 	// Get validator requirements (with fallback)
-	validatorApprovalContract := common.HexToAddress(evmParams.ValidatorApprovalContractAddress)
-	requiredNXQTokens, requiredNXQNFTs, err := k.getValidatorRequirements(ctx, validatorApprovalContract)
-	if err != nil {
-
-		requiredNXQTokens = big.NewInt(5_000_000_000_000_000_000) // Default: 5 NXQ with 18 decimals
-		requiredNXQNFTs = big.NewInt(5)                           // Default: 5 NFT
-	}
+	requiredNXQTokens, requiredNXQNFTs := k.ValidatorRequirements(ctx)
 
 	// Get NFT balance
-	nftBalance, err := k.getNFTBalance(ctx, nftContract, valEvmAddr)
+	nftBalance, err := k.GetNFTBalance(ctx, nftContract, valEvmAddr)
 	if err != nil {
 
 		return errorsmod.Wrap(
@@ -247,9 +242,24 @@ func getFunctionSelector(signature string) []byte {
 	return selector
 }
 
-// getValidatorRequirements queries the ValidatorApproval contract to get the required number of
+// ValidatorRequirements returns the minimum self delegation and the number of
+// NXQNFT's required to become a validator. It falls back to the default
+// requirements if the ValidatorApproval contract cannot be queried.
+func (k Keeper) ValidatorRequirements(ctx sdk.Context) (*big.Int, *big.Int) {
+	evmParams := k.evmKeeper.GetParams(ctx)
+	validatorApprovalContract := common.HexToAddress(evmParams.ValidatorApprovalContractAddress)
+
+	requiredNXQTokens, requiredNXQNFTs, err := k.GetValidatorRequirements(ctx, validatorApprovalContract)
+	if err != nil {
+		return new(big.Int).Set(stakingtypes.DefaultRequiredSelfDelegation), new(big.Int).Set(stakingtypes.DefaultRequiredNFTs)
+	}
+
+	return requiredNXQTokens, requiredNXQNFTs
+}
+
+// GetValidatorRequirements queries the ValidatorApproval contract to get the required number of
 // NXQ tokens and NXQNFT's to become a validator
-func (k msgServer) getValidatorRequirements(ctx sdk.Context, contractAddr common.Address) (*big.Int, *big.Int, error) {
+func (k Keeper) GetValidatorRequirements(ctx sdk.Context, contractAddr common.Address) (*big.Int, *big.Int, error) {
 
 	// Calculate the function selector using the getFunctionSelector function
 	functionSignature := "getValidatorRequirements()"
@@ -309,9 +319,9 @@ func (k msgServer) getValidatorRequirements(ctx sdk.Context, contractAddr common
 	return requiredNXQTokens, requiredNXQNFTs, nil
 }
 
-// getNFTBalance queries the NFT balance using direct EthCall approach
+// GetNFTBalance queries the NFT balance using direct EthCall approach
 // This is the proper way to query EVM contracts
-func (k msgServer) getNFTBalance(ctx sdk.Context, contractAddr, ownerAddr common.Address) (*big.Int, error) {
+func (k Keeper) GetNFTBalance(ctx sdk.Context, contractAddr, ownerAddr common.Address) (*big.Int, error) {
 	log.Printf("Querying NFT balance using EthCall for address: %s", ownerAddr.Hex())
 
 	// balanceOf function signature
@@ -371,8 +381,8 @@ type EvmEthCaller interface {
 	EthCall(ctx sdk.Context, req *evmtypes.EthCallRequest) (*evmtypes.MsgEthereumTxResponse, error)
 }
 
-// isApprovedValidator checks if an address is on the approved validators list
-func (k msgServer) isApprovedValidator(ctx sdk.Context, validatorAddr common.Address) (bool, error) {
+// IsApprovedValidator checks if an address is on the approved validators list
+func (k Keeper) IsApprovedValidator(ctx sdk.Context, validatorAddr common.Address) (bool, error) {
 	// Get EVM params for contract addresses
 	evmParams := k.evmKeeper.GetParams(ctx)
 
@@ -1,9 +1,21 @@
 package types
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 )
 
+var (
+	// DefaultRequiredSelfDelegation is the minimum self delegation (5 NXQ with 18 decimals)
+	// required to become a validator when the ValidatorApproval contract cannot be queried
+	DefaultRequiredSelfDelegation = big.NewInt(5_000_000_000_000_000_000)
+
+	// DefaultRequiredNFTs is the number of NXQNFT's required to become a validator when
+	// the ValidatorApproval contract cannot be queried
+	DefaultRequiredNFTs = big.NewInt(5)
+)
+
 // ContractConfig defines configuration for smart contract addresses used in staking module
 type ContractConfig struct {
 	// NFTContractAddress is the address of the NFT contract that validators must own tokens from
@@ -6,6 +6,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetVestingScheduleCmd(),
+		GetVestingProjectionCmd(),
 	)
 	return cmd
 }
@@ -62,3 +65,75 @@ func GetBalancesCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetVestingScheduleCmd queries the lockup and vesting schedule of a given vesting account.
+func GetVestingScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule ADDRESS",
+		Short: "Gets the lockup and vesting periods of a vesting account",
+		Long:  "Gets the funder, start time and the lockup and vesting periods with their absolute start and end times for a vesting account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVestingScheduleRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.VestingSchedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetVestingProjectionCmd queries the locked, unvested, vested and delegatable tokens
+// of a given vesting account at a given time.
+func GetVestingProjectionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projection ADDRESS [UNIX_TIME]",
+		Short: "Gets locked, unvested, vested and delegatable tokens for a vesting account at a given time",
+		Long:  "Gets locked, unvested, vested and delegatable tokens for a vesting account at the given unix time in seconds. The current block time is used if no time is provided.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVestingProjectionRequest{
+				Address: args[0],
+			}
+
+			if len(args) == 2 {
+				req.AtTime, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid unix time %s: %w", args[1], err)
+				}
+			}
+
+			res, err := queryClient.VestingProjection(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -29,6 +29,8 @@ const (
 	FlagVesting  = "vesting"
 	FlagClawback = "clawback"
 	FlagFunder   = "funder"
+
+	FlagEnableGovClawback = "enable-gov-clawback"
 )
 
 // NewTxCmd returns a root CLI command handler for vesting
@@ -48,6 +50,7 @@ func NewTxCmd() *cobra.Command {
 		NewMsgClawbackCmd(),
 		NewMsgUpdateVestingFunderCmd(),
 		NewMsgConvertVestingAccountCmd(),
+		NewMsgBatchFundVestingAccountsCmd(),
 	)
 
 	return txCmd
@@ -166,6 +169,74 @@ with a start time and an array of coins strings and durations relative to the st
 	return cmd
 }
 
+// NewMsgBatchFundVestingAccountsCmd returns a CLI command handler for creating and
+// funding multiple clawback vesting accounts in a single transaction.
+func NewMsgBatchFundVestingAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-fund FILE",
+		Short: "Create and fund multiple vesting accounts with allocations of tokens.",
+		Long: `Fund the vesting accounts described in FILE from the --from address in a single transaction.
+Accounts that don't exist yet are created as clawback vesting accounts with the --from address as funder,
+existing accounts must already be clawback vesting accounts funded by the --from address.
+All grants are validated before any account is funded, so either every grant is funded or none.
+Governance clawback is enabled on the created accounts through --enable-gov-clawback.
+
+Files with a .csv extension contain one grant per line with the columns
+  address,coins,start_time,lockup_seconds,vesting_periods,vesting_period_seconds
+where the coins are locked up until lockup_seconds after start_time and vest in vesting_periods
+equal periods of vesting_period_seconds each. Any other file is read as JSON, where each grant
+defines its lockup and vesting periods as in the fund-vesting-account period files.`,
+		Example: fmt.Sprintf(`$ %[1]s tx vesting batch-fund grants.csv --from=<key_or_address>
+
+Sample CSV file contents:
+address,coins,start_time,lockup_seconds,vesting_periods,vesting_period_seconds
+nxq1...,1000unxq,1625204910,31536000,4,7884000
+
+Sample JSON file contents:
+{
+  "grants": [
+    {
+      "vesting_address": "nxq1...",
+      "start_time": 1625204910,
+      "lockup_periods": [{"coins": "1000unxq", "length_seconds": 31536000}],
+      "vesting_periods": [
+        {"coins": "500unxq", "length_seconds": 15768000},
+        {"coins": "500unxq", "length_seconds": 15768000}
+      ]
+    }
+  ]
+}`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grants, err := ReadBatchFundFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			enableGovClawback, err := cmd.Flags().GetBool(FlagEnableGovClawback)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchFundVestingAccounts(clientCtx.GetFromAddress(), grants, enableGovClawback)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagEnableGovClawback, false, "enable governance clawback on the created vesting accounts")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewMsgClawbackCmd returns a CLI command handler for clawing back unvested funds.
 func NewMsgClawbackCmd() *cobra.Command {
 	cmd := &cobra.Command{
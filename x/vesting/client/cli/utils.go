@@ -4,13 +4,20 @@
 package cli
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/evmos/evmos/v19/x/vesting/types"
 )
 
 type VestingData struct {
@@ -23,6 +30,25 @@ type InputPeriod struct {
 	Length int64  `json:"length_seconds"`
 }
 
+// BatchFundData is the content of a JSON batch funding file.
+type BatchFundData struct {
+	Grants []InputGrant `json:"grants"`
+}
+
+// InputGrant is a grant of a JSON batch funding file. The periods follow the
+// same format as the periods of a schedule file.
+type InputGrant struct {
+	VestingAddress string        `json:"vesting_address"`
+	StartTime      int64         `json:"start_time"`
+	LockupPeriods  []InputPeriod `json:"lockup_periods"`
+	VestingPeriods []InputPeriod `json:"vesting_periods"`
+}
+
+// batchFundCSVColumns are the columns of a CSV batch funding file.
+var batchFundCSVColumns = []string{
+	"address", "coins", "start_time", "lockup_seconds", "vesting_periods", "vesting_period_seconds",
+}
+
 // readScheduleFile reads the file at path and unmarshals it to get the schedule.
 // Returns start time, periods, and error.
 func ReadScheduleFile(path string) (int64, sdkvesting.Periods, error) {
@@ -37,22 +63,171 @@ func ReadScheduleFile(path string) (int64, sdkvesting.Periods, error) {
 		return 0, nil, err
 	}
 
-	startTime := data.StartTime
-	periods := make(sdkvesting.Periods, 0, len(data.Periods))
+	periods, err := parseInputPeriods(data.Periods)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return data.StartTime, periods, nil
+}
+
+// ReadBatchFundFile reads the grants of a batch funding file. Files with a
+// .csv extension are parsed as CSV, any other file is parsed as JSON.
+func ReadBatchFundFile(path string) ([]types.VestingGrant, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return parseBatchFundCSV(file)
+	}
+
+	return parseBatchFundJSON(file)
+}
+
+// parseBatchFundJSON parses the grants of a JSON batch funding file.
+func parseBatchFundJSON(r io.Reader) ([]types.VestingGrant, error) {
+	var data BatchFundData
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	grants := make([]types.VestingGrant, 0, len(data.Grants))
+	for i, g := range data.Grants {
+		lockupPeriods, err := parseInputPeriods(g.LockupPeriods)
+		if err != nil {
+			return nil, fmt.Errorf("grant %d: invalid lockup periods: %w", i, err)
+		}
+
+		vestingPeriods, err := parseInputPeriods(g.VestingPeriods)
+		if err != nil {
+			return nil, fmt.Errorf("grant %d: invalid vesting periods: %w", i, err)
+		}
+
+		grants = append(grants, types.VestingGrant{
+			VestingAddress: g.VestingAddress,
+			StartTime:      time.Unix(g.StartTime, 0),
+			LockupPeriods:  lockupPeriods,
+			VestingPeriods: vestingPeriods,
+		})
+	}
+
+	return grants, nil
+}
+
+// parseBatchFundCSV parses the grants of a CSV batch funding file. Each record
+// describes a grant of the given coins that is locked up until lockup_seconds
+// after start_time and vests in vesting_periods equal periods of
+// vesting_period_seconds each. A header row is skipped if present.
+func parseBatchFundCSV(r io.Reader) ([]types.VestingGrant, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(batchFundCSVColumns)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) > 0 && records[0][0] == batchFundCSVColumns[0] {
+		records = records[1:]
+	}
+
+	grants := make([]types.VestingGrant, 0, len(records))
+	for i, record := range records {
+		grant, err := parseBatchFundCSVRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i, err)
+		}
+		grants = append(grants, grant)
+	}
+
+	return grants, nil
+}
+
+// parseBatchFundCSVRecord parses a single CSV record into a grant.
+func parseBatchFundCSVRecord(record []string) (types.VestingGrant, error) {
+	coins, err := sdk.ParseCoinsNormalized(record[1])
+	if err != nil {
+		return types.VestingGrant{}, err
+	}
+
+	values := make([]int64, 4)
+	for i := range values {
+		column := batchFundCSVColumns[i+2]
+		values[i], err = strconv.ParseInt(record[i+2], 10, 64)
+		if err != nil {
+			return types.VestingGrant{}, fmt.Errorf("invalid %s: %w", column, err)
+		}
+		if values[i] < 0 {
+			return types.VestingGrant{}, fmt.Errorf("invalid %s: %d cannot be negative", column, values[i])
+		}
+	}
+	startTime, lockupLength, numVestingPeriods, vestingLength := values[0], values[1], values[2], values[3]
+
+	var lockupPeriods sdkvesting.Periods
+	if lockupLength > 0 {
+		lockupPeriods = sdkvesting.Periods{{Length: lockupLength, Amount: coins}}
+	}
+
+	var vestingPeriods sdkvesting.Periods
+	if numVestingPeriods > 0 {
+		vestingPeriods = splitCoinsIntoPeriods(coins, numVestingPeriods, vestingLength)
+	}
+
+	return types.VestingGrant{
+		VestingAddress: record[0],
+		StartTime:      time.Unix(startTime, 0),
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}, nil
+}
+
+// splitCoinsIntoPeriods splits the coins into n periods of the given length.
+// The remainder of the division is added to the last period.
+func splitCoinsIntoPeriods(coins sdk.Coins, n, length int64) sdkvesting.Periods {
+	periods := make(sdkvesting.Periods, n)
+	for i := range periods {
+		periods[i].Length = length
+	}
+
+	for _, coin := range coins {
+		perPeriod := coin.Amount.QuoRaw(n)
+		remainder := coin.Amount.Sub(perPeriod.MulRaw(n))
+
+		for i := range periods {
+			amount := perPeriod
+			if int64(i) == n-1 {
+				amount = amount.Add(remainder)
+			}
+			if amount.IsPositive() {
+				periods[i].Amount = periods[i].Amount.Add(sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+	}
+
+	return periods
+}
+
+// parseInputPeriods converts the periods of an input file into vesting periods.
+func parseInputPeriods(inputPeriods []InputPeriod) (sdkvesting.Periods, error) {
+	periods := make(sdkvesting.Periods, 0, len(inputPeriods))
 
-	for i, p := range data.Periods {
+	for i, p := range inputPeriods {
 		if p.Length < 1 {
-			return 0, nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+			return nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
 		}
 
 		amount, err := sdk.ParseCoinsNormalized(p.Coins)
 		if err != nil {
-			return 0, nil, err
+			return nil, err
 		}
 
 		period := sdkvesting.Period{Length: p.Length, Amount: amount}
 		periods = append(periods, period)
 	}
 
-	return startTime, periods, nil
+	return periods, nil
 }
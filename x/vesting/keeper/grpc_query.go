@@ -5,6 +5,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
@@ -25,19 +26,11 @@ func (k Keeper) Balances(
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	addr, err := sdk.AccAddressFromBech32(req.Address)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	clawbackAccount, err := k.GetClawbackVestingAccount(ctx, addr)
+	clawbackAccount, err := k.queryClawbackVestingAccount(ctx, req.Address)
 	if err != nil {
-		return nil, status.Errorf(
-			codes.InvalidArgument,
-			"account at address '%s' either does not exist or is not a vesting account ", addr.String(),
-		)
+		return nil, err
 	}
 
 	locked := clawbackAccount.GetLockedUpCoins(ctx.BlockTime())
@@ -50,3 +43,102 @@ func (k Keeper) Balances(
 		Vested:   vested,
 	}, nil
 }
+
+// VestingSchedule returns the funder, start time and the lockup and vesting
+// periods with their absolute start and end times for a clawback vesting
+// account
+func (k Keeper) VestingSchedule(
+	goCtx context.Context,
+	req *types.QueryVestingScheduleRequest,
+) (*types.QueryVestingScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clawbackAccount, err := k.queryClawbackVestingAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := clawbackAccount.GetStartTime()
+
+	return &types.QueryVestingScheduleResponse{
+		FunderAddress:   clawbackAccount.FunderAddress,
+		StartTime:       time.Unix(startTime, 0).UTC(),
+		EndTime:         time.Unix(clawbackAccount.GetEndTime(), 0).UTC(),
+		OriginalVesting: clawbackAccount.OriginalVesting,
+		LockupPeriods:   types.NewSchedulePeriods(startTime, clawbackAccount.LockupPeriods),
+		VestingPeriods:  types.NewSchedulePeriods(startTime, clawbackAccount.VestingPeriods),
+	}, nil
+}
+
+// VestingProjection returns the locked, unvested, vested and delegatable
+// amount of tokens for a clawback vesting account at the requested time. The
+// current block time is used if no time is provided.
+func (k Keeper) VestingProjection(
+	goCtx context.Context,
+	req *types.QueryVestingProjectionRequest,
+) (*types.QueryVestingProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.AtTime < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid projection time %d", req.AtTime)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clawbackAccount, err := k.queryClawbackVestingAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	atTime := ctx.BlockTime().UTC()
+	if req.AtTime != 0 {
+		atTime = time.Unix(req.AtTime, 0).UTC()
+	}
+
+	unvested := clawbackAccount.GetVestingCoins(atTime)
+
+	// the same rule as the delegation check of the staking module is applied
+	// on the current balance: the free and vested coins can be delegated,
+	// regardless of their lockup, while the unvested coins cannot
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	balance := k.bankKeeper.GetBalance(ctx, clawbackAccount.GetAddress(), bondDenom)
+	delegatableAmt := balance.Amount.Sub(unvested.AmountOf(bondDenom))
+	delegatable := sdk.NewCoins()
+	if delegatableAmt.IsPositive() {
+		delegatable = sdk.NewCoins(sdk.NewCoin(bondDenom, delegatableAmt))
+	}
+
+	return &types.QueryVestingProjectionResponse{
+		AtTime:      atTime,
+		Locked:      clawbackAccount.GetLockedUpCoins(atTime),
+		Unvested:    unvested,
+		Vested:      clawbackAccount.GetVestedCoins(atTime),
+		Delegatable: delegatable,
+	}, nil
+}
+
+// queryClawbackVestingAccount returns the clawback vesting account for the
+// given bech32 address or a gRPC error if the account is not a clawback
+// vesting account.
+func (k Keeper) queryClawbackVestingAccount(ctx sdk.Context, address string) (*types.ClawbackVestingAccount, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	clawbackAccount, err := k.GetClawbackVestingAccount(ctx, addr)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"account at address '%s' either does not exist or is not a vesting account ", addr.String(),
+		)
+	}
+
+	return clawbackAccount, nil
+}
@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/evmos/evmos/v19/testutil"
 	"github.com/evmos/evmos/v19/x/vesting/types"
@@ -133,3 +136,271 @@ func (suite *KeeperTestSuite) TestBalances() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestVestingSchedule() {
+	var (
+		req    *types.QueryVestingScheduleRequest
+		expRes *types.QueryVestingScheduleResponse
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			name: "empty req",
+			malleate: func() {
+				req = &types.QueryVestingScheduleRequest{}
+			},
+			expPass:     false,
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name: "invalid account - not found",
+			malleate: func() {
+				req = &types.QueryVestingScheduleRequest{
+					Address: vestingAddr.String(),
+				}
+			},
+			expPass:     false,
+			errContains: "either does not exist or is not a vesting account",
+		},
+		{
+			name: "valid",
+			malleate: func() {
+				vestingStart := suite.ctx.BlockTime().UTC()
+				suite.setupClawbackVestingAccount(vestingStart, lockupPeriods, vestingPeriods)
+
+				req = &types.QueryVestingScheduleRequest{
+					Address: vestingAddr.String(),
+				}
+				expRes = &types.QueryVestingScheduleResponse{
+					FunderAddress:   funder.String(),
+					StartTime:       time.Unix(vestingStart.Unix(), 0).UTC(),
+					EndTime:         time.Unix(vestingStart.Unix()+8000, 0).UTC(),
+					OriginalVesting: balances,
+					LockupPeriods: []types.SchedulePeriod{
+						{
+							StartTime: time.Unix(vestingStart.Unix(), 0).UTC(),
+							EndTime:   time.Unix(vestingStart.Unix()+5000, 0).UTC(),
+							Length:    5000,
+							Amount:    balances,
+						},
+					},
+					VestingPeriods: []types.SchedulePeriod{
+						{
+							StartTime: time.Unix(vestingStart.Unix(), 0).UTC(),
+							EndTime:   time.Unix(vestingStart.Unix()+2000, 0).UTC(),
+							Length:    2000,
+							Amount:    quarter,
+						},
+						{
+							StartTime: time.Unix(vestingStart.Unix()+2000, 0).UTC(),
+							EndTime:   time.Unix(vestingStart.Unix()+4000, 0).UTC(),
+							Length:    2000,
+							Amount:    quarter,
+						},
+						{
+							StartTime: time.Unix(vestingStart.Unix()+4000, 0).UTC(),
+							EndTime:   time.Unix(vestingStart.Unix()+6000, 0).UTC(),
+							Length:    2000,
+							Amount:    quarter,
+						},
+						{
+							StartTime: time.Unix(vestingStart.Unix()+6000, 0).UTC(),
+							EndTime:   time.Unix(vestingStart.Unix()+8000, 0).UTC(),
+							Length:    2000,
+							Amount:    quarter,
+						},
+					},
+				}
+			},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.Require().NoError(suite.SetupTest()) // reset
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+			suite.Commit()
+
+			res, err := suite.queryClient.VestingSchedule(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVestingProjection() {
+	var vestingStart time.Time
+
+	// the schedule is defined in the bond denom, so that it can be delegated
+	suite.Require().NoError(suite.SetupTest())
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	bondBalances := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, vestAmount))
+	bondQuarter := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 250))
+	free := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))
+	delegated := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 60))
+	bondLockupPeriods := sdkvesting.Periods{{Length: 5000, Amount: bondBalances}}
+	bondVestingPeriods := sdkvesting.Periods{
+		{Length: 2000, Amount: bondQuarter},
+		{Length: 2000, Amount: bondQuarter},
+		{Length: 2000, Amount: bondQuarter},
+		{Length: 2000, Amount: bondQuarter},
+	}
+
+	fundFreeCoins := func() {
+		err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, vestingAddr, free)
+		suite.Require().NoError(err)
+	}
+	delegateFreeCoins := func() {
+		fundFreeCoins()
+		_, err := suite.app.StakingKeeper.Delegate(
+			suite.ctx, vestingAddr, delegated.AmountOf(bondDenom), stakingtypes.Unbonded, suite.validator, true,
+		)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name           string
+		atTime         func() int64
+		malleate       func()
+		nonBondDenom   bool
+		expLocked      sdk.Coins
+		expUnvested    sdk.Coins
+		expDelegatable sdk.Coins
+		expPass        bool
+		errContains    string
+	}{
+		{
+			name:        "invalid - negative time",
+			atTime:      func() int64 { return -1 },
+			malleate:    func() {},
+			expPass:     false,
+			errContains: "invalid projection time",
+		},
+		{
+			name:           "valid - current block time",
+			atTime:         func() int64 { return 0 },
+			malleate:       func() {},
+			expLocked:      bondBalances,
+			expUnvested:    bondBalances,
+			expDelegatable: sdk.NewCoins(),
+			expPass:        true,
+		},
+		{
+			name:           "valid - first vesting period passed, still locked",
+			atTime:         func() int64 { return vestingStart.Unix() + 2000 },
+			malleate:       func() {},
+			expLocked:      bondBalances,
+			expUnvested:    bondBalances.Sub(bondQuarter...),
+			expDelegatable: bondQuarter,
+			expPass:        true,
+		},
+		{
+			name:           "valid - lockup passed, partially vested",
+			atTime:         func() int64 { return vestingStart.Unix() + 5000 },
+			malleate:       func() {},
+			expLocked:      sdk.NewCoins(),
+			expUnvested:    bondQuarter.Add(bondQuarter...),
+			expDelegatable: bondQuarter.Add(bondQuarter...),
+			expPass:        true,
+		},
+		{
+			name:           "valid - schedule ended",
+			atTime:         func() int64 { return vestingStart.Unix() + 10000 },
+			malleate:       func() {},
+			expLocked:      sdk.NewCoins(),
+			expUnvested:    sdk.NewCoins(),
+			expDelegatable: bondBalances,
+			expPass:        true,
+		},
+		{
+			name:           "valid - free coins outside of the schedule",
+			atTime:         func() int64 { return 0 },
+			malleate:       fundFreeCoins,
+			expLocked:      bondBalances,
+			expUnvested:    bondBalances,
+			expDelegatable: free,
+			expPass:        true,
+		},
+		{
+			name:           "valid - delegated coins are not delegatable",
+			atTime:         func() int64 { return vestingStart.Unix() + 10000 },
+			malleate:       delegateFreeCoins,
+			expLocked:      sdk.NewCoins(),
+			expUnvested:    sdk.NewCoins(),
+			expDelegatable: bondBalances.Add(free...).Sub(delegated...),
+			expPass:        true,
+		},
+		{
+			name:           "valid - schedule in a non bond denom",
+			atTime:         func() int64 { return vestingStart.Unix() + 10000 },
+			malleate:       func() {},
+			nonBondDenom:   true,
+			expLocked:      sdk.NewCoins(),
+			expUnvested:    sdk.NewCoins(),
+			expDelegatable: sdk.NewCoins(),
+			expPass:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.Require().NoError(suite.SetupTest()) // reset
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			vestingStart = suite.ctx.BlockTime()
+			if tc.nonBondDenom {
+				suite.setupClawbackVestingAccount(vestingStart, lockupPeriods, vestingPeriods)
+			} else {
+				suite.setupClawbackVestingAccount(vestingStart, bondLockupPeriods, bondVestingPeriods)
+			}
+			tc.malleate()
+			suite.Commit()
+
+			res, err := suite.queryClient.VestingProjection(ctx, &types.QueryVestingProjectionRequest{
+				Address: vestingAddr.String(),
+				AtTime:  tc.atTime(),
+			})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(tc.expLocked.IsEqual(res.Locked), "expected locked %s, got %s", tc.expLocked, res.Locked)
+				suite.Require().True(tc.expUnvested.IsEqual(res.Unvested), "expected unvested %s, got %s", tc.expUnvested, res.Unvested)
+				suite.Require().True(tc.expDelegatable.IsEqual(res.Delegatable), "expected delegatable %s, got %s", tc.expDelegatable, res.Delegatable)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+// setupClawbackVestingAccount creates the vesting account and funds it with
+// the given lockup and vesting schedule starting at vestingStart
+func (suite *KeeperTestSuite) setupClawbackVestingAccount(vestingStart time.Time, lockup, vesting sdkvesting.Periods) {
+	total := lockup.TotalAmount()
+
+	// fund the vesting account with coins to initialize it and
+	// then send all balances to the funding account
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, vestingAddr, total)
+	suite.Require().NoError(err, "error while funding the target account")
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, vestingAddr, funder, total)
+	suite.Require().NoError(err, "error while sending coins to the funder account")
+
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, false)
+	_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err, "error while creating the vesting account")
+
+	msgFund := types.NewMsgFundVestingAccount(funder, vestingAddr, vestingStart, lockup, vesting)
+	_, err = suite.app.VestingKeeper.FundVestingAccount(sdk.WrapSDKContext(suite.ctx), msgFund)
+	suite.Require().NoError(err, "error while funding the vesting account")
+}
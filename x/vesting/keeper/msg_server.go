@@ -5,6 +5,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -77,17 +78,7 @@ func (k Keeper) CreateClawbackVestingAccount(
 		)
 	}
 
-	baseAcc := ethAcc.GetBaseAccount()
-	baseVestingAcc := &sdkvesting.BaseVestingAccount{BaseAccount: baseAcc}
-	vestingAcc := &types.ClawbackVestingAccount{
-		BaseVestingAccount: baseVestingAcc,
-		FunderAddress:      funderAddress.String(),
-	}
-	ak.SetAccount(ctx, vestingAcc)
-
-	if !msg.EnableGovClawback {
-		k.SetGovClawbackDisabled(ctx, vestingAcc.GetAddress())
-	}
+	k.initClawbackVestingAccount(ctx, ethAcc, funderAddress, msg.EnableGovClawback)
 
 	telemetry.IncrCounter(
 		float32(ctx.GasMeter().GasConsumed()),
@@ -118,59 +109,12 @@ func (k Keeper) CreateClawbackVestingAccount(
 //   - both vesting and lockup periods describe the same total amount
 func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVestingAccount) (*types.MsgFundVestingAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	ak := k.accountKeeper
-	bk := k.bankKeeper
-
-	// Error checked during msg validation
-	funderAddr := sdk.MustAccAddressFromBech32(msg.FunderAddress)
-	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
-
-	if bk.BlockedAddr(vestingAddr) {
-		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
-			"%s is not allowed to receive funds", msg.VestingAddress,
-		)
-	}
 
-	// Check if vesting account exists
-	vestingAcc, err := k.GetClawbackVestingAccount(ctx, vestingAddr)
+	vestingCoins, err := k.fundVestingAccount(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	vestingCoins := msg.VestingPeriods.TotalAmount()
-	lockupCoins := msg.LockupPeriods.TotalAmount()
-
-	// If lockup absent, default to an instant unlock schedule
-	if !vestingCoins.IsZero() && len(msg.LockupPeriods) == 0 {
-		msg.LockupPeriods = sdkvesting.Periods{
-			{Length: 0, Amount: vestingCoins},
-		}
-		lockupCoins = vestingCoins
-	}
-
-	// If vesting absent, default to an instant vesting schedule
-	if !lockupCoins.IsZero() && len(msg.VestingPeriods) == 0 {
-		msg.VestingPeriods = sdkvesting.Periods{
-			{Length: 0, Amount: lockupCoins},
-		}
-		vestingCoins = lockupCoins
-	}
-
-	if msg.FunderAddress != vestingAcc.FunderAddress {
-		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s can only accept grants from account %s", msg.VestingAddress, vestingAcc.FunderAddress)
-	}
-
-	err = k.addGrant(ctx, vestingAcc, msg.GetStartTime().Unix(), msg.GetLockupPeriods(), msg.GetVestingPeriods(), vestingCoins)
-	if err != nil {
-		return nil, err
-	}
-	ak.SetAccount(ctx, vestingAcc)
-
-	// Send coins from the funder to vesting account
-	if err = bk.SendCoins(ctx, funderAddr, vestingAddr, vestingCoins); err != nil {
-		return nil, err
-	}
-
 	telemetry.IncrCounter(
 		float32(ctx.GasMeter().GasConsumed()),
 		"tx", "fund_vesting_account", "gas_used",
@@ -190,6 +134,112 @@ func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVest
 	return &types.MsgFundVestingAccountResponse{}, nil
 }
 
+// BatchFundVestingAccounts creates the ClawbackVestingAccounts that don't exist
+// yet and funds every vesting account with its grant. All grants are validated
+// before any state change so the batch either funds every account or fails as
+// a whole.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder address is correct bech32 format
+//   - the number of grants is between 1 and MaxBatchFundGrants
+//   - every grant passes the MsgFundVestingAccount stateless checks
+//   - each vesting address is funded only once
+func (k Keeper) BatchFundVestingAccounts(
+	goCtx context.Context,
+	msg *types.MsgBatchFundVestingAccounts,
+) (*types.MsgBatchFundVestingAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := k.accountKeeper
+	bk := k.bankKeeper
+
+	// Error checked during msg validation
+	funderAddr := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+
+	// validate all the grants against the current state before creating or
+	// funding any account
+	totalCoins := sdk.NewCoins()
+	for i, grant := range msg.Grants {
+		vestingAddr := sdk.MustAccAddressFromBech32(grant.VestingAddress)
+
+		if bk.BlockedAddr(vestingAddr) {
+			return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+				"grant %d: %s is not allowed to receive funds", i, grant.VestingAddress,
+			)
+		}
+
+		if acc := ak.GetAccount(ctx, vestingAddr); acc != nil {
+			// existing accounts can only be converted by their owner, so they
+			// need to be clawback vesting accounts of the same funder
+			vestingAcc, isClawback := acc.(*types.ClawbackVestingAccount)
+			if !isClawback {
+				return nil, errorsmod.Wrapf(types.ErrNotSubjectToClawback,
+					"grant %d: account %s already exists and is not a clawback vesting account", i, grant.VestingAddress,
+				)
+			}
+
+			if vestingAcc.FunderAddress != msg.FunderAddress {
+				return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+					"grant %d: account %s can only accept grants from account %s", i, grant.VestingAddress, vestingAcc.FunderAddress,
+				)
+			}
+		}
+
+		totalCoins = totalCoins.Add(grant.LockupPeriods.TotalAmount()...)
+		if len(grant.LockupPeriods) == 0 {
+			totalCoins = totalCoins.Add(grant.VestingPeriods.TotalAmount()...)
+		}
+	}
+
+	if spendable := bk.SpendableCoins(ctx, funderAddr); !spendable.IsAllGTE(totalCoins) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInsufficientFunds,
+			"funder %s has %s spendable, batch requires %s", msg.FunderAddress, spendable, totalCoins,
+		)
+	}
+
+	createdAccounts := make([]string, 0, len(msg.Grants))
+	events := make(sdk.Events, 0, len(msg.Grants))
+
+	for _, grant := range msg.Grants {
+		vestingAddr := sdk.MustAccAddressFromBech32(grant.VestingAddress)
+
+		created := false
+		if ak.GetAccount(ctx, vestingAddr) == nil {
+			ethAcc, ok := ak.NewAccountWithAddress(ctx, vestingAddr).(*evmostypes.EthAccount)
+			if !ok {
+				return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+					"account %s is not an Ethereum account", grant.VestingAddress,
+				)
+			}
+
+			k.initClawbackVestingAccount(ctx, ethAcc, funderAddr, msg.EnableGovClawback)
+			createdAccounts = append(createdAccounts, grant.VestingAddress)
+			created = true
+		}
+
+		vestingCoins, err := k.fundVestingAccount(ctx, grant.ToMsgFundVestingAccount(funderAddr))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to fund vesting account %s", grant.VestingAddress)
+		}
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeBatchFundVestingAccount,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, grant.VestingAddress),
+			sdk.NewAttribute(types.AttributeKeyCoins, vestingCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, grant.StartTime.String()),
+			sdk.NewAttribute(types.AttributeKeyCreated, strconv.FormatBool(created)),
+		))
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "batch_fund_vesting_accounts", "gas_used",
+	)
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgBatchFundVestingAccountsResponse{CreatedAccounts: createdAccounts}, nil
+}
+
 // Clawback removes the unvested amount from a ClawbackVestingAccount.
 // The destination defaults to the funder address, but can be overridden.
 //
@@ -440,6 +490,87 @@ func (k Keeper) addGrant(
 	return nil
 }
 
+// initClawbackVestingAccount converts the given Ethereum account into an
+// unfunded ClawbackVestingAccount with the given funder.
+func (k Keeper) initClawbackVestingAccount(
+	ctx sdk.Context,
+	ethAcc *evmostypes.EthAccount,
+	funder sdk.AccAddress,
+	enableGovClawback bool,
+) {
+	baseAcc := ethAcc.GetBaseAccount()
+	baseVestingAcc := &sdkvesting.BaseVestingAccount{BaseAccount: baseAcc}
+	vestingAcc := &types.ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+	}
+	k.accountKeeper.SetAccount(ctx, vestingAcc)
+
+	if !enableGovClawback {
+		k.SetGovClawbackDisabled(ctx, vestingAcc.GetAddress())
+	}
+}
+
+// fundVestingAccount adds the grant described by the message to the
+// ClawbackVestingAccount and transfers the granted coins from the funder. It
+// returns the granted coins.
+func (k Keeper) fundVestingAccount(ctx sdk.Context, msg *types.MsgFundVestingAccount) (sdk.Coins, error) {
+	ak := k.accountKeeper
+	bk := k.bankKeeper
+
+	// Error checked during msg validation
+	funderAddr := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	if bk.BlockedAddr(vestingAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"%s is not allowed to receive funds", msg.VestingAddress,
+		)
+	}
+
+	// Check if vesting account exists
+	vestingAcc, err := k.GetClawbackVestingAccount(ctx, vestingAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingCoins := msg.VestingPeriods.TotalAmount()
+	lockupCoins := msg.LockupPeriods.TotalAmount()
+
+	// If lockup absent, default to an instant unlock schedule
+	if !vestingCoins.IsZero() && len(msg.LockupPeriods) == 0 {
+		msg.LockupPeriods = sdkvesting.Periods{
+			{Length: 0, Amount: vestingCoins},
+		}
+		lockupCoins = vestingCoins
+	}
+
+	// If vesting absent, default to an instant vesting schedule
+	if !lockupCoins.IsZero() && len(msg.VestingPeriods) == 0 {
+		msg.VestingPeriods = sdkvesting.Periods{
+			{Length: 0, Amount: lockupCoins},
+		}
+		vestingCoins = lockupCoins
+	}
+
+	if msg.FunderAddress != vestingAcc.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s can only accept grants from account %s", msg.VestingAddress, vestingAcc.FunderAddress)
+	}
+
+	err = k.addGrant(ctx, vestingAcc, msg.GetStartTime().Unix(), msg.GetLockupPeriods(), msg.GetVestingPeriods(), vestingCoins)
+	if err != nil {
+		return nil, err
+	}
+	ak.SetAccount(ctx, vestingAcc)
+
+	// Send coins from the funder to vesting account
+	if err = bk.SendCoins(ctx, funderAddr, vestingAddr, vestingCoins); err != nil {
+		return nil, err
+	}
+
+	return vestingCoins, nil
+}
+
 // transferClawback transfers unvested tokens in a ClawbackVestingAccount to
 // the destination address. Then, it updates the lockup schedule, removes future
 // vesting events and deletes the store entry for governance clawback if it exists.
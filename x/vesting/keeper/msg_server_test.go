@@ -742,3 +742,164 @@ func (suite *KeeperTestSuite) TestConvertVestingAccount() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgBatchFundVestingAccounts() {
+	var (
+		newAddr1 sdk.AccAddress
+		newAddr2 sdk.AccAddress
+	)
+
+	newGrant := func(addr sdk.AccAddress) types.VestingGrant {
+		return types.VestingGrant{
+			VestingAddress: addr.String(),
+			StartTime:      time.Now(),
+			LockupPeriods:  lockupPeriods,
+			VestingPeriods: vestingPeriods,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func() []types.VestingGrant
+		// funderBalance is the amount funded to the funder before the test case
+		funderBalance sdk.Coins
+		expCreated    func() []string
+		expPass       bool
+		errContains   string
+	}{
+		{
+			name: "pass - create and fund new accounts",
+			malleate: func() []types.VestingGrant {
+				return []types.VestingGrant{newGrant(newAddr1), newGrant(newAddr2)}
+			},
+			funderBalance: balances.Add(balances...),
+			expCreated:    func() []string { return []string{newAddr1.String(), newAddr2.String()} },
+			expPass:       true,
+		},
+		{
+			name: "pass - fund existing clawback vesting account and create new account",
+			malleate: func() []types.VestingGrant {
+				suite.createClawbackVestingAccount(funder, newAddr1)
+				return []types.VestingGrant{newGrant(newAddr1), newGrant(newAddr2)}
+			},
+			funderBalance: balances.Add(balances...),
+			expCreated:    func() []string { return []string{newAddr2.String()} },
+			expPass:       true,
+		},
+		{
+			name: "pass - only vesting schedule",
+			malleate: func() []types.VestingGrant {
+				grant := newGrant(newAddr1)
+				grant.LockupPeriods = nil
+				return []types.VestingGrant{grant}
+			},
+			funderBalance: balances,
+			expCreated:    func() []string { return []string{newAddr1.String()} },
+			expPass:       true,
+		},
+		{
+			name: "fail - existing account is not a clawback vesting account",
+			malleate: func() []types.VestingGrant {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, newAddr2, balances)
+				suite.Require().NoError(err)
+				return []types.VestingGrant{newGrant(newAddr1), newGrant(newAddr2)}
+			},
+			funderBalance: balances.Add(balances...),
+			expPass:       false,
+			errContains:   "already exists and is not a clawback vesting account",
+		},
+		{
+			name: "fail - existing clawback vesting account with different funder",
+			malleate: func() []types.VestingGrant {
+				suite.createClawbackVestingAccount(addr3, newAddr2)
+				return []types.VestingGrant{newGrant(newAddr1), newGrant(newAddr2)}
+			},
+			funderBalance: balances.Add(balances...),
+			expPass:       false,
+			errContains:   "can only accept grants from account",
+		},
+		{
+			name: "fail - blocked address",
+			malleate: func() []types.VestingGrant {
+				return []types.VestingGrant{newGrant(newAddr1), newGrant(authtypes.NewModuleAddress("transfer"))}
+			},
+			funderBalance: balances.Add(balances...),
+			expPass:       false,
+			errContains:   "is not allowed to receive funds",
+		},
+		{
+			name: "fail - insufficient funds for the whole batch",
+			malleate: func() []types.VestingGrant {
+				return []types.VestingGrant{newGrant(newAddr1), newGrant(newAddr2)}
+			},
+			funderBalance: balances,
+			expPass:       false,
+			errContains:   "batch requires",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.Require().NoError(suite.SetupTest()) // Reset
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			newAddr1 = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			newAddr2 = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, tc.funderBalance)
+			suite.Require().NoError(err, "failed to fund funder account")
+
+			grants := tc.malleate()
+			msg := types.NewMsgBatchFundVestingAccounts(funder, grants, false)
+			suite.Require().NoError(msg.ValidateBasic())
+
+			res, err := suite.app.VestingKeeper.BatchFundVestingAccounts(ctx, msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(tc.expCreated(), res.CreatedAccounts)
+
+				for _, addr := range []string{newAddr1.String(), newAddr2.String()}[:len(grants)] {
+					vestingAddr := sdk.MustAccAddressFromBech32(addr)
+					accI := suite.app.AccountKeeper.GetAccount(suite.ctx, vestingAddr)
+					suite.Require().NotNil(accI)
+					suite.Require().IsType(&types.ClawbackVestingAccount{}, accI)
+					suite.Require().Equal(balances, accI.(*types.ClawbackVestingAccount).OriginalVesting)
+					suite.Require().Equal(balances, suite.app.BankKeeper.GetAllBalances(suite.ctx, vestingAddr))
+					suite.Require().True(suite.app.VestingKeeper.HasGovClawbackDisabled(suite.ctx, vestingAddr))
+				}
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, funder).IsZero())
+
+				var batchEvents int
+				for _, event := range suite.ctx.EventManager().Events() {
+					if event.Type == types.EventTypeBatchFundVestingAccount {
+						batchEvents++
+					}
+				}
+				suite.Require().Equal(len(grants), batchEvents, "expected one event per recipient")
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().ErrorContains(err, tc.errContains)
+
+				// no account is created or funded if any grant is invalid
+				suite.Require().Nil(suite.app.AccountKeeper.GetAccount(suite.ctx, newAddr1))
+				suite.Require().Equal(tc.funderBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, funder))
+			}
+		})
+	}
+}
+
+// createClawbackVestingAccount initializes an unfunded clawback vesting account
+// at the given address with the given funder
+func (suite *KeeperTestSuite) createClawbackVestingAccount(funderAddr, vestingAddr sdk.AccAddress) {
+	// fund the account to set it in the account keeper and send the funds
+	// back so the balance is empty
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, vestingAddr, balances)
+	suite.Require().NoError(err, "failed to fund target account")
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, vestingAddr, addr4, balances)
+	suite.Require().NoError(err, "failed to send tokens out of target account")
+
+	msgCreate := types.NewMsgCreateClawbackVestingAccount(funderAddr, vestingAddr, false)
+	_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(sdk.WrapSDKContext(suite.ctx), msgCreate)
+	suite.Require().NoError(err, "failed to create clawback vesting account")
+}
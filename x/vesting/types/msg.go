@@ -20,6 +20,7 @@ var (
 	_ sdk.Msg = &MsgClawback{}
 	_ sdk.Msg = &MsgConvertVestingAccount{}
 	_ sdk.Msg = &MsgUpdateVestingFunder{}
+	_ sdk.Msg = &MsgBatchFundVestingAccounts{}
 )
 
 const (
@@ -28,8 +29,12 @@ const (
 	TypeMsgClawback                     = "clawback"
 	TypeMsgUpdateVestingFunder          = "update_vesting_funder"
 	TypeMsgConvertVestingAccount        = "convert_vesting_account"
+	TypeMsgBatchFundVestingAccounts     = "batch_fund_vesting_accounts"
 )
 
+// MaxBatchFundGrants is the maximum number of grants in a MsgBatchFundVestingAccounts
+const MaxBatchFundGrants = 1000
+
 // NewMsgCreateClawbackVestingAccount creates new instance of MsgCreateClawbackVestingAccount
 func NewMsgCreateClawbackVestingAccount(
 	funderAddr sdk.AccAddress,
@@ -303,3 +308,77 @@ func (msg MsgConvertVestingAccount) GetSigners() []sdk.AccAddress {
 	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
 	return []sdk.AccAddress{vesting}
 }
+
+// NewMsgBatchFundVestingAccounts creates new instance of MsgBatchFundVestingAccounts
+func NewMsgBatchFundVestingAccounts(
+	funderAddr sdk.AccAddress,
+	grants []VestingGrant,
+	enableGovClawback bool,
+) *MsgBatchFundVestingAccounts {
+	return &MsgBatchFundVestingAccounts{
+		FunderAddress:     funderAddr.String(),
+		Grants:            grants,
+		EnableGovClawback: enableGovClawback,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgBatchFundVestingAccounts) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgBatchFundVestingAccounts
+func (msg MsgBatchFundVestingAccounts) Type() string { return TypeMsgBatchFundVestingAccounts }
+
+// ValidateBasic runs stateless checks on the message. Every grant is validated
+// with the same checks as a MsgFundVestingAccount and each vesting address can
+// only be funded once per batch.
+func (msg MsgBatchFundVestingAccounts) ValidateBasic() error {
+	funderAddr, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if len(msg.Grants) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "grants cannot be empty")
+	}
+
+	if len(msg.Grants) > MaxBatchFundGrants {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "too many grants, got %d, max %d", len(msg.Grants), MaxBatchFundGrants)
+	}
+
+	seen := make(map[string]bool, len(msg.Grants))
+	for i, grant := range msg.Grants {
+		if err := grant.ToMsgFundVestingAccount(funderAddr).ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid grant %d", i)
+		}
+
+		if seen[grant.VestingAddress] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate vesting address %s in grant %d", grant.VestingAddress, i)
+		}
+		seen[grant.VestingAddress] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgBatchFundVestingAccounts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBatchFundVestingAccounts) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// ToMsgFundVestingAccount returns the MsgFundVestingAccount that funds the
+// grant from the given funder.
+func (g VestingGrant) ToMsgFundVestingAccount(funderAddr sdk.AccAddress) *MsgFundVestingAccount {
+	return &MsgFundVestingAccount{
+		FunderAddress:  funderAddr.String(),
+		VestingAddress: g.VestingAddress,
+		StartTime:      g.StartTime,
+		LockupPeriods:  g.LockupPeriods,
+		VestingPeriods: g.VestingPeriods,
+	}
+}
@@ -493,3 +493,125 @@ func (suite *MsgsTestSuite) TestMsgConvertVestingAccount() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgBatchFundVestingAccountsGetters() {
+	msgInvalid := types.MsgBatchFundVestingAccounts{}
+	msg := types.NewMsgBatchFundVestingAccounts(
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		[]types.VestingGrant{
+			{
+				VestingAddress: sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+				StartTime:      time.Unix(100200300, 0),
+				LockupPeriods:  sdkvesting.Periods{{Length: 200000, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 10000000)}}},
+				VestingPeriods: sdkvesting.Periods{{Length: 300000, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 10000000)}}},
+			},
+		},
+		false,
+	)
+	suite.Require().Equal(types.RouterKey, msg.Route())
+	suite.Require().Equal(types.TypeMsgBatchFundVestingAccounts, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgBatchFundVestingAccounts() {
+	vestingAddr := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	lockupPeriods := sdkvesting.Periods{{Length: 200000, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 10000000)}}}
+	vestingPeriods := sdkvesting.Periods{{Length: 300000, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 10000000)}}}
+
+	newGrant := func(addr string) types.VestingGrant {
+		return types.VestingGrant{
+			VestingAddress: addr,
+			StartTime:      time.Unix(100200300, 0),
+			LockupPeriods:  lockupPeriods,
+			VestingPeriods: vestingPeriods,
+		}
+	}
+
+	tooManyGrants := make([]types.VestingGrant, types.MaxBatchFundGrants+1)
+	for i := range tooManyGrants {
+		tooManyGrants[i] = newGrant(sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String())
+	}
+
+	testCases := []struct {
+		msg        string
+		funderAddr string
+		grants     []types.VestingGrant
+		expPass    bool
+	}{
+		{
+			"msg batch fund vesting accounts - invalid funder address",
+			"foo",
+			[]types.VestingGrant{newGrant(vestingAddr)},
+			false,
+		},
+		{
+			"msg batch fund vesting accounts - empty grants",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			[]types.VestingGrant{},
+			false,
+		},
+		{
+			"msg batch fund vesting accounts - too many grants",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			tooManyGrants,
+			false,
+		},
+		{
+			"msg batch fund vesting accounts - invalid vesting address",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			[]types.VestingGrant{newGrant(vestingAddr), newGrant("foo")},
+			false,
+		},
+		{
+			"msg batch fund vesting accounts - vesting address is zero address",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			[]types.VestingGrant{newGrant(sdk.AccAddress(zeroAddress).String())},
+			false,
+		},
+		{
+			"msg batch fund vesting accounts - schedules with different total coins",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			[]types.VestingGrant{
+				{
+					VestingAddress: vestingAddr,
+					StartTime:      time.Unix(100200300, 0),
+					LockupPeriods:  lockupPeriods,
+					VestingPeriods: sdkvesting.Periods{{Length: 300000, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 1)}}},
+				},
+			},
+			false,
+		},
+		{
+			"msg batch fund vesting accounts - duplicate vesting address",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			[]types.VestingGrant{newGrant(vestingAddr), newGrant(vestingAddr)},
+			false,
+		},
+		{
+			"msg batch fund vesting accounts - pass",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			[]types.VestingGrant{
+				newGrant(vestingAddr),
+				newGrant(sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tx := types.MsgBatchFundVestingAccounts{
+				FunderAddress: tc.funderAddr,
+				Grants:        tc.grants,
+			}
+			err := tx.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err, "failed to validate message")
+			} else {
+				suite.Require().Error(err, "expected message validation to fail")
+			}
+		})
+	}
+}
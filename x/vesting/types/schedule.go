@@ -4,6 +4,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
@@ -382,3 +384,22 @@ func AlignSchedules(
 
 	return startTime, endTime
 }
+
+// NewSchedulePeriods returns the periods of a schedule starting at startTime
+// with their absolute start and end times.
+func NewSchedulePeriods(startTime int64, periods sdkvesting.Periods) []SchedulePeriod {
+	schedulePeriods := make([]SchedulePeriod, len(periods))
+	elapsedTime := startTime
+
+	for i, period := range periods {
+		schedulePeriods[i] = SchedulePeriod{
+			StartTime: time.Unix(elapsedTime, 0).UTC(),
+			EndTime:   time.Unix(elapsedTime+period.Length, 0).UTC(),
+			Length:    period.Length,
+			Amount:    period.Amount,
+		}
+		elapsedTime += period.Length
+	}
+
+	return schedulePeriods
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -521,3 +522,47 @@ func (suite *ScheduleTestSuite) TestAlignSchedules() {
 		})
 	}
 }
+
+func (suite *ScheduleTestSuite) TestNewSchedulePeriods() {
+	testCases := []struct {
+		name      string
+		startTime int64
+		periods   sdkvesting.Periods
+		expEnds   []int64
+	}{
+		{
+			"empty periods",
+			100,
+			sdkvesting.Periods{},
+			[]int64{},
+		},
+		{
+			"single period",
+			100,
+			sdkvesting.Periods{period(3600, 50)},
+			[]int64{3700},
+		},
+		{
+			"multiple periods",
+			100,
+			sdkvesting.Periods{period(10, 50), period(30, 7), period(0, 3)},
+			[]int64{110, 140, 140},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			schedulePeriods := NewSchedulePeriods(tc.startTime, tc.periods)
+			suite.Require().Len(schedulePeriods, len(tc.expEnds))
+
+			start := tc.startTime
+			for i, p := range schedulePeriods {
+				suite.Require().Equal(time.Unix(start, 0).UTC(), p.StartTime)
+				suite.Require().Equal(time.Unix(tc.expEnds[i], 0).UTC(), p.EndTime)
+				suite.Require().Equal(tc.periods[i].Length, p.Length)
+				suite.Require().Equal(tc.periods[i].Amount, p.Amount)
+				start = tc.expEnds[i]
+			}
+		})
+	}
+}
@@ -564,6 +564,194 @@ func (m *MsgConvertVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertVestingAccountResponse proto.InternalMessageInfo
 
+// VestingGrant defines the lockup and vesting schedules of the tokens granted
+// to a vesting account in a MsgBatchFundVestingAccounts.
+type VestingGrant struct {
+	// vesting_address specifies the account that receives the funds
+	VestingAddress string `protobuf:"bytes,1,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// start_time defines the time at which the vesting period begins
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// lockup_periods defines the unlocking schedule relative to the start_time
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,3,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the vesting schedule relative to the start_time
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+}
+
+func (m *VestingGrant) Reset()         { *m = VestingGrant{} }
+func (m *VestingGrant) String() string { return proto.CompactTextString(m) }
+func (*VestingGrant) ProtoMessage()    {}
+func (*VestingGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{10}
+}
+func (m *VestingGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingGrant.Merge(m, src)
+}
+func (m *VestingGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingGrant proto.InternalMessageInfo
+
+func (m *VestingGrant) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *VestingGrant) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *VestingGrant) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+func (m *VestingGrant) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgBatchFundVestingAccounts defines a message that enables creating and
+// funding multiple clawback vesting accounts in a single transaction.
+type MsgBatchFundVestingAccounts struct {
+	// funder_address specifies the account that funds the vesting accounts
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// grants defines the vesting accounts to fund and their schedules
+	Grants []VestingGrant `protobuf:"bytes,2,rep,name=grants,proto3" json:"grants"`
+	// enable_gov_clawback specifies whether the governance module can clawback
+	// the vesting accounts created by this message
+	EnableGovClawback bool `protobuf:"varint,3,opt,name=enable_gov_clawback,json=enableGovClawback,proto3" json:"enable_gov_clawback,omitempty"`
+}
+
+func (m *MsgBatchFundVestingAccounts) Reset()         { *m = MsgBatchFundVestingAccounts{} }
+func (m *MsgBatchFundVestingAccounts) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchFundVestingAccounts) ProtoMessage()    {}
+func (*MsgBatchFundVestingAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{11}
+}
+func (m *MsgBatchFundVestingAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchFundVestingAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchFundVestingAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchFundVestingAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchFundVestingAccounts.Merge(m, src)
+}
+func (m *MsgBatchFundVestingAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchFundVestingAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchFundVestingAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchFundVestingAccounts proto.InternalMessageInfo
+
+func (m *MsgBatchFundVestingAccounts) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgBatchFundVestingAccounts) GetGrants() []VestingGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *MsgBatchFundVestingAccounts) GetEnableGovClawback() bool {
+	if m != nil {
+		return m.EnableGovClawback
+	}
+	return false
+}
+
+// MsgBatchFundVestingAccountsResponse defines the
+// MsgBatchFundVestingAccounts response type.
+type MsgBatchFundVestingAccountsResponse struct {
+	// created_accounts are the addresses of the vesting accounts created by the
+	// message
+	CreatedAccounts []string `protobuf:"bytes,1,rep,name=created_accounts,json=createdAccounts,proto3" json:"created_accounts,omitempty"`
+}
+
+func (m *MsgBatchFundVestingAccountsResponse) Reset()         { *m = MsgBatchFundVestingAccountsResponse{} }
+func (m *MsgBatchFundVestingAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchFundVestingAccountsResponse) ProtoMessage()    {}
+func (*MsgBatchFundVestingAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{12}
+}
+func (m *MsgBatchFundVestingAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchFundVestingAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchFundVestingAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchFundVestingAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchFundVestingAccountsResponse.Merge(m, src)
+}
+func (m *MsgBatchFundVestingAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchFundVestingAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchFundVestingAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchFundVestingAccountsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchFundVestingAccountsResponse) GetCreatedAccounts() []string {
+	if m != nil {
+		return m.CreatedAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccount")
 	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccountResponse")
@@ -575,65 +763,76 @@ func init() {
 	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "evmos.vesting.v2.MsgUpdateVestingFunderResponse")
 	proto.RegisterType((*MsgConvertVestingAccount)(nil), "evmos.vesting.v2.MsgConvertVestingAccount")
 	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "evmos.vesting.v2.MsgConvertVestingAccountResponse")
+	proto.RegisterType((*VestingGrant)(nil), "evmos.vesting.v2.VestingGrant")
+	proto.RegisterType((*MsgBatchFundVestingAccounts)(nil), "evmos.vesting.v2.MsgBatchFundVestingAccounts")
+	proto.RegisterType((*MsgBatchFundVestingAccountsResponse)(nil), "evmos.vesting.v2.MsgBatchFundVestingAccountsResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v2/tx.proto", fileDescriptor_a372bb0b868e4c86) }
 
 var fileDescriptor_a372bb0b868e4c86 = []byte{
-	// 848 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xbf, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0x9c, 0xb9, 0x53, 0x6e, 0xcc, 0x85, 0x63, 0xcd, 0x81, 0x6f, 0x75, 0xd9, 0x35, 0x16,
-	0x51, 0x7c, 0x26, 0xec, 0x9c, 0xcd, 0x09, 0x29, 0x27, 0x9a, 0xd8, 0x28, 0x54, 0x96, 0x90, 0x05,
-	0x14, 0x34, 0xd6, 0x78, 0x77, 0xb2, 0x59, 0xc5, 0xde, 0x59, 0x79, 0x66, 0xd7, 0xa6, 0x4d, 0x85,
-	0xa8, 0x22, 0x21, 0x7a, 0x28, 0x68, 0x40, 0x48, 0xf4, 0xfc, 0x01, 0x44, 0x54, 0x91, 0x68, 0xa0,
-	0x21, 0x28, 0x41, 0x82, 0x3f, 0x03, 0xcd, 0x8f, 0xdd, 0x04, 0x67, 0x48, 0x9c, 0x82, 0xab, 0xec,
-	0x9d, 0xf7, 0xbd, 0xf7, 0xbe, 0xf9, 0xde, 0x8f, 0x81, 0x0f, 0x49, 0x36, 0xa1, 0x0c, 0x65, 0x84,
-	0xf1, 0x28, 0x0e, 0x51, 0xd6, 0x41, 0x7c, 0xee, 0x25, 0x53, 0xca, 0xa9, 0x75, 0x5f, 0x9a, 0x3c,
-	0x6d, 0xf2, 0xb2, 0x8e, 0xed, 0xf8, 0x94, 0x09, 0xf4, 0x08, 0x33, 0x82, 0xb2, 0xf6, 0x88, 0x70,
-	0xdc, 0x46, 0x3e, 0x8d, 0x62, 0xe5, 0x61, 0xbf, 0xa6, 0xed, 0x13, 0x16, 0xa2, 0xac, 0x2d, 0x7e,
-	0xb4, 0xe1, 0x0d, 0x6d, 0x28, 0xd2, 0x68, 0xdf, 0x3c, 0xb6, 0x42, 0xbd, 0x12, 0xd2, 0x90, 0xca,
-	0xbf, 0x48, 0xfc, 0xd3, 0xa7, 0x8f, 0x42, 0x4a, 0xc3, 0x31, 0x41, 0x38, 0x89, 0x10, 0x8e, 0x63,
-	0xca, 0x31, 0x8f, 0x68, 0xcc, 0xb4, 0xd5, 0xd5, 0x56, 0xf9, 0x35, 0x4a, 0x77, 0x11, 0x8f, 0x26,
-	0x84, 0x71, 0x3c, 0x49, 0x14, 0xa0, 0xf1, 0x23, 0x80, 0x6e, 0x9f, 0x85, 0xbd, 0x29, 0xc1, 0x9c,
-	0xf4, 0xc6, 0x78, 0x36, 0xc2, 0xfe, 0xfe, 0xc7, 0x2a, 0xef, 0xb6, 0xef, 0xd3, 0x34, 0xe6, 0xd6,
-	0x3a, 0x5c, 0xdd, 0x4d, 0xe3, 0x80, 0x4c, 0x87, 0x38, 0x08, 0xa6, 0x84, 0xb1, 0x1a, 0xa8, 0x83,
-	0xe6, 0xdd, 0xc1, 0x3d, 0x75, 0xba, 0xad, 0x0e, 0xad, 0x0d, 0xf8, 0x92, 0x26, 0x5c, 0xe0, 0x6e,
-	0x49, 0xdc, 0xaa, 0x3e, 0xce, 0x81, 0x1e, 0xac, 0x92, 0x18, 0x8f, 0xc6, 0x64, 0x18, 0xd2, 0x6c,
-	0xe8, 0xeb, 0xa4, 0xb5, 0x72, 0x1d, 0x34, 0x57, 0x06, 0x2f, 0x2b, 0xd3, 0xfb, 0x34, 0xcb, 0xd9,
-	0x3c, 0xab, 0xfd, 0xfd, 0x95, 0x5b, 0x3a, 0xf8, 0xeb, 0x87, 0xd6, 0x62, 0xfc, 0xc6, 0x63, 0xb8,
-	0x71, 0x0d, 0xf9, 0x01, 0x61, 0x09, 0x8d, 0x19, 0x69, 0xfc, 0x56, 0x86, 0x0f, 0xfa, 0x2c, 0xdc,
-	0x49, 0xe3, 0xe0, 0x7f, 0xbe, 0x5e, 0x0f, 0x42, 0xc6, 0xf1, 0x94, 0x0f, 0x85, 0xd6, 0xf2, 0x56,
-	0x95, 0x8e, 0xed, 0xa9, 0x42, 0x78, 0x79, 0x21, 0xbc, 0x0f, 0xf3, 0x42, 0x74, 0x57, 0x8e, 0x7e,
-	0x77, 0x4b, 0x87, 0x27, 0x2e, 0x18, 0xdc, 0x95, 0x7e, 0xc2, 0x62, 0x7d, 0x06, 0xe0, 0xea, 0x98,
-	0xfa, 0xfb, 0x69, 0x32, 0x4c, 0xc8, 0x34, 0xa2, 0x01, 0xab, 0xbd, 0x50, 0x2f, 0x37, 0x2b, 0x1d,
-	0xc7, 0x53, 0xcd, 0x72, 0xde, 0x78, 0xaa, 0x59, 0xbc, 0x0f, 0x24, 0xac, 0xbb, 0x2d, 0xa2, 0x7d,
-	0x7b, 0xe2, 0x6e, 0x85, 0x11, 0xdf, 0x4b, 0x47, 0x9e, 0x4f, 0x27, 0x48, 0xb7, 0x97, 0xfa, 0x79,
-	0x8b, 0x05, 0xfb, 0x68, 0x8e, 0x70, 0xca, 0xf7, 0x8a, 0x86, 0xe3, 0x9f, 0x26, 0x84, 0xe9, 0x08,
-	0x6c, 0x70, 0x4f, 0x25, 0xd6, 0x9f, 0xd6, 0xe7, 0xe0, 0xfc, 0xe6, 0x39, 0x97, 0xdb, 0xcf, 0x8b,
-	0x4b, 0x2e, 0xae, 0xfe, 0x7e, 0x56, 0x15, 0x7d, 0xb0, 0x50, 0xaf, 0x86, 0x0b, 0xd7, 0x8c, 0xa5,
-	0x2d, 0x8a, 0xff, 0x25, 0x80, 0x15, 0xd1, 0x28, 0xba, 0x45, 0x6e, 0x50, 0x72, 0xac, 0x22, 0x2d,
-	0x96, 0x5c, 0x1f, 0xe7, 0xc0, 0xd7, 0xe1, 0x8b, 0x01, 0x61, 0xe7, 0xa8, 0xb2, 0x44, 0x55, 0xc4,
-	0x99, 0x86, 0x98, 0x89, 0xcf, 0x61, 0xf5, 0x02, 0xad, 0x9c, 0xae, 0x85, 0xe1, 0x6d, 0xb1, 0x36,
-	0x04, 0x2b, 0x21, 0xf3, 0xc3, 0x5c, 0x66, 0xb1, 0x58, 0x0a, 0x8d, 0x7b, 0x34, 0x8a, 0xbb, 0x4f,
-	0xb4, 0xc2, 0xcd, 0x2b, 0x15, 0x56, 0x92, 0x0a, 0x07, 0x36, 0x50, 0x91, 0x1b, 0xdf, 0x01, 0xf8,
-	0x6a, 0x9f, 0x85, 0x1f, 0x25, 0x01, 0xe6, 0x44, 0xab, 0xb6, 0x23, 0xc9, 0x2d, 0x2b, 0xce, 0x26,
-	0xb4, 0x62, 0x32, 0x1b, 0x2e, 0x40, 0x95, 0x3e, 0xf7, 0x63, 0x32, 0xdb, 0xb9, 0x6e, 0x7a, 0xca,
-	0xa6, 0xe9, 0x31, 0xeb, 0x54, 0x87, 0x8e, 0x99, 0x6c, 0x51, 0xe1, 0x1e, 0xac, 0x09, 0x25, 0x69,
-	0x9c, 0x91, 0x29, 0x5f, 0x18, 0x70, 0x43, 0x6e, 0x60, 0xca, 0xdd, 0x68, 0xc0, 0xfa, 0x7f, 0x05,
-	0xc9, 0x13, 0x75, 0x7e, 0xba, 0x03, 0xcb, 0x7d, 0x16, 0x5a, 0x3f, 0x03, 0xf8, 0xe8, 0xca, 0xad,
-	0xd9, 0xf6, 0x16, 0x1f, 0x08, 0xef, 0x9a, 0x5d, 0x65, 0x6f, 0xdd, 0xd8, 0xa5, 0xb8, 0xff, 0xbb,
-	0x07, 0xbf, 0xfc, 0xf9, 0xc5, 0xad, 0x77, 0xac, 0xa7, 0xc8, 0xf0, 0x62, 0x21, 0x5f, 0x86, 0x28,
-	0x56, 0xed, 0xb0, 0x90, 0x43, 0x73, 0xfd, 0x1a, 0x40, 0xcb, 0xb0, 0x19, 0x37, 0x8c, 0x7c, 0x2e,
-	0x03, 0x6d, 0xb4, 0x24, 0xb0, 0xa0, 0xdb, 0x96, 0x74, 0xdf, 0xb4, 0x1e, 0x1b, 0xe9, 0x8a, 0xea,
-	0x5f, 0xe2, 0x38, 0x83, 0x2b, 0xc5, 0xfc, 0xae, 0x99, 0x85, 0xd2, 0x66, 0x7b, 0xfd, 0x4a, 0x73,
-	0x41, 0x62, 0x5d, 0x92, 0x70, 0xad, 0x35, 0xb3, 0x66, 0x79, 0xb2, 0x6f, 0x00, 0xac, 0x9a, 0xe6,
-	0xa4, 0x69, 0xcc, 0x62, 0x40, 0xda, 0x4f, 0x96, 0x45, 0x16, 0xd4, 0x3a, 0x92, 0xda, 0xa6, 0xd5,
-	0x32, 0x52, 0x4b, 0xa5, 0x67, 0xa1, 0x90, 0x1a, 0x16, 0xeb, 0x7b, 0x00, 0x1f, 0x98, 0x07, 0xa0,
-	0x65, 0xd6, 0xc3, 0x84, 0xb5, 0x3b, 0xcb, 0x63, 0x0b, 0xb6, 0x4f, 0x25, 0x5b, 0xcf, 0xda, 0x34,
-	0x0b, 0xa9, 0x7c, 0x17, 0x0b, 0xda, 0x7d, 0xef, 0xe8, 0xd4, 0x01, 0xc7, 0xa7, 0x0e, 0xf8, 0xe3,
-	0xd4, 0x01, 0x87, 0x67, 0x4e, 0xe9, 0xf8, 0xcc, 0x29, 0xfd, 0x7a, 0xe6, 0x94, 0x3e, 0x69, 0x5d,
-	0xd8, 0x66, 0x2a, 0xa2, 0x8e, 0xdb, 0xde, 0x42, 0xf3, 0x7f, 0x3f, 0x14, 0xa3, 0x3b, 0xf2, 0x45,
-	0x7d, 0xfb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x80, 0x49, 0xc6, 0xaa, 0x09, 0x00, 0x00,
+	// 976 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc4, 0x69, 0x95, 0x4c, 0xda, 0x34, 0x6c, 0x28, 0xb8, 0x4b, 0xb3, 0x36, 0x86, 0x28,
+	0x4e, 0x48, 0x77, 0x62, 0x53, 0x10, 0xa9, 0x7a, 0x89, 0x8d, 0xd2, 0x93, 0xa5, 0xca, 0x02, 0x0e,
+	0x5c, 0x56, 0xe3, 0xdd, 0xe9, 0xc6, 0x4a, 0xbc, 0x63, 0x79, 0xc6, 0x9b, 0x70, 0xad, 0x38, 0x20,
+	0x4e, 0x95, 0x80, 0x3b, 0x1c, 0xb8, 0x80, 0x90, 0x38, 0xc3, 0x1f, 0x40, 0xc5, 0xa9, 0x12, 0x17,
+	0xb8, 0x50, 0x94, 0x20, 0xc1, 0x9f, 0x81, 0xe6, 0xc7, 0x4e, 0x82, 0x3d, 0x71, 0x6c, 0x89, 0x1f,
+	0x3d, 0xc5, 0x3b, 0xef, 0x7b, 0xef, 0x7d, 0xf3, 0xbd, 0x37, 0xef, 0x05, 0xde, 0x20, 0x69, 0x97,
+	0x32, 0x94, 0x12, 0xc6, 0x3b, 0x49, 0x8c, 0xd2, 0x1a, 0xe2, 0x47, 0x7e, 0xaf, 0x4f, 0x39, 0x75,
+	0x96, 0xa4, 0xc9, 0xd7, 0x26, 0x3f, 0xad, 0xb9, 0x5e, 0x48, 0x99, 0x40, 0xb7, 0x31, 0x23, 0x28,
+	0xad, 0xb6, 0x09, 0xc7, 0x55, 0x14, 0xd2, 0x4e, 0xa2, 0x3c, 0xdc, 0x17, 0xb5, 0xbd, 0xcb, 0x62,
+	0x94, 0x56, 0xc5, 0x1f, 0x6d, 0x78, 0x55, 0x1b, 0x4c, 0x1a, 0xed, 0x9b, 0xc5, 0x56, 0xa8, 0xe7,
+	0x63, 0x1a, 0x53, 0xf9, 0x13, 0x89, 0x5f, 0xfa, 0xf4, 0x66, 0x4c, 0x69, 0x7c, 0x40, 0x10, 0xee,
+	0x75, 0x10, 0x4e, 0x12, 0xca, 0x31, 0xef, 0xd0, 0x84, 0x69, 0x6b, 0x51, 0x5b, 0xe5, 0x57, 0x7b,
+	0xf0, 0x00, 0xf1, 0x4e, 0x97, 0x30, 0x8e, 0xbb, 0x3d, 0x05, 0x28, 0x7f, 0x0f, 0x60, 0xb1, 0xc9,
+	0xe2, 0x46, 0x9f, 0x60, 0x4e, 0x1a, 0x07, 0xf8, 0xb0, 0x8d, 0xc3, 0xfd, 0xf7, 0x54, 0xde, 0x9d,
+	0x30, 0xa4, 0x83, 0x84, 0x3b, 0xab, 0x70, 0xf1, 0xc1, 0x20, 0x89, 0x48, 0x3f, 0xc0, 0x51, 0xd4,
+	0x27, 0x8c, 0x15, 0x40, 0x09, 0x54, 0xe6, 0x5b, 0x57, 0xd5, 0xe9, 0x8e, 0x3a, 0x74, 0xd6, 0xe0,
+	0x35, 0x4d, 0xd8, 0xe0, 0x66, 0x24, 0x6e, 0x51, 0x1f, 0x67, 0x40, 0x1f, 0x2e, 0x93, 0x04, 0xb7,
+	0x0f, 0x48, 0x10, 0xd3, 0x34, 0x08, 0x75, 0xd2, 0x42, 0xbe, 0x04, 0x2a, 0x73, 0xad, 0xe7, 0x94,
+	0xe9, 0x1e, 0x4d, 0x33, 0x36, 0x77, 0x0a, 0x7f, 0x7e, 0x5e, 0xcc, 0x3d, 0xfc, 0xe3, 0xdb, 0x8d,
+	0xe1, 0xf8, 0xe5, 0x75, 0xb8, 0x76, 0x01, 0xf9, 0x16, 0x61, 0x3d, 0x9a, 0x30, 0x52, 0xfe, 0x25,
+	0x0f, 0xaf, 0x37, 0x59, 0xbc, 0x3b, 0x48, 0xa2, 0x7f, 0xf9, 0x7a, 0x0d, 0x08, 0x19, 0xc7, 0x7d,
+	0x1e, 0x08, 0xad, 0xe5, 0xad, 0x16, 0x6a, 0xae, 0xaf, 0x0a, 0xe1, 0x67, 0x85, 0xf0, 0xdf, 0xc9,
+	0x0a, 0x51, 0x9f, 0x7b, 0xfc, 0x6b, 0x31, 0xf7, 0xe8, 0x69, 0x11, 0xb4, 0xe6, 0xa5, 0x9f, 0xb0,
+	0x38, 0x1f, 0x01, 0xb8, 0x78, 0x40, 0xc3, 0xfd, 0x41, 0x2f, 0xe8, 0x91, 0x7e, 0x87, 0x46, 0xac,
+	0x30, 0x5b, 0xca, 0x57, 0x16, 0x6a, 0x9e, 0xaf, 0x9a, 0xe5, 0xb4, 0xf1, 0x54, 0xb3, 0xf8, 0xf7,
+	0x25, 0xac, 0xbe, 0x23, 0xa2, 0x7d, 0xf5, 0xb4, 0xb8, 0x1d, 0x77, 0xf8, 0xde, 0xa0, 0xed, 0x87,
+	0xb4, 0x8b, 0x74, 0x7b, 0xa9, 0x3f, 0xb7, 0x58, 0xb4, 0x8f, 0x8e, 0x10, 0x1e, 0xf0, 0x3d, 0xd3,
+	0x70, 0xfc, 0x83, 0x1e, 0x61, 0x3a, 0x02, 0x6b, 0x5d, 0x55, 0x89, 0xf5, 0xa7, 0xf3, 0x31, 0x38,
+	0xbd, 0x79, 0xc6, 0xe5, 0xd2, 0x7f, 0xc5, 0x25, 0x13, 0x57, 0x7f, 0xdf, 0x59, 0x16, 0x7d, 0x30,
+	0x54, 0xaf, 0x72, 0x11, 0xae, 0x58, 0x4b, 0x6b, 0x8a, 0xff, 0x19, 0x80, 0x0b, 0xa2, 0x51, 0x74,
+	0x8b, 0x4c, 0x51, 0x72, 0xac, 0x22, 0x0d, 0x97, 0x5c, 0x1f, 0x67, 0xc0, 0x97, 0xe1, 0x95, 0x88,
+	0xb0, 0x53, 0x54, 0x5e, 0xa2, 0x16, 0xc4, 0x99, 0x86, 0xd8, 0x89, 0x1f, 0xc1, 0xe5, 0x33, 0xb4,
+	0x32, 0xba, 0x0e, 0x86, 0x97, 0xc4, 0xd8, 0x10, 0xac, 0x84, 0xcc, 0x37, 0x32, 0x99, 0xc5, 0x60,
+	0x31, 0x1a, 0x37, 0x68, 0x27, 0xa9, 0x6f, 0x69, 0x85, 0x2b, 0x63, 0x15, 0x56, 0x92, 0x0a, 0x07,
+	0xd6, 0x52, 0x91, 0xcb, 0x5f, 0x03, 0xf8, 0x42, 0x93, 0xc5, 0xef, 0xf6, 0x22, 0xcc, 0x89, 0x56,
+	0x6d, 0x57, 0x92, 0x9b, 0x54, 0x9c, 0x4d, 0xe8, 0x24, 0xe4, 0x30, 0x18, 0x82, 0x2a, 0x7d, 0x96,
+	0x12, 0x72, 0xb8, 0x7b, 0xd1, 0xeb, 0xc9, 0xdb, 0x5e, 0x8f, 0x5d, 0xa7, 0x12, 0xf4, 0xec, 0x64,
+	0x4d, 0x85, 0x1b, 0xb0, 0x20, 0x94, 0xa4, 0x49, 0x4a, 0xfa, 0x7c, 0xe8, 0x81, 0x5b, 0x72, 0x03,
+	0x5b, 0xee, 0x72, 0x19, 0x96, 0xce, 0x0b, 0x62, 0x12, 0x7d, 0x9a, 0x87, 0x57, 0xb4, 0xe9, 0x5e,
+	0x1f, 0x4f, 0x11, 0x7d, 0x68, 0x2e, 0xcc, 0xfc, 0x63, 0x73, 0x21, 0xff, 0x0c, 0xcd, 0x85, 0xd9,
+	0xff, 0x69, 0x2e, 0x94, 0x7f, 0x00, 0xf0, 0xa5, 0x26, 0x8b, 0xeb, 0x98, 0x87, 0x7b, 0xa3, 0x83,
+	0x80, 0x4d, 0xda, 0xd4, 0x77, 0xe1, 0xe5, 0x58, 0x54, 0x55, 0x34, 0xb2, 0xba, 0xc9, 0xf0, 0x96,
+	0xf7, 0xcf, 0x16, 0xbf, 0x3e, 0x2b, 0x6e, 0xd2, 0xd2, 0x3e, 0x53, 0x2f, 0x36, 0x6b, 0xaf, 0xdf,
+	0x87, 0xaf, 0x8c, 0xb9, 0x88, 0x99, 0x11, 0xeb, 0x70, 0x29, 0x94, 0x7b, 0x2f, 0x0a, 0xf4, 0x30,
+	0x52, 0xe3, 0x62, 0xbe, 0x75, 0x4d, 0x9f, 0x67, 0x2e, 0xb5, 0x0f, 0xe7, 0x60, 0xbe, 0xc9, 0x62,
+	0xe7, 0x47, 0x00, 0x6f, 0x8e, 0x5d, 0xf4, 0xd5, 0xd1, 0xdb, 0x5e, 0xb0, 0x5e, 0xdd, 0xed, 0xa9,
+	0x5d, 0xcc, 0x4b, 0xba, 0xfb, 0xf0, 0xa7, 0xdf, 0x3f, 0x99, 0x79, 0xd3, 0xb9, 0x8d, 0x2c, 0xff,
+	0x64, 0x21, 0x75, 0x09, 0x23, 0x62, 0x60, 0xde, 0x98, 0xe6, 0xfa, 0x05, 0x80, 0x8e, 0x65, 0x99,
+	0xaf, 0x59, 0xf9, 0x8c, 0x02, 0x5d, 0x34, 0x21, 0xd0, 0xd0, 0xad, 0x4a, 0xba, 0xaf, 0x39, 0xeb,
+	0x56, 0xba, 0xa2, 0x88, 0x23, 0x1c, 0x0f, 0xe1, 0x9c, 0x59, 0x39, 0x2b, 0x76, 0xa1, 0xb4, 0xd9,
+	0x5d, 0x1d, 0x6b, 0x36, 0x24, 0x56, 0x25, 0x89, 0xa2, 0xb3, 0x62, 0xd7, 0x2c, 0x4b, 0xf6, 0x25,
+	0x80, 0xcb, 0xb6, 0xd1, 0x5e, 0xb1, 0x66, 0xb1, 0x20, 0xdd, 0xad, 0x49, 0x91, 0x86, 0x5a, 0x4d,
+	0x52, 0xdb, 0x74, 0x36, 0xac, 0xd4, 0x06, 0xd2, 0xd3, 0x28, 0xa4, 0x7a, 0xde, 0xf9, 0x06, 0xc0,
+	0xeb, 0xf6, 0x99, 0xbd, 0x61, 0xd7, 0xc3, 0x86, 0x75, 0x6b, 0x93, 0x63, 0x0d, 0xdb, 0xdb, 0x92,
+	0xad, 0xef, 0x6c, 0xda, 0x85, 0x54, 0xbe, 0x23, 0x05, 0xfd, 0x0e, 0xc0, 0xc2, 0xb9, 0x23, 0xe6,
+	0x96, 0x95, 0xc6, 0x79, 0x70, 0xf7, 0x8d, 0xa9, 0xe0, 0x86, 0xf8, 0x5b, 0x92, 0x78, 0xcd, 0xd9,
+	0xb2, 0x12, 0x6f, 0x0b, 0xf7, 0xc0, 0xd6, 0x8c, 0xac, 0xfe, 0xf6, 0xe3, 0x63, 0x0f, 0x3c, 0x39,
+	0xf6, 0xc0, 0x6f, 0xc7, 0x1e, 0x78, 0x74, 0xe2, 0xe5, 0x9e, 0x9c, 0x78, 0xb9, 0x9f, 0x4f, 0xbc,
+	0xdc, 0xfb, 0x1b, 0x67, 0xe6, 0xb0, 0x8a, 0xaa, 0x63, 0x57, 0xb7, 0xd1, 0xd1, 0xdf, 0x07, 0x70,
+	0xfb, 0xb2, 0xdc, 0x54, 0xaf, 0xff, 0x35, 0x00, 0xa8, 0x6c, 0x58, 0x62, 0x1a, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -660,6 +859,9 @@ type MsgClient interface {
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// BatchFundVestingAccounts creates the missing ClawbackVestingAccounts and
+	// funds all of them atomically with the given grants.
+	BatchFundVestingAccounts(ctx context.Context, in *MsgBatchFundVestingAccounts, opts ...grpc.CallOption) (*MsgBatchFundVestingAccountsResponse, error)
 }
 
 type msgClient struct {
@@ -715,6 +917,15 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) BatchFundVestingAccounts(ctx context.Context, in *MsgBatchFundVestingAccounts, opts ...grpc.CallOption) (*MsgBatchFundVestingAccountsResponse, error) {
+	out := new(MsgBatchFundVestingAccountsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/BatchFundVestingAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
@@ -729,6 +940,9 @@ type MsgServer interface {
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// BatchFundVestingAccounts creates the missing ClawbackVestingAccounts and
+	// funds all of them atomically with the given grants.
+	BatchFundVestingAccounts(context.Context, *MsgBatchFundVestingAccounts) (*MsgBatchFundVestingAccountsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -750,6 +964,9 @@ func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) ConvertVestingAccount(ctx context.Context, req *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) BatchFundVestingAccounts(ctx context.Context, req *MsgBatchFundVestingAccounts) (*MsgBatchFundVestingAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchFundVestingAccounts not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -845,6 +1062,24 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchFundVestingAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchFundVestingAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchFundVestingAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/BatchFundVestingAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchFundVestingAccounts(ctx, req.(*MsgBatchFundVestingAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v2.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -869,6 +1104,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
+		{
+			MethodName: "BatchFundVestingAccounts",
+			Handler:    _Msg_BatchFundVestingAccounts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v2/tx.proto",
@@ -1241,93 +1480,245 @@ func (m *MsgConvertVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VestingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EnableGovClawback {
-		n += 2
+func (m *VestingGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateClawbackVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *VestingGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFundVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VestingGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VestingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.LockupPeriods) > 0 {
-		for _, e := range m.LockupPeriods {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.VestingPeriods) > 0 {
-		for _, e := range m.VestingPeriods {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	return n
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBatchFundVestingAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgClawback) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
+func (m *MsgBatchFundVestingAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchFundVestingAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnableGovClawback {
+		i--
+		if m.EnableGovClawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchFundVestingAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchFundVestingAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchFundVestingAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CreatedAccounts) > 0 {
+		for iNdEx := len(m.CreatedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CreatedAccounts[iNdEx])
+			copy(dAtA[i:], m.CreatedAccounts[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CreatedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EnableGovClawback {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1409,6 +1800,70 @@ func (m *MsgConvertVestingAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *VestingGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchFundVestingAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EnableGovClawback {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgBatchFundVestingAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CreatedAccounts) > 0 {
+		for _, s := range m.CreatedAccounts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2422,6 +2877,407 @@ func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VestingGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchFundVestingAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchFundVestingAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchFundVestingAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, VestingGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovClawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGovClawback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchFundVestingAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchFundVestingAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchFundVestingAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAccounts = append(m.CreatedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0